@@ -135,9 +135,17 @@ type Status struct {
 	// HealthPolicy defines the health check policy for the abstraction
 	// +optional
 	HealthPolicy string `json:"healthPolicy,omitempty"`
+	// HealthStatus defines the health status check for the abstraction, which reports one of Healthy, Progressing,
+	// Degraded, Suspended, Missing and Unknown through the `healthStatus` field
+	// +optional
+	HealthStatus string `json:"healthStatus,omitempty"`
 	// Details stores a string representation of a CUE status map to be evaluated at runtime for display
 	// +optional
 	Details string `json:"details,omitempty"`
+	// DisableBuiltinHealthCheck disables the built-in health assessment of the output resources, which is used when
+	// the health policy is not set. The output resources will always be treated as healthy if disabled.
+	// +optional
+	DisableBuiltinHealthCheck bool `json:"disableBuiltinHealthCheck,omitempty"`
 }
 
 // ApplicationPhase is a label for the condition of an application at the current time
@@ -166,6 +174,82 @@ const (
 	ApplicationDeleting ApplicationPhase = "deleting"
 )
 
+// HealthStatus is the health state of a component, trait or application
+// +kubebuilder:validation:Enum=Healthy;Progressing;Degraded;Suspended;Missing;Unknown
+type HealthStatus string
+
+const (
+	// HealthStatusHealthy means the resource is healthy
+	HealthStatusHealthy HealthStatus = "Healthy"
+	// HealthStatusProgressing means the resource is not healthy yet but still making progress
+	HealthStatusProgressing HealthStatus = "Progressing"
+	// HealthStatusDegraded means the resource failed or could not reach the healthy state in time
+	HealthStatusDegraded HealthStatus = "Degraded"
+	// HealthStatusSuspended means the resource is suspended or paused
+	HealthStatusSuspended HealthStatus = "Suspended"
+	// HealthStatusMissing means the resource does not exist
+	HealthStatusMissing HealthStatus = "Missing"
+	// HealthStatusUnknown means the health of the resource could not be assessed
+	HealthStatusUnknown HealthStatus = "Unknown"
+)
+
+// healthStatusOrder is the order of health status from the best to the worst
+var healthStatusOrder = []HealthStatus{
+	HealthStatusHealthy,
+	HealthStatusSuspended,
+	HealthStatusProgressing,
+	HealthStatusMissing,
+	HealthStatusDegraded,
+	HealthStatusUnknown,
+}
+
+// IsValid check if the health status is one of the known health status
+func (in HealthStatus) IsValid() bool {
+	for _, status := range healthStatusOrder {
+		if in == status {
+			return true
+		}
+	}
+	return false
+}
+
+// IsWorseThan check if the health status is worse than the given one. Unknown health status is treated as the worst
+// and empty health status is treated as the best.
+func (in HealthStatus) IsWorseThan(r HealthStatus) bool {
+	index := func(status HealthStatus) int {
+		if status == "" {
+			return -1
+		}
+		for i, s := range healthStatusOrder {
+			if s == status {
+				return i
+			}
+		}
+		return len(healthStatusOrder) - 1
+	}
+	return index(in) > index(r)
+}
+
+// HealthStatusFromHealthy convert the boolean healthy result to health status. Unhealthy resources are regarded as
+// progressing as the boolean result cannot tell whether the resource is failed.
+func HealthStatusFromHealthy(healthy bool) HealthStatus {
+	if healthy {
+		return HealthStatusHealthy
+	}
+	return HealthStatusProgressing
+}
+
+// AggregateHealthStatus return the worst one of the given health status, empty health status will be ignored
+func AggregateHealthStatus(statuses ...HealthStatus) HealthStatus {
+	var aggregated HealthStatus
+	for _, status := range statuses {
+		if status.IsWorseThan(aggregated) {
+			aggregated = status
+		}
+	}
+	return aggregated
+}
+
 // ApplicationComponentStatus record the health status of App component
 type ApplicationComponentStatus struct {
 	Name      string `json:"name"`
@@ -179,6 +263,21 @@ type ApplicationComponentStatus struct {
 	Message            string                   `json:"message,omitempty"`
 	Traits             []ApplicationTraitStatus `json:"traits,omitempty"`
 	Scopes             []corev1.ObjectReference `json:"scopes,omitempty"`
+	// HealthStatus is the aggregated health status of the workload and traits in the component
+	HealthStatus HealthStatus `json:"healthStatus,omitempty"`
+}
+
+// GetHealthStatus return the health status of the component, fallback to the healthy field of the component and its
+// traits if the health status is not recorded
+func (in ApplicationComponentStatus) GetHealthStatus() HealthStatus {
+	if in.HealthStatus != "" {
+		return in.HealthStatus
+	}
+	status := HealthStatusFromHealthy(in.Healthy)
+	for _, tr := range in.Traits {
+		status = AggregateHealthStatus(status, tr.GetHealthStatus())
+	}
+	return status
 }
 
 // Equal check if two ApplicationComponentStatus are equal
@@ -193,6 +292,17 @@ type ApplicationTraitStatus struct {
 	Healthy bool              `json:"healthy"`
 	Details map[string]string `json:"details,omitempty"`
 	Message string            `json:"message,omitempty"`
+	// HealthStatus is the health status of the trait
+	HealthStatus HealthStatus `json:"healthStatus,omitempty"`
+}
+
+// GetHealthStatus return the health status of the trait, fallback to the healthy field if the health status is not
+// recorded
+func (in ApplicationTraitStatus) GetHealthStatus() HealthStatus {
+	if in.HealthStatus != "" {
+		return in.HealthStatus
+	}
+	return HealthStatusFromHealthy(in.Healthy)
 }
 
 // Revision has name and revision number
@@ -222,6 +332,10 @@ type AppStatus struct {
 	// Services record the status of the application services
 	Services []ApplicationComponentStatus `json:"services,omitempty"`
 
+	// HealthStatus is the aggregated health status of all the application services
+	// +optional
+	HealthStatus HealthStatus `json:"healthStatus,omitempty"`
+
 	// Workflow record the status of workflow
 	Workflow *WorkflowStatus `json:"workflow,omitempty"`
 
@@ -235,6 +349,58 @@ type AppStatus struct {
 	// PolicyStatus records the status of policy
 	// Deprecated This field is only used by EnvBinding Policy which is deprecated.
 	PolicyStatus []PolicyStatus `json:"policy,omitempty"`
+
+	// PlacementRevisions records the application revision deployed to each cluster by the deploy steps.
+	// The clusters rolled back separately are deployed with the revisions pinned to them.
+	// +optional
+	PlacementRevisions []PlacementRevision `json:"placementRevisions,omitempty"`
+
+	// PlacementCleanups records the clusters pinned to other revisions or unpinned. The resources of the components
+	// not in the revisions deployed to these clusters are deleted after the workflow succeeds.
+	// +optional
+	PlacementCleanups []string `json:"placementCleanups,omitempty"`
+
+	// OperationHistory records the latest operations on the workflow, such as suspend, resume and rollback.
+	// +optional
+	OperationHistory []WorkflowOperationRecord `json:"operationHistory,omitempty"`
+}
+
+// PlacementRevision is the application revision deployed to a cluster
+type PlacementRevision struct {
+	Cluster  string `json:"cluster"`
+	Revision string `json:"revision"`
+	// Pinned means the cluster is rolled back to the revision separately from the other clusters
+	Pinned bool `json:"pinned,omitempty"`
+}
+
+// WorkflowOperationRecord is an operation on the workflow of the application and the identity requesting it
+type WorkflowOperationRecord struct {
+	// Operation is the type of the operation, one of suspend, resume, restart, terminate and rollback
+	Operation string `json:"operation"`
+	// Step is the workflow step operated, empty if the whole workflow is operated
+	Step   string      `json:"step,omitempty"`
+	User   string      `json:"user,omitempty"`
+	Groups []string    `json:"groups,omitempty"`
+	Reason string      `json:"reason,omitempty"`
+	Time   metav1.Time `json:"time"`
+}
+
+// GetHealthStatus return the health status of the application, fallback to aggregate the health status of services
+// if the health status is not recorded
+func (in AppStatus) GetHealthStatus() HealthStatus {
+	if in.HealthStatus != "" {
+		return in.HealthStatus
+	}
+	return AggregateServicesHealthStatus(in.Services)
+}
+
+// AggregateServicesHealthStatus return the worst health status of the services, return healthy if there is no service
+func AggregateServicesHealthStatus(services []ApplicationComponentStatus) HealthStatus {
+	status := HealthStatusHealthy
+	for _, svc := range services {
+		status = AggregateHealthStatus(status, svc.GetHealthStatus())
+	}
+	return status
 }
 
 // PolicyStatus records the status of policy
@@ -71,3 +71,27 @@ func TestContainerStateToString(t *testing.T) {
 	}))
 	r.Equal("Unknown", ContainerStateToString(v1.ContainerState{}))
 }
+
+func TestHealthStatus(t *testing.T) {
+	r := require.New(t)
+	r.True(HealthStatusDegraded.IsValid())
+	r.False(HealthStatus("Broken").IsValid())
+	r.True(HealthStatusDegraded.IsWorseThan(HealthStatusProgressing))
+	r.True(HealthStatusHealthy.IsWorseThan(""))
+	r.False(HealthStatusSuspended.IsWorseThan(HealthStatusProgressing))
+	r.Equal(HealthStatus(""), AggregateHealthStatus())
+	r.Equal(HealthStatusProgressing, AggregateHealthStatus(HealthStatusHealthy, "", HealthStatusProgressing, HealthStatusSuspended))
+	r.Equal(HealthStatusUnknown, AggregateHealthStatus(HealthStatusDegraded, HealthStatusUnknown, HealthStatusMissing))
+
+	// status recorded by legacy controller only has boolean healthy field
+	legacy := ApplicationComponentStatus{Healthy: true, Traits: []ApplicationTraitStatus{{Healthy: false}}}
+	r.Equal(HealthStatusProgressing, legacy.GetHealthStatus())
+	legacy.Traits[0].Healthy = true
+	r.Equal(HealthStatusHealthy, legacy.GetHealthStatus())
+	svc := ApplicationComponentStatus{Healthy: false, HealthStatus: HealthStatusDegraded}
+	r.Equal(HealthStatusDegraded, svc.GetHealthStatus())
+
+	r.Equal(HealthStatusHealthy, AppStatus{}.GetHealthStatus())
+	r.Equal(HealthStatusDegraded, AppStatus{Services: []ApplicationComponentStatus{legacy, svc}}.GetHealthStatus())
+	r.Equal(HealthStatusSuspended, AppStatus{HealthStatus: HealthStatusSuspended, Services: []ApplicationComponentStatus{svc}}.GetHealthStatus())
+}
@@ -65,6 +65,23 @@ func (in *AppStatus) DeepCopyInto(out *AppStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.PlacementRevisions != nil {
+		in, out := &in.PlacementRevisions, &out.PlacementRevisions
+		*out = make([]PlacementRevision, len(*in))
+		copy(*out, *in)
+	}
+	if in.PlacementCleanups != nil {
+		in, out := &in.PlacementCleanups, &out.PlacementCleanups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.OperationHistory != nil {
+		in, out := &in.OperationHistory, &out.OperationHistory
+		*out = make([]WorkflowOperationRecord, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppStatus.
@@ -308,6 +325,21 @@ func (in *OAMObjectReference) DeepCopy() *OAMObjectReference {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlacementRevision) DeepCopyInto(out *PlacementRevision) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlacementRevision.
+func (in *PlacementRevision) DeepCopy() *PlacementRevision {
+	if in == nil {
+		return nil
+	}
+	out := new(PlacementRevision)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PolicyStatus) DeepCopyInto(out *PolicyStatus) {
 	*out = *in
@@ -471,6 +503,27 @@ func (in *Terraform) DeepCopy() *Terraform {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkflowOperationRecord) DeepCopyInto(out *WorkflowOperationRecord) {
+	*out = *in
+	if in.Groups != nil {
+		in, out := &in.Groups, &out.Groups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.Time.DeepCopyInto(&out.Time)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowOperationRecord.
+func (in *WorkflowOperationRecord) DeepCopy() *WorkflowOperationRecord {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkflowOperationRecord)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkflowStatus) DeepCopyInto(out *WorkflowStatus) {
 	*out = *in
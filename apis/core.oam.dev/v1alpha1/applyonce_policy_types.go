@@ -17,6 +17,8 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"fmt"
+
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 )
 
@@ -76,3 +78,13 @@ func (in *ApplyOncePolicySpec) FindStrategy(manifest *unstructured.Unstructured)
 	}
 	return nil
 }
+
+// Validate check if the selectors of the rules are valid
+func (in *ApplyOncePolicySpec) Validate() error {
+	for i, rule := range in.Rules {
+		if err := rule.Selector.Validate(); err != nil {
+			return fmt.Errorf("invalid selector in rule %d: %w", i, err)
+		}
+	}
+	return nil
+}
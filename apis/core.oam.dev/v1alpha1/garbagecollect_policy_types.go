@@ -17,6 +17,9 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"fmt"
+	"time"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -33,6 +36,11 @@ type GarbageCollectPolicySpec struct {
 	// the global configuration
 	ApplicationRevisionLimit *int `json:"applicationRevisionLimit,omitempty"`
 
+	// ApplicationRevisionRetention if set, application revisions created within this duration will be kept. Outdated
+	// revisions beyond the retention will be recycled unless they are in use or kept by the ApplicationRevisionLimit
+	// explicitly set in the policy
+	ApplicationRevisionRetention *metav1.Duration `json:"applicationRevisionRetention,omitempty"`
+
 	// KeepLegacyResource if is set, outdated versioned resourcetracker will not be recycled automatically
 	// outdated resources will be kept until resourcetracker be deleted manually
 	KeepLegacyResource bool `json:"keepLegacyResource,omitempty"`
@@ -61,6 +69,8 @@ type GarbageCollectPolicyRule struct {
 	Selector    ResourcePolicyRuleSelector `json:"selector"`
 	Strategy    GarbageCollectStrategy     `json:"strategy"`
 	Propagation *GarbageCollectPropagation `json:"propagation"`
+	// TTL is the duration to keep the outdated resource after it falls out of use, only works for the afterTTL strategy
+	TTL *metav1.Duration `json:"ttl,omitempty"`
 }
 
 // GarbageCollectStrategy the strategy for target resource to recycle
@@ -74,6 +84,8 @@ const (
 	GarbageCollectStrategyOnAppDelete GarbageCollectStrategy = "onAppDelete"
 	// GarbageCollectStrategyOnAppUpdate recycle target resource when it is not inUse
 	GarbageCollectStrategyOnAppUpdate GarbageCollectStrategy = "onAppUpdate"
+	// GarbageCollectStrategyAfterTTL recycle target resource when it has not been inUse for the duration of the TTL
+	GarbageCollectStrategyAfterTTL GarbageCollectStrategy = "afterTTL"
 )
 
 // GarbageCollectPropagation the deletion propagation setting similar to metav1.DeletionPropagation
@@ -101,6 +113,20 @@ func (in *GarbageCollectPolicySpec) FindStrategy(manifest *unstructured.Unstruct
 	return nil
 }
 
+// FindTTL find the ttl to keep the outdated target resource, return false if the first matched rule does not use the
+// afterTTL strategy
+func (in *GarbageCollectPolicySpec) FindTTL(manifest *unstructured.Unstructured) (time.Duration, bool) {
+	for _, rule := range in.Rules {
+		if rule.Selector.Match(manifest) {
+			if rule.Strategy != GarbageCollectStrategyAfterTTL || rule.TTL == nil {
+				return 0, false
+			}
+			return rule.TTL.Duration, true
+		}
+	}
+	return 0, false
+}
+
 // FindDeleteOption find delete option for target resource
 func (in *GarbageCollectPolicySpec) FindDeleteOption(manifest *unstructured.Unstructured) (bool, []client.DeleteOption) {
 	for _, rule := range in.Rules {
@@ -115,3 +141,13 @@ func (in *GarbageCollectPolicySpec) FindDeleteOption(manifest *unstructured.Unst
 	}
 	return false, nil
 }
+
+// Validate check if the selectors of the rules are valid
+func (in *GarbageCollectPolicySpec) Validate() error {
+	for i, rule := range in.Rules {
+		if err := rule.Selector.Validate(); err != nil {
+			return fmt.Errorf("invalid selector in rule %d: %w", i, err)
+		}
+	}
+	return nil
+}
@@ -18,8 +18,10 @@ package v1alpha1
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	"github.com/oam-dev/kubevela/pkg/oam"
@@ -135,3 +137,29 @@ func TestGarbageCollectPolicySpec_FindStrategy(t *testing.T) {
 		})
 	}
 }
+
+func TestGarbageCollectPolicySpec_FindTTL(t *testing.T) {
+	r := require.New(t)
+	spec := GarbageCollectPolicySpec{Rules: []GarbageCollectPolicyRule{{
+		Selector: ResourcePolicyRuleSelector{CompNames: []string{"blue"}},
+		Strategy: GarbageCollectStrategyAfterTTL,
+		TTL:      &metav1.Duration{Duration: 2 * time.Hour},
+	}, {
+		Selector: ResourcePolicyRuleSelector{CompNames: []string{"green", "blue"}},
+		Strategy: GarbageCollectStrategyOnAppUpdate,
+	}}}
+	newManifest := func(comp string) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]interface{}{
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{oam.LabelAppComponent: comp},
+			},
+		}}
+	}
+	ttl, found := spec.FindTTL(newManifest("blue"))
+	r.True(found)
+	r.Equal(2*time.Hour, ttl)
+	_, found = spec.FindTTL(newManifest("green"))
+	r.False(found)
+	_, found = spec.FindTTL(newManifest("red"))
+	r.False(found)
+}
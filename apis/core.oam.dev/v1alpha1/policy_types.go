@@ -16,6 +16,11 @@ limitations under the License.
 
 package v1alpha1
 
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
 const (
 	// TopologyPolicyType refers to the type of topology policy
 	TopologyPolicyType = "topology"
@@ -34,6 +39,81 @@ type TopologyPolicySpec struct {
 	// Namespace is the target namespace to deploy in the selected clusters.
 	// +optional
 	Namespace string `json:"namespace,omitempty"`
+	// Strategy picks a subset of the selected clusters.
+	// +optional
+	Strategy *PlacementStrategy `json:"strategy,omitempty"`
+	// Tolerations allow the topology to select the tainted clusters. Clusters selected by labels
+	// are skipped if they have NoSchedule or NoExecute taints not tolerated. Clusters listed by
+	// names are skipped only if they have NoExecute taints not tolerated.
+	// +optional
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+	// Failover moves the components off the placed clusters disconnected for longer than the grace period.
+	// +optional
+	Failover *FailoverPolicy `json:"failover,omitempty"`
+}
+
+// FailoverPolicy describes how to move the components when the placed clusters are disconnected.
+// The components are moved to a connected cluster selected by the topology, the clusters not placed
+// yet are preferred.
+type FailoverPolicy struct {
+	// GracePeriod is the duration a placed cluster can be disconnected before its components are
+	// moved to another cluster, defaults to 5m.
+	GracePeriod *metav1.Duration `json:"gracePeriod,omitempty"`
+	// FailBack moves the components back when the original cluster is connected again.
+	// Otherwise, the components stay in the cluster they are moved to.
+	FailBack bool `json:"failBack,omitempty"`
+}
+
+// PlacementStrategy describes how to pick clusters out of the ones selected by the topology.
+// The picked clusters are recorded in the application status and kept unchanged until the
+// selected clusters or the strategy change.
+type PlacementStrategy struct {
+	// Count is the number of clusters to pick. All the selected clusters are picked if not set.
+	Count int `json:"count,omitempty"`
+	// SpreadByLabel is the cluster label, such as region or zone, to spread the picked clusters
+	// evenly across its values.
+	SpreadByLabel string `json:"spreadByLabel,omitempty"`
+	// CapacityWeights ranks the clusters by the weighted ratio of their free resources.
+	// The clusters are picked in the order of names if not set.
+	CapacityWeights *CapacityWeights `json:"capacityWeights,omitempty"`
+}
+
+// CapacityWeights is the weights of the free resources when ranking clusters
+type CapacityWeights struct {
+	CPU    int `json:"cpu,omitempty"`
+	Memory int `json:"memory,omitempty"`
+}
+
+// TopologyPolicyStatus records the clusters picked by the placement strategy of topology policy
+// and the failovers of the placed clusters
+type TopologyPolicyStatus struct {
+	// Strategy is the placement strategy used to pick the clusters
+	Strategy *PlacementStrategy `json:"strategy,omitempty"`
+	// Candidates are the clusters selected by the topology
+	Candidates []string `json:"candidates,omitempty"`
+	// Decisions are the placements of the picked clusters
+	Decisions []PlacementDecision `json:"decisions,omitempty"`
+	// Failovers are the placed clusters found disconnected and the clusters their components are moved to
+	Failovers []ClusterFailover `json:"failovers,omitempty"`
+	// GroupMembers are the clusters resolved from the cluster group when the components are placed
+	GroupMembers []string `json:"groupMembers,omitempty"`
+	// Released are the clusters the components moved away from by failover, the resources left in them are deleted
+	// after the components are deployed in the clusters they moved to
+	Released []string `json:"released,omitempty"`
+}
+
+// ClusterFailover records the failover of a placed cluster
+type ClusterFailover struct {
+	// Cluster is the placed cluster found disconnected
+	Cluster string `json:"cluster"`
+	// DisconnectedSince is the time the cluster is found disconnected
+	DisconnectedSince metav1.Time `json:"disconnectedSince"`
+	// Target is the cluster the components are moved to, empty if not moved yet
+	Target string `json:"target,omitempty"`
+	// FailoverTime is the time the components are moved
+	FailoverTime *metav1.Time `json:"failoverTime,omitempty"`
+	// Message explains why the components are not moved
+	Message string `json:"message,omitempty"`
 }
 
 // Placement describes which clusters to be selected in this topology
@@ -45,6 +125,11 @@ type Placement struct {
 	// Exclusive to "clusters"
 	ClusterLabelSelector map[string]string `json:"clusterLabelSelector,omitempty"`
 
+	// ClusterGroup is the name of the cluster group to select, the members of the group
+	// are resolved when the application is reconciled.
+	// Exclusive to "clusters" and "clusterLabelSelector"
+	ClusterGroup string `json:"clusterGroup,omitempty"`
+
 	// AllowEmpty ignore empty cluster error when no cluster returned for label
 	// selector
 	AllowEmpty bool `json:"allowEmpty,omitempty"`
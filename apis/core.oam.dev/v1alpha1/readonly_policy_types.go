@@ -16,7 +16,11 @@ limitations under the License.
 
 package v1alpha1
 
-import "k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
 
 const (
 	// ReadOnlyPolicyType refers to the type of read-only policy
@@ -47,3 +51,13 @@ func (in *ReadOnlyPolicySpec) FindStrategy(manifest *unstructured.Unstructured)
 	}
 	return false
 }
+
+// Validate check if the selectors of the rules are valid
+func (in *ReadOnlyPolicySpec) Validate() error {
+	for i, rule := range in.Rules {
+		if err := rule.Selector.Validate(); err != nil {
+			return fmt.Errorf("invalid selector in rule %d: %w", i, err)
+		}
+	}
+	return nil
+}
@@ -55,8 +55,15 @@ var (
 	WorkflowGroupVersionKind = SchemeGroupVersion.WithKind(WorkflowKind)
 )
 
+// ResourceAdmissionPolicy meta
+var (
+	ResourceAdmissionPolicyKind             = "ResourceAdmissionPolicy"
+	ResourceAdmissionPolicyGroupVersionKind = SchemeGroupVersion.WithKind(ResourceAdmissionPolicyKind)
+)
+
 func init() {
 	SchemeBuilder.Register(&Policy{}, &PolicyList{})
+	SchemeBuilder.Register(&ResourceAdmissionPolicy{}, &ResourceAdmissionPolicyList{})
 	SchemeBuilder.Register(&workflowv1alpha1.Workflow{}, &workflowv1alpha1.WorkflowList{})
 	_ = SchemeBuilder.AddToScheme(k8sscheme.Scheme)
 }
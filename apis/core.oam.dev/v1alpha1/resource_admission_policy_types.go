@@ -0,0 +1,112 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	stringslices "k8s.io/utils/strings/slices"
+)
+
+// +kubebuilder:object:root=true
+
+// ResourceAdmissionPolicy declares the admission rules for the resources to be dispatched or deleted by applications.
+// The rules are written as CEL expressions over each manifest and evaluated by the resourcekeeper.
+// +kubebuilder:resource:scope=Cluster,categories={oam},shortName=rap
+// +kubebuilder:printcolumn:name="AGE",type=date,JSONPath=".metadata.creationTimestamp"
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type ResourceAdmissionPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec ResourceAdmissionPolicySpec `json:"spec"`
+}
+
+// ResourceAdmissionPolicySpec defines the spec of ResourceAdmissionPolicy
+type ResourceAdmissionPolicySpec struct {
+	// Rules defines the list of admission rules, the resource operation will be denied if any rule is violated
+	Rules []ResourceAdmissionRule `json:"rules"`
+}
+
+// ResourceAdmissionRule defines a single admission rule
+type ResourceAdmissionRule struct {
+	// Name is the name of the rule, which will be displayed when the rule is violated
+	Name string `json:"name"`
+	// Operations specify the operations the rule applies to, the rule applies to all operations if empty
+	Operations []ResourceAdmissionOperation `json:"operations,omitempty"`
+	// Selector select the target resources of the rule, the rule applies to all resources if not set
+	Selector *ResourcePolicyRuleSelector `json:"selector,omitempty"`
+	// Expression is the CEL expression which returns true if the resource is admitted.
+	// The following variables are available in the expression:
+	// - object: the manifest of the resource
+	// - operation: the operation on the resource, Dispatch or Delete
+	// - cluster: the cluster of the resource
+	// - application: the metadata of the application, including name, namespace, labels and annotations
+	Expression string `json:"expression"`
+	// Message is displayed when the rule is violated
+	Message string `json:"message,omitempty"`
+	// FailurePolicy defines how to handle the errors while evaluating the expression, defaults to Fail
+	FailurePolicy ResourceAdmissionFailurePolicy `json:"failurePolicy,omitempty"`
+}
+
+// ResourceAdmissionOperation is the operation on the resource to be admitted
+type ResourceAdmissionOperation string
+
+const (
+	// ResourceAdmissionOperationDispatch refers to the dispatch of the resource
+	ResourceAdmissionOperationDispatch ResourceAdmissionOperation = "Dispatch"
+	// ResourceAdmissionOperationDelete refers to the deletion of the resource
+	ResourceAdmissionOperationDelete ResourceAdmissionOperation = "Delete"
+)
+
+// ResourceAdmissionFailurePolicy defines how to handle the errors while evaluating the admission rule
+type ResourceAdmissionFailurePolicy string
+
+const (
+	// ResourceAdmissionFailurePolicyFail deny the resource operation if the evaluation fails
+	ResourceAdmissionFailurePolicyFail ResourceAdmissionFailurePolicy = "Fail"
+	// ResourceAdmissionFailurePolicyIgnore ignore the rule if the evaluation fails
+	ResourceAdmissionFailurePolicyIgnore ResourceAdmissionFailurePolicy = "Ignore"
+)
+
+// Match check if the rule applies to the operation on the target resource
+func (in *ResourceAdmissionRule) Match(op ResourceAdmissionOperation, manifest *unstructured.Unstructured) bool {
+	if len(in.Operations) > 0 && !stringslices.Contains(operationsToStrings(in.Operations), string(op)) {
+		return false
+	}
+	return in.Selector == nil || in.Selector.Match(manifest)
+}
+
+func operationsToStrings(ops []ResourceAdmissionOperation) []string {
+	s := make([]string, 0, len(ops))
+	for _, op := range ops {
+		s = append(s, string(op))
+	}
+	return s
+}
+
+// +kubebuilder:object:root=true
+
+// ResourceAdmissionPolicyList contains a list of ResourceAdmissionPolicy
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type ResourceAdmissionPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ResourceAdmissionPolicy `json:"items"`
+}
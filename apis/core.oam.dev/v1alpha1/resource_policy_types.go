@@ -17,7 +17,14 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"fmt"
+	"path"
+	"regexp"
+	"sync"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/utils/ptr"
 	stringslices "k8s.io/utils/strings/slices"
 
@@ -33,8 +40,25 @@ type ResourcePolicyRuleSelector struct {
 	TraitTypes       []string `json:"traitTypes,omitempty"`
 	ResourceTypes    []string `json:"resourceTypes,omitempty"`
 	ResourceNames    []string `json:"resourceNames,omitempty"`
+	// ResourceNamePatterns select resources whose names match one of the glob patterns, like "redis-*"
+	ResourceNamePatterns []string `json:"resourceNamePatterns,omitempty"`
+	// ResourceNameRegexps select resources whose names match one of the regular expressions
+	ResourceNameRegexps []string `json:"resourceNameRegexps,omitempty"`
+	// Namespaces select resources in the given namespaces
+	Namespaces []string `json:"namespaces,omitempty"`
+	// APIGroups select resources by api group, the core group can be specified as "core"
+	APIGroups []string `json:"apiGroups,omitempty"`
+	// APIVersions select resources by apiVersion, like apps/v1
+	APIVersions []string `json:"apiVersions,omitempty"`
+	// LabelSelector select resources by labels with matchLabels and matchExpressions
+	LabelSelector *metav1.LabelSelector `json:"labelSelector,omitempty"`
+	// Annotations select resources which contains all the given annotations
+	Annotations map[string]string `json:"annotations,omitempty"`
 }
 
+// CoreAPIGroup is the alias of the core api group ("") used in ResourcePolicyRuleSelector
+const CoreAPIGroup = "core"
+
 // Match check if current rule selector match the target resource
 // If at least one condition is matched and no other condition failed (could be empty), return true
 // Otherwise, return false
@@ -54,6 +78,11 @@ func (in *ResourcePolicyRuleSelector) Match(manifest *unstructured.Unstructured)
 		}
 		return ptr.To(val != "" && stringslices.Contains(src, val))
 	}
+	gvk := manifest.GroupVersionKind()
+	apiGroup := gvk.Group
+	if apiGroup == "" && gvk.Version != "" {
+		apiGroup = CoreAPIGroup
+	}
 	conditions := []*bool{
 		match(in.CompNames, compName),
 		match(in.CompTypes, compType),
@@ -61,6 +90,13 @@ func (in *ResourcePolicyRuleSelector) Match(manifest *unstructured.Unstructured)
 		match(in.TraitTypes, traitType),
 		match(in.ResourceTypes, resourceType),
 		match(in.ResourceNames, resourceName),
+		matchPatterns(in.ResourceNamePatterns, resourceName, path.Match),
+		matchPatterns(in.ResourceNameRegexps, resourceName, matchRegexp),
+		match(in.Namespaces, manifest.GetNamespace()),
+		match(in.APIGroups, apiGroup),
+		match(in.APIVersions, manifest.GetAPIVersion()),
+		matchLabelSelector(in.LabelSelector, manifest.GetLabels()),
+		matchAnnotations(in.Annotations, manifest.GetAnnotations()),
 	}
 	hasMatched := false
 	for _, cond := range conditions {
@@ -76,3 +112,93 @@ func (in *ResourcePolicyRuleSelector) Match(manifest *unstructured.Unstructured)
 	// if at least one condition is met, return true
 	return hasMatched
 }
+
+// Validate check if the name patterns, the name regular expressions and the label selector of the rule selector are
+// valid, so that they are not silently treated as mismatched
+func (in *ResourcePolicyRuleSelector) Validate() error {
+	for _, pattern := range in.ResourceNamePatterns {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("invalid resource name pattern %q: %w", pattern, err)
+		}
+	}
+	for _, expr := range in.ResourceNameRegexps {
+		if _, err := compileRegexp(expr); err != nil {
+			return fmt.Errorf("invalid resource name regexp %q: %w", expr, err)
+		}
+	}
+	if in.LabelSelector != nil {
+		if _, err := metav1.LabelSelectorAsSelector(in.LabelSelector); err != nil {
+			return fmt.Errorf("invalid label selector: %w", err)
+		}
+	}
+	return nil
+}
+
+// compiledRegexps caches the compiled regular expressions of the rule selectors, so that they are compiled only once
+var compiledRegexps sync.Map
+
+type compiledRegexp struct {
+	re  *regexp.Regexp
+	err error
+}
+
+// compileRegexp compiles the regular expression or loads it from the cache
+func compileRegexp(expr string) (*regexp.Regexp, error) {
+	if cached, ok := compiledRegexps.Load(expr); ok {
+		return cached.(compiledRegexp).re, cached.(compiledRegexp).err
+	}
+	re, err := regexp.Compile(expr)
+	compiledRegexps.Store(expr, compiledRegexp{re: re, err: err})
+	return re, err
+}
+
+// matchRegexp check if the value matches the regular expression compiled once
+func matchRegexp(expr string, val string) (bool, error) {
+	re, err := compileRegexp(expr)
+	if err != nil {
+		return false, err
+	}
+	return re.MatchString(val), nil
+}
+
+// matchPatterns check if the value matches any of the patterns with the given matcher, invalid patterns rejected by
+// Validate are treated as mismatched
+func matchPatterns(patterns []string, val string, matcher func(pattern, val string) (bool, error)) *bool {
+	if len(patterns) == 0 {
+		return nil
+	}
+	if val == "" {
+		return ptr.To(false)
+	}
+	for _, pattern := range patterns {
+		if matched, err := matcher(pattern, val); err == nil && matched {
+			return ptr.To(true)
+		}
+	}
+	return ptr.To(false)
+}
+
+// matchLabelSelector check if the labels match the label selector, invalid selector is treated as mismatched
+func matchLabelSelector(selector *metav1.LabelSelector, lbls map[string]string) *bool {
+	if selector == nil || (len(selector.MatchLabels) == 0 && len(selector.MatchExpressions) == 0) {
+		return nil
+	}
+	sel, err := metav1.LabelSelectorAsSelector(selector)
+	if err != nil {
+		return ptr.To(false)
+	}
+	return ptr.To(sel.Matches(labels.Set(lbls)))
+}
+
+// matchAnnotations check if all the expected annotations exist with the same value
+func matchAnnotations(expected map[string]string, annotations map[string]string) *bool {
+	if len(expected) == 0 {
+		return nil
+	}
+	for k, v := range expected {
+		if val, found := annotations[k]; !found || val != v {
+			return ptr.To(false)
+		}
+	}
+	return ptr.To(true)
+}
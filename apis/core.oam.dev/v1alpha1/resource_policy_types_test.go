@@ -0,0 +1,210 @@
+/*
+Copyright 2022 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/oam-dev/kubevela/pkg/oam"
+)
+
+func TestResourcePolicyRuleSelector_Match(t *testing.T) {
+	deploy := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata": map[string]interface{}{
+			"name":      "redis-master",
+			"namespace": "data",
+			"labels": map[string]interface{}{
+				oam.LabelAppComponent: "redis",
+				"tier":                "data",
+			},
+			"annotations": map[string]interface{}{
+				"owner": "team-a",
+			},
+		},
+	}}
+	cm := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata": map[string]interface{}{
+			"name":      "config",
+			"namespace": "default",
+		},
+	}}
+	testCases := map[string]struct {
+		selector ResourcePolicyRuleSelector
+		input    *unstructured.Unstructured
+		matched  bool
+	}{
+		"empty selector": {
+			selector: ResourcePolicyRuleSelector{},
+			input:    deploy,
+			matched:  false,
+		},
+		"match labels": {
+			selector: ResourcePolicyRuleSelector{LabelSelector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{"tier": "data"},
+			}},
+			input:   deploy,
+			matched: true,
+		},
+		"match expressions": {
+			selector: ResourcePolicyRuleSelector{LabelSelector: &metav1.LabelSelector{
+				MatchExpressions: []metav1.LabelSelectorRequirement{{
+					Key:      "tier",
+					Operator: metav1.LabelSelectorOpIn,
+					Values:   []string{"data", "cache"},
+				}},
+			}},
+			input:   deploy,
+			matched: true,
+		},
+		"match expressions mismatch": {
+			selector: ResourcePolicyRuleSelector{LabelSelector: &metav1.LabelSelector{
+				MatchExpressions: []metav1.LabelSelectorRequirement{{
+					Key:      "tier",
+					Operator: metav1.LabelSelectorOpExists,
+				}},
+			}},
+			input:   cm,
+			matched: false,
+		},
+		"invalid label selector": {
+			selector: ResourcePolicyRuleSelector{LabelSelector: &metav1.LabelSelector{
+				MatchExpressions: []metav1.LabelSelectorRequirement{{
+					Key:      "tier",
+					Operator: "Unknown",
+				}},
+			}},
+			input:   deploy,
+			matched: false,
+		},
+		"annotations match": {
+			selector: ResourcePolicyRuleSelector{Annotations: map[string]string{"owner": "team-a"}},
+			input:    deploy,
+			matched:  true,
+		},
+		"annotations mismatch": {
+			selector: ResourcePolicyRuleSelector{Annotations: map[string]string{"owner": "team-b"}},
+			input:    deploy,
+			matched:  false,
+		},
+		"namespace match": {
+			selector: ResourcePolicyRuleSelector{Namespaces: []string{"data"}},
+			input:    deploy,
+			matched:  true,
+		},
+		"api group match": {
+			selector: ResourcePolicyRuleSelector{APIGroups: []string{"apps"}},
+			input:    deploy,
+			matched:  true,
+		},
+		"core api group match": {
+			selector: ResourcePolicyRuleSelector{APIGroups: []string{CoreAPIGroup}},
+			input:    cm,
+			matched:  true,
+		},
+		"api version mismatch": {
+			selector: ResourcePolicyRuleSelector{APIVersions: []string{"apps/v1beta1"}},
+			input:    deploy,
+			matched:  false,
+		},
+		"glob name pattern match": {
+			selector: ResourcePolicyRuleSelector{ResourceNamePatterns: []string{"redis-*"}},
+			input:    deploy,
+			matched:  true,
+		},
+		"regexp name match": {
+			selector: ResourcePolicyRuleSelector{ResourceNameRegexps: []string{"^redis-(master|slave)$"}},
+			input:    deploy,
+			matched:  true,
+		},
+		"invalid regexp": {
+			selector: ResourcePolicyRuleSelector{ResourceNameRegexps: []string{"redis-("}},
+			input:    deploy,
+			matched:  false,
+		},
+		"combined conditions match": {
+			selector: ResourcePolicyRuleSelector{
+				CompNames:     []string{"redis"},
+				ResourceTypes: []string{"Deployment"},
+				LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"tier": "data"}},
+			},
+			input:   deploy,
+			matched: true,
+		},
+		"combined conditions with one failed": {
+			selector: ResourcePolicyRuleSelector{
+				CompNames:  []string{"redis"},
+				Namespaces: []string{"default"},
+			},
+			input:   deploy,
+			matched: false,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			r := require.New(t)
+			r.Equal(tc.matched, tc.selector.Match(tc.input))
+		})
+	}
+}
+
+func TestResourcePolicyRuleSelector_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		selector    ResourcePolicyRuleSelector
+		expectedErr string
+	}{
+		"valid": {
+			selector: ResourcePolicyRuleSelector{
+				ResourceNamePatterns: []string{"redis-*"},
+				ResourceNameRegexps:  []string{"^redis-(master|slave)$"},
+				LabelSelector:        &metav1.LabelSelector{MatchLabels: map[string]string{"tier": "data"}},
+			},
+		},
+		"invalid glob pattern": {
+			selector:    ResourcePolicyRuleSelector{ResourceNamePatterns: []string{"redis-["}},
+			expectedErr: `invalid resource name pattern "redis-["`,
+		},
+		"invalid regexp": {
+			selector:    ResourcePolicyRuleSelector{ResourceNameRegexps: []string{"redis-("}},
+			expectedErr: `invalid resource name regexp "redis-("`,
+		},
+		"invalid label selector": {
+			selector: ResourcePolicyRuleSelector{LabelSelector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{
+				{Key: "tier", Operator: "Bad"},
+			}}},
+			expectedErr: "invalid label selector",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			r := require.New(t)
+			err := tc.selector.Validate()
+			if tc.expectedErr == "" {
+				r.NoError(err)
+				return
+			}
+			r.ErrorContains(err, tc.expectedErr)
+		})
+	}
+}
@@ -16,7 +16,11 @@ limitations under the License.
 
 package v1alpha1
 
-import "k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
 
 const (
 	// ResourceUpdatePolicyType refers to the type of resource-update policy
@@ -68,3 +72,13 @@ func (in *ResourceUpdatePolicySpec) FindStrategy(manifest *unstructured.Unstruct
 	}
 	return nil
 }
+
+// Validate check if the selectors of the rules are valid
+func (in *ResourceUpdatePolicySpec) Validate() error {
+	for i, rule := range in.Rules {
+		if err := rule.Selector.Validate(); err != nil {
+			return fmt.Errorf("invalid selector in rule %d: %w", i, err)
+		}
+	}
+	return nil
+}
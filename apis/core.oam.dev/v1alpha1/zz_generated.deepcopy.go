@@ -21,6 +21,8 @@ limitations under the License.
 package v1alpha1
 
 import (
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 
 	"github.com/oam-dev/kubevela/apis/core.oam.dev/common"
@@ -89,6 +91,21 @@ func (in *ApplyOnceStrategy) DeepCopy() *ApplyOnceStrategy {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CapacityWeights) DeepCopyInto(out *CapacityWeights) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CapacityWeights.
+func (in *CapacityWeights) DeepCopy() *CapacityWeights {
+	if in == nil {
+		return nil
+	}
+	out := new(CapacityWeights)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterConnection) DeepCopyInto(out *ClusterConnection) {
 	*out = *in
@@ -104,6 +121,26 @@ func (in *ClusterConnection) DeepCopy() *ClusterConnection {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterFailover) DeepCopyInto(out *ClusterFailover) {
+	*out = *in
+	in.DisconnectedSince.DeepCopyInto(&out.DisconnectedSince)
+	if in.FailoverTime != nil {
+		in, out := &in.FailoverTime, &out.FailoverTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterFailover.
+func (in *ClusterFailover) DeepCopy() *ClusterFailover {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterFailover)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EnvBindingSpec) DeepCopyInto(out *EnvBindingSpec) {
 	*out = *in
@@ -309,6 +346,26 @@ func (in *EnvTraitPatch) DeepCopy() *EnvTraitPatch {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FailoverPolicy) DeepCopyInto(out *FailoverPolicy) {
+	*out = *in
+	if in.GracePeriod != nil {
+		in, out := &in.GracePeriod, &out.GracePeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FailoverPolicy.
+func (in *FailoverPolicy) DeepCopy() *FailoverPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(FailoverPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GarbageCollectPolicyRule) DeepCopyInto(out *GarbageCollectPolicyRule) {
 	*out = *in
@@ -318,6 +375,11 @@ func (in *GarbageCollectPolicyRule) DeepCopyInto(out *GarbageCollectPolicyRule)
 		*out = new(GarbageCollectPropagation)
 		**out = **in
 	}
+	if in.TTL != nil {
+		in, out := &in.TTL, &out.TTL
+		*out = new(v1.Duration)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GarbageCollectPolicyRule.
@@ -338,6 +400,11 @@ func (in *GarbageCollectPolicySpec) DeepCopyInto(out *GarbageCollectPolicySpec)
 		*out = new(int)
 		**out = **in
 	}
+	if in.ApplicationRevisionRetention != nil {
+		in, out := &in.ApplicationRevisionRetention, &out.ApplicationRevisionRetention
+		*out = new(v1.Duration)
+		**out = **in
+	}
 	if in.Rules != nil {
 		in, out := &in.Rules, &out.Rules
 		*out = make([]GarbageCollectPolicyRule, len(*in))
@@ -532,6 +599,26 @@ func (in *PlacementDecision) DeepCopy() *PlacementDecision {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlacementStrategy) DeepCopyInto(out *PlacementStrategy) {
+	*out = *in
+	if in.CapacityWeights != nil {
+		in, out := &in.CapacityWeights, &out.CapacityWeights
+		*out = new(CapacityWeights)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlacementStrategy.
+func (in *PlacementStrategy) DeepCopy() *PlacementStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(PlacementStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Policy) DeepCopyInto(out *Policy) {
 	*out = *in
@@ -684,6 +771,111 @@ func (in *ReplicationPolicySpec) DeepCopy() *ReplicationPolicySpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceAdmissionPolicy) DeepCopyInto(out *ResourceAdmissionPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceAdmissionPolicy.
+func (in *ResourceAdmissionPolicy) DeepCopy() *ResourceAdmissionPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceAdmissionPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ResourceAdmissionPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceAdmissionPolicyList) DeepCopyInto(out *ResourceAdmissionPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ResourceAdmissionPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceAdmissionPolicyList.
+func (in *ResourceAdmissionPolicyList) DeepCopy() *ResourceAdmissionPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceAdmissionPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ResourceAdmissionPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceAdmissionPolicySpec) DeepCopyInto(out *ResourceAdmissionPolicySpec) {
+	*out = *in
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]ResourceAdmissionRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceAdmissionPolicySpec.
+func (in *ResourceAdmissionPolicySpec) DeepCopy() *ResourceAdmissionPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceAdmissionPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceAdmissionRule) DeepCopyInto(out *ResourceAdmissionRule) {
+	*out = *in
+	if in.Operations != nil {
+		in, out := &in.Operations, &out.Operations
+		*out = make([]ResourceAdmissionOperation, len(*in))
+		copy(*out, *in)
+	}
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(ResourcePolicyRuleSelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceAdmissionRule.
+func (in *ResourceAdmissionRule) DeepCopy() *ResourceAdmissionRule {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceAdmissionRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourcePolicyRuleSelector) DeepCopyInto(out *ResourcePolicyRuleSelector) {
 	*out = *in
@@ -717,6 +909,43 @@ func (in *ResourcePolicyRuleSelector) DeepCopyInto(out *ResourcePolicyRuleSelect
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.ResourceNamePatterns != nil {
+		in, out := &in.ResourceNamePatterns, &out.ResourceNamePatterns
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ResourceNameRegexps != nil {
+		in, out := &in.ResourceNameRegexps, &out.ResourceNameRegexps
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.APIGroups != nil {
+		in, out := &in.APIGroups, &out.APIGroups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.APIVersions != nil {
+		in, out := &in.APIVersions, &out.APIVersions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.LabelSelector != nil {
+		in, out := &in.LabelSelector, &out.LabelSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourcePolicyRuleSelector.
@@ -868,6 +1097,23 @@ func (in *TakeOverPolicySpec) DeepCopy() *TakeOverPolicySpec {
 func (in *TopologyPolicySpec) DeepCopyInto(out *TopologyPolicySpec) {
 	*out = *in
 	in.Placement.DeepCopyInto(&out.Placement)
+	if in.Strategy != nil {
+		in, out := &in.Strategy, &out.Strategy
+		*out = new(PlacementStrategy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]corev1.Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Failover != nil {
+		in, out := &in.Failover, &out.Failover
+		*out = new(FailoverPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TopologyPolicySpec.
@@ -879,3 +1125,50 @@ func (in *TopologyPolicySpec) DeepCopy() *TopologyPolicySpec {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TopologyPolicyStatus) DeepCopyInto(out *TopologyPolicyStatus) {
+	*out = *in
+	if in.Strategy != nil {
+		in, out := &in.Strategy, &out.Strategy
+		*out = new(PlacementStrategy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Candidates != nil {
+		in, out := &in.Candidates, &out.Candidates
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Decisions != nil {
+		in, out := &in.Decisions, &out.Decisions
+		*out = make([]PlacementDecision, len(*in))
+		copy(*out, *in)
+	}
+	if in.Failovers != nil {
+		in, out := &in.Failovers, &out.Failovers
+		*out = make([]ClusterFailover, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.GroupMembers != nil {
+		in, out := &in.GroupMembers, &out.GroupMembers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Released != nil {
+		in, out := &in.Released, &out.Released
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TopologyPolicyStatus.
+func (in *TopologyPolicyStatus) DeepCopy() *TopologyPolicyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TopologyPolicyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -42,9 +42,13 @@ type AppPolicy struct {
 
 // Workflow defines workflow steps and other attributes
 type Workflow struct {
-	Ref   string                                `json:"ref,omitempty"`
-	Mode  *workflowv1alpha1.WorkflowExecuteMode `json:"mode,omitempty"`
-	Steps []workflowv1alpha1.WorkflowStep       `json:"steps,omitempty"`
+	Ref string `json:"ref,omitempty"`
+	// Parameters are the values of the parameters declared by the referenced workflow, which are
+	// substituted into the properties of the workflow steps.
+	// +kubebuilder:pruning:PreserveUnknownFields
+	Parameters *runtime.RawExtension                 `json:"parameters,omitempty"`
+	Mode       *workflowv1alpha1.WorkflowExecuteMode `json:"mode,omitempty"`
+	Steps      []workflowv1alpha1.WorkflowStep       `json:"steps,omitempty"`
 }
 
 // ApplicationSpec is the spec of Application
@@ -135,6 +135,8 @@ type ManagedResource struct {
 	Deleted bool `json:"deleted,omitempty"`
 	// SkipGC marks the resource to skip gc
 	SkipGC bool `json:"skipGC,omitempty"`
+	// DeleteAfter records when the outdated resource kept by the ttl of garbage-collect policy will be recycled
+	DeleteAfter *metav1.Time `json:"deleteAfter,omitempty"`
 }
 
 // Equal check if two managed resource equals
@@ -18,12 +18,14 @@ package types
 
 // reason for Application
 const (
-	ReasonParsed          = "Parsed"
-	ReasonRendered        = "Rendered"
-	ReasonPolicyGenerated = "PolicyGenerated"
-	ReasonRevisoned       = "Revisioned"
-	ReasonApplied         = "Applied"
-	ReasonDeployed        = "Deployed"
+	ReasonParsed           = "Parsed"
+	ReasonRendered         = "Rendered"
+	ReasonPolicyGenerated  = "PolicyGenerated"
+	ReasonRevisoned        = "Revisioned"
+	ReasonApplied          = "Applied"
+	ReasonDeployed         = "Deployed"
+	ReasonClusterFailover  = "ClusterFailover"
+	ReasonWorkflowOperated = "WorkflowOperated"
 
 	ReasonFailedParse     = "FailedParse"
 	ReasonFailedRevision  = "FailedRevision"
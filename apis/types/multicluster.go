@@ -39,6 +39,10 @@ const (
 var (
 	// AnnotationClusterVersion the annotation key for cluster version
 	AnnotationClusterVersion = config.MetaApiGroupName + "/cluster-version"
+	// AnnotationClusterTaints the annotation key for cluster taints, the value is the json of taints
+	AnnotationClusterTaints = config.MetaApiGroupName + "/cluster-taints"
+	// LabelClusterGroup the label key for the configmap of cluster group, the value is the name of the group
+	LabelClusterGroup = config.MetaApiGroupName + "/cluster-group"
 )
 
 // ClusterVersion defines the Version info of managed clusters.
@@ -98,6 +98,17 @@ const (
 	AnnotationConfigAlias = "config.oam.dev/alias"
 	// AnnotationConfigDistributionSpec is the annotation key of the application that distributes the configs
 	AnnotationConfigDistributionSpec = "config.oam.dev/distribution-spec"
+	// AnnotationConfigVersion is the annotation for the version of the config, it increases when the config changes
+	AnnotationConfigVersion = "config.oam.dev/version"
+	// AnnotationConfigVersionTime is the annotation for the time when the version of the config is created
+	AnnotationConfigVersionTime = "config.oam.dev/version-time"
+	// AnnotationConfigRotatedTime is the annotation for the time when the config is rotated last time
+	AnnotationConfigRotatedTime = "config.oam.dev/rotated-time"
+	// AnnotationConfigDistributionSyncStatus is the annotation of the distribution recording whether the distributed
+	// configs in the targets are in sync with the configs
+	AnnotationConfigDistributionSyncStatus = "config.oam.dev/distribution-sync-status"
+	// LabelConfigHistoryOf is the label marked as the name of the config that the history secret belongs to
+	LabelConfigHistoryOf = "config.oam.dev/history-of"
 )
 
 const (
@@ -175,6 +186,8 @@ const (
 	HelmRepository = "helm-repository"
 	// CatalogConfigDistribution is the catalog type
 	CatalogConfigDistribution = "config-distribution"
+	// CatalogConfigHistory is the catalog type of the secrets saving the previous versions of the configs
+	CatalogConfigHistory = "config-history"
 )
 
 const (
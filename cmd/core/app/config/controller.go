@@ -17,6 +17,8 @@ limitations under the License.
 package config
 
 import (
+	"time"
+
 	"github.com/spf13/pflag"
 
 	oamcontroller "github.com/oam-dev/kubevela/pkg/controller/core.oam.dev"
@@ -29,6 +31,15 @@ import (
 type ControllerConfig struct {
 	// Embed the existing Args struct to reuse its fields
 	oamcontroller.Args
+
+	// ConfigRotationCheckPeriod is the period to check and rotate the configs whose rotation interval is reached.
+	// The scheduled rotation is disabled if it is 0.
+	ConfigRotationCheckPeriod time.Duration
+	// ConfigDriftCheckPeriod is the period to check whether the distributed configs are in sync with the configs.
+	// The check is disabled if it is 0.
+	ConfigDriftCheckPeriod time.Duration
+	// ConfigDriftAutoResync re-applies the distributions if the distributed configs are out of sync
+	ConfigDriftAutoResync bool
 }
 
 // NewControllerConfig creates a new ControllerConfig with defaults.
@@ -43,6 +54,8 @@ func NewControllerConfig() *ControllerConfig {
 			IgnoreAppWithoutControllerRequirement:        false,
 			IgnoreDefinitionWithoutControllerRequirement: false,
 		},
+		ConfigRotationCheckPeriod: 5 * time.Minute,
+		ConfigDriftCheckPeriod:    5 * time.Minute,
 	}
 }
 
@@ -64,4 +77,10 @@ func (c *ControllerConfig) AddFlags(fs *pflag.FlagSet) {
 		"If true, application controller will not process the app without 'app.oam.dev/controller-version-require' annotation")
 	fs.BoolVar(&c.IgnoreDefinitionWithoutControllerRequirement, "ignore-definition-without-controller-version", c.IgnoreDefinitionWithoutControllerRequirement,
 		"If true, trait/component/workflowstep definition controller will not process the definition without 'definition.oam.dev/controller-version-require' annotation")
+	fs.DurationVar(&c.ConfigRotationCheckPeriod, "config-rotation-check-period", c.ConfigRotationCheckPeriod,
+		"config-rotation-check-period is the period to check and rotate the configs whose rotation interval defined in the template is reached. Set it to 0 to disable the scheduled rotation. The default value is 5m.")
+	fs.DurationVar(&c.ConfigDriftCheckPeriod, "config-drift-check-period", c.ConfigDriftCheckPeriod,
+		"config-drift-check-period is the period to check whether the distributed configs in the target clusters are changed or deleted. Set it to 0 to disable the check. The default value is 5m.")
+	fs.BoolVar(&c.ConfigDriftAutoResync, "config-drift-auto-resync", c.ConfigDriftAutoResync,
+		"If true, the distributions are re-applied when the distributed configs are found out of sync.")
 }
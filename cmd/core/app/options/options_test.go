@@ -84,6 +84,9 @@ func TestNewCoreOptions_DefaultValues(t *testing.T) {
 	assert.Equal(t, 4, opt.Controller.ConcurrentReconciles)
 	assert.Equal(t, false, opt.Controller.IgnoreAppWithoutControllerRequirement)
 	assert.Equal(t, false, opt.Controller.IgnoreDefinitionWithoutControllerRequirement)
+	assert.Equal(t, 5*time.Minute, opt.Controller.ConfigRotationCheckPeriod)
+	assert.Equal(t, 5*time.Minute, opt.Controller.ConfigDriftCheckPeriod)
+	assert.Equal(t, false, opt.Controller.ConfigDriftAutoResync)
 
 	// Test Workflow defaults
 	assert.Equal(t, 60, opt.Workflow.MaxWaitBackoffTime)
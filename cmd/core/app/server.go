@@ -52,6 +52,7 @@ import (
 	"github.com/oam-dev/kubevela/cmd/core/app/options"
 	"github.com/oam-dev/kubevela/pkg/auth"
 	"github.com/oam-dev/kubevela/pkg/cache"
+	velaconfig "github.com/oam-dev/kubevela/pkg/config"
 	commonconfig "github.com/oam-dev/kubevela/pkg/controller/common"
 	oamv1beta1 "github.com/oam-dev/kubevela/pkg/controller/core.oam.dev/v1beta1"
 	"github.com/oam-dev/kubevela/pkg/controller/core.oam.dev/v1beta1/application"
@@ -471,6 +472,21 @@ func prepareRun(ctx context.Context, manager manager.Manager, coreOptions *optio
 	}
 	klog.InfoS("OAM controllers setup completed successfully")
 
+	if period := coreOptions.Controller.ConfigRotationCheckPeriod; period > 0 {
+		klog.V(2).InfoS("Registering the config rotation manager", "period", period)
+		if err := manager.Add(velaconfig.NewRotationManager(manager.GetClient(), period)); err != nil {
+			klog.ErrorS(err, "Unable to add the config rotation manager")
+			return err
+		}
+	}
+	if period := coreOptions.Controller.ConfigDriftCheckPeriod; period > 0 {
+		klog.V(2).InfoS("Registering the config drift manager", "period", period, "autoResync", coreOptions.Controller.ConfigDriftAutoResync)
+		if err := manager.Add(velaconfig.NewDriftManager(manager.GetClient(), period, coreOptions.Controller.ConfigDriftAutoResync)); err != nil {
+			klog.ErrorS(err, "Unable to add the config drift manager")
+			return err
+		}
+	}
+
 	klog.V(2).InfoS("Initializing control plane cluster info")
 	if err := multicluster.InitClusterInfo(manager.GetConfig()); err != nil {
 		klog.ErrorS(err, "Failed to init control plane cluster info")
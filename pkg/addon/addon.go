@@ -27,7 +27,6 @@ import (
 	"os"
 	"path"
 	"path/filepath"
-	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -39,7 +38,6 @@ import (
 	"github.com/imdario/mergo"
 	"github.com/pkg/errors"
 	gitlab "gitlab.com/gitlab-org/api/client-go"
-	"go.uber.org/multierr"
 	"golang.org/x/oauth2"
 	"helm.sh/helm/v3/pkg/chart/loader"
 	"helm.sh/helm/v3/pkg/chartutil"
@@ -72,7 +70,6 @@ import (
 	"github.com/oam-dev/kubevela/pkg/utils"
 	addonutil "github.com/oam-dev/kubevela/pkg/utils/addon"
 	"github.com/oam-dev/kubevela/pkg/utils/apply"
-	"github.com/oam-dev/kubevela/pkg/utils/common"
 	"github.com/oam-dev/kubevela/pkg/velaql"
 	version2 "github.com/oam-dev/kubevela/version"
 )
@@ -894,6 +891,10 @@ type Installer struct {
 	dc                  *discovery.DiscoveryClient
 	skipVersionValidate bool
 	overrideDefs        bool
+	insecureSkipVerify  bool
+	updateDependencies  bool
+	// resolution is the resolved dependencies of the addon to be enabled, which is shared with the dependent addons
+	resolution *Resolution
 
 	dryRun     bool
 	dryRunBuff *bytes.Buffer
@@ -957,6 +958,11 @@ func (h *Installer) enableAddon(ctx context.Context, addon *InstallPackage) (str
 	if err := h.continueOrRestartWorkflow(); err != nil {
 		return "", err
 	}
+	if !h.dryRun {
+		if err := h.lockAddon(ctx, addon); err != nil {
+			return "", errors.Wrapf(err, "fail to save the lock record of addon %s", addon.Name)
+		}
+	}
 	additionalInfo, err := h.renderNotes(addon)
 	if err != nil {
 		klog.Warningf("fail to render notes for addon %s: %v\n", addon.Name, err)
@@ -969,7 +975,11 @@ func (h *Installer) enableAddon(ctx context.Context, addon *InstallPackage) (str
 func (h *Installer) loadInstallPackage(name, version string) (*InstallPackage, error) {
 	var installPackage *InstallPackage
 	var err error
-	if !IsVersionRegistry(*h.r) {
+	r := *h.r
+	if h.insecureSkipVerify {
+		r.PublicKeys = nil
+	}
+	if !IsVersionRegistry(r) {
 		metas, err := h.getAddonMeta()
 		if err != nil {
 			return nil, errors.Wrap(err, "fail to get addon meta")
@@ -985,16 +995,16 @@ func (h *Installer) loadInstallPackage(name, version string) (*InstallPackage, e
 			return nil, err
 		}
 		// enable this addon if it's invisible
-		installPackage, err = h.r.GetInstallPackage(&meta, uiData)
+		installPackage, err = r.GetInstallPackage(&meta, uiData)
 		if err != nil {
 			return nil, errors.Wrap(err, "fail to find dependent addon in source repository")
 		}
 	} else {
-		versionedRegistry := BuildVersionedRegistry(h.r.Name, h.r.Helm.URL, &common.HTTPOption{
-			Username:        h.r.Helm.Username,
-			Password:        h.r.Helm.Password,
-			InsecureSkipTLS: h.r.Helm.InsecureSkipTLS,
-		})
+		var versionedRegistry VersionedRegistry
+		versionedRegistry, err = ToVersionedRegistry(r)
+		if err != nil {
+			return nil, err
+		}
 		installPackage, err = versionedRegistry.GetAddonInstallPackage(context.Background(), name, version)
 		if err != nil {
 			return nil, err
@@ -1014,36 +1024,34 @@ func (h *Installer) getAddonMeta() (map[string]SourceMeta, error) {
 	return h.registryMeta, nil
 }
 
-// installDependency checks if addon's dependency and install it
+// installDependency resolves the versions of the addon's dependencies from all registries and installs them in order
 func (h *Installer) installDependency(ctx context.Context, addon *InstallPackage) error {
-	installedAddons, err := listInstalledAddons(h.ctx, h.cli)
-	if err != nil {
-		return err
-	}
-
-	var registries []ItemInfoLister
-	registries = append(registries, h.r)
-	for _, registry := range h.registries {
-		r := registry
-		registries = append(registries, &r)
+	// the dependencies of a dependent addon have been resolved and installed in order by the upstream addon
+	if h.dependenciesResolved(addon) {
+		return nil
 	}
-	availableAddons, err := listAvailableAddons(registries)
-	if err != nil {
-		return err
-	}
-
-	err = validateAddonDependencies(addon, installedAddons, availableAddons)
+	resolution, err := h.resolveDependencies(ctx, addon)
 	if err != nil {
 		return err
 	}
+	h.resolution = resolution
 
 	var dependencies []string
 	var addonClusters = getClusters(h.args)
-	for _, dep := range addon.Dependencies {
+	for _, dep := range resolution.Addons {
+		if dep.Name == addon.Name {
+			continue
+		}
 		needInstallAddonDep, err := checkDependencyNeedInstall(h.ctx, h.cli, dep.Name, addonClusters)
 		if err != nil {
 			return err
 		}
+		if !needInstallAddonDep && !dep.Installed && h.updateDependencies {
+			needInstallAddonDep, err = checkDependencyNeedUpgrade(h.ctx, h.cli, dep.Name)
+			if err != nil {
+				return err
+			}
+		}
 		if !needInstallAddonDep {
 			continue
 		}
@@ -1058,54 +1066,19 @@ func (h *Installer) installDependency(ctx context.Context, addon *InstallPackage
 		if depArgsErr != nil {
 			return depArgsErr
 		}
-
 		depHandler.args = depArgs
 
-		var depAddon *InstallPackage
-		depVersion, err := calculateDependencyVersionToInstall(*dep, installedAddons, availableAddons)
+		depAddon, err := depHandler.loadDependencyPackage(dep)
 		if err != nil {
 			return err
 		}
-		// try to install the dependent addon from the same registry with the current addon
-		depAddon, err = h.loadInstallPackage(dep.Name, depVersion)
-		if err == nil {
-			additionalInfo, err := depHandler.enableAddon(ctx, depAddon)
-			if err != nil {
-				return errors.Wrap(err, "fail to dispatch dependent addon resource")
-			}
-			if len(additionalInfo) > 0 {
-				klog.Infof("addon %s installed with additional info: %s\n", addon.Name, additionalInfo)
-			}
-			return nil
+		additionalInfo, err := depHandler.enableAddon(ctx, depAddon)
+		if err != nil {
+			return errors.Wrap(err, "fail to dispatch dependent addon resource")
 		}
-		if !errors.Is(err, ErrNotExist) {
-			return err
+		if len(additionalInfo) > 0 {
+			klog.Infof("addon %s installed with additional info: %s\n", dep.Name, additionalInfo)
 		}
-		for _, registry := range h.registries {
-			// try to install dependent addon from other registries
-			depHandler.r = &Registry{
-				Name: registry.Name, Helm: registry.Helm, OSS: registry.OSS, Git: registry.Git, Gitee: registry.Gitee, Gitlab: registry.Gitlab,
-			}
-			depAddon, err = depHandler.loadInstallPackage(dep.Name, depVersion)
-			if err == nil {
-				break
-			}
-			if errors.Is(err, ErrNotExist) {
-				continue
-			}
-			return err
-		}
-		if err == nil {
-			additionalInfo, err := depHandler.enableAddon(ctx, depAddon)
-			if err != nil {
-				return errors.Wrap(err, "fail to dispatch dependent addon resource")
-			}
-			if len(additionalInfo) > 0 {
-				klog.Infof("addon %s installed with additional info: %s\n", addon.Name, additionalInfo)
-			}
-			return nil
-		}
-		return fmt.Errorf("dependency addon: %s with version: %s cannot be found from all registries", dep.Name, depVersion)
 	}
 	if h.dryRun && len(dependencies) > 0 {
 		klog.Warningf("dry run addon won't install dependencies, please make sure your system has already installed these addons: %v", strings.Join(dependencies, ", "))
@@ -1114,156 +1087,86 @@ func (h *Installer) installDependency(ctx context.Context, addon *InstallPackage
 	return nil
 }
 
-// validateAddonDependencies checks if addon's dependencies can be satisfied.
-// If dependency is installed, check if the version matches required version.
-// If dependency is not installed, check available addons for dependency
-// matching the required version.
-// Return error if any dependency cannot be satisfied.
-func validateAddonDependencies(addon *InstallPackage, installedAddons itemInfoMap, availableAddons itemInfoMap) error {
-	var merr error
-	for _, dep := range addon.Dependencies {
-		_, err := calculateDependencyVersionToInstall(*dep, installedAddons, availableAddons)
-		if err != nil {
-			merr = multierr.Append(merr, fmt.Errorf("addon %s has unresolvable dependency %s: %w", addon.Name, dep.Name, err))
-		}
+// dependenciesResolved checks whether the shared resolution is resolved for the same version of the addon, it
+// must be resolved again if the addon to install is not the resolved version
+func (h *Installer) dependenciesResolved(addon *InstallPackage) bool {
+	if h.resolution == nil {
+		return false
 	}
-	return merr
+	resolved := h.resolution.Get(addon.Name)
+	return resolved != nil && resolved.Version == addon.Version
 }
 
-// calculateDependencyVersionToInstall compares an addon's dependency to a list
-// of installed and available addons and returns a version to install.
-// If dependency is installed, return the installed version if it matches the
-// required version.
-// If dependency is not installed, return the latest available version that
-// satisfies the dependency version.
-// Return error if dependency version cannot be satisfied.
-func calculateDependencyVersionToInstall(dependency Dependency, installedAddons itemInfoMap, availableAddons itemInfoMap) (string, error) {
-	if dependency.Name == "" {
-		return "", fmt.Errorf("dependency name cannot be empty")
+// resolveDependencies chooses the versions of all the addons in the dependency graph of the addon
+func (h *Installer) resolveDependencies(ctx context.Context, addon *InstallPackage) (*Resolution, error) {
+	installedAddons, err := listInstalledAddons(h.ctx, h.cli)
+	if err != nil {
+		return nil, err
 	}
-
-	// if dependency is installed, return the installed version if it matches
-	// the required version
-	if installedAddons != nil {
-		installedAddon, ok := installedAddons[dependency.Name]
-		if ok {
-			// versions length must be 1
-			if len(installedAddon.AvailableVersions) != 1 {
-				return "", errors.New("installedAddon.Versions length must be 1")
-			}
-			installedVersion := installedAddon.AvailableVersions[0]
-
-			if dependency.Version == "" {
-				return installedVersion, nil
-			}
-
-			match, _ := checkSemVer(installedVersion, dependency.Version)
-			if match {
-				return installedVersion, nil
-			}
-
-			return "", fmt.Errorf("addon %s version '%s' does not match installed version '%s'",
-				dependency.Name, dependency.Version, installedVersion)
-		}
+	installed := map[string]string{}
+	for name, item := range installedAddons {
+		installed[name] = item.AvailableVersions[0]
 	}
-
-	availableAddon, ok := availableAddons[dependency.Name]
-	if !ok {
-		return "", fmt.Errorf("no available addon with name %s", dependency.Name)
+	locks, err := GetAddonLocks(h.ctx, h.cli)
+	if err != nil {
+		return nil, err
 	}
-
-	sortedVersions := sortVersionsDescending(availableAddon.AvailableVersions)
-
-	// if no version is specified, return the latest version
-	if dependency.Version == "" {
-		return sortedVersions[0], nil
+	resolver := NewDependencyResolver(h.candidateRegistries(), installed, locks, h.updateDependencies)
+	resolution, err := resolver.Resolve(ctx, addon)
+	if err != nil {
+		return nil, errors.Wrapf(err, "fail to resolve the dependencies of addon %s", addon.Name)
 	}
+	return resolution, nil
+}
 
-	// check if the dependency version is satisfied
-	var match bool
-	for _, version := range sortedVersions {
-		match, _ = checkSemVer(version, dependency.Version)
-		if match {
-			return version, nil
+// candidateRegistries return the registries to find the dependencies, the registry of the current addon comes first
+func (h *Installer) candidateRegistries() []Registry {
+	registries := []Registry{*h.r}
+	for _, registry := range h.registries {
+		if registry.Name != h.r.Name {
+			registries = append(registries, registry)
 		}
 	}
-
-	// no available version satisfies the dependency version
-	return "", fmt.Errorf("no available addon with name %s and version '%s', available versions %s",
-		dependency.Name, dependency.Version, availableAddon.AvailableVersions)
+	return registries
 }
 
-func sortVersionsDescending(versions []string) []string {
-	var sortedVersions []*semver.Version
-	var sortedVersionStrings []string
-	for _, v := range versions {
-		var err error
-		// Note: NewVersion attempts to convert SemVer-ish formats into SemVer
-		parsedVersion, err := semver.NewVersion(v)
-		if err == nil {
-			sortedVersions = append(sortedVersions, parsedVersion)
+// loadDependencyPackage loads the resolved dependency from the registry it's resolved from. If the registry is
+// unknown, e.g. the dependency is installed before the lock record is introduced, all the registries are tried.
+func (h *Installer) loadDependencyPackage(dep *ResolvedAddon) (*InstallPackage, error) {
+	var registries []Registry
+	for _, registry := range h.candidateRegistries() {
+		if dep.Registry == "" || registry.Name == dep.Registry {
+			registries = append(registries, registry)
 		}
 	}
-	// sort versions in descending order
-	sort.Sort(sort.Reverse(semver.Collection(sortedVersions)))
-	for _, v := range sortedVersions {
-		sortedVersionStrings = append(sortedVersionStrings, v.String())
-	}
-	return sortedVersionStrings
-}
-
-// ItemInfoLister is an interface for Registry.ListAddonInfo() to enable easier
-// testing with mocks.
-type ItemInfoLister interface {
-	ListAddonInfo() (map[string]ItemInfo, error)
-}
-
-// listAvailableAddons fetches a collection of addons available in a list of
-// registries. Returns a map of ItemInfo grouped by addon name.
-func listAvailableAddons(registries []ItemInfoLister) (itemInfoMap, error) {
-	availableAddons := make(itemInfoMap)
-
-	for _, registry := range registries {
-		addons, err := registry.ListAddonInfo()
-		if err != nil {
+	for i := range registries {
+		h.r = &registries[i]
+		h.registryMeta = nil
+		depAddon, err := h.loadInstallPackage(dep.Name, dep.Version)
+		if err == nil {
+			return depAddon, nil
+		}
+		if !errors.Is(err, ErrNotExist) {
 			return nil, err
 		}
-		availableAddons = mergeAddonInfoMaps(availableAddons, addons)
 	}
-	return availableAddons, nil
+	return nil, fmt.Errorf("dependency addon: %s with version: %s cannot be found from all registries", dep.Name, dep.Version)
 }
 
-func mergeAddonInfoMaps(existingAddons itemInfoMap, newAddons itemInfoMap) itemInfoMap {
-	mergedAddons := existingAddons
-	for _, newAddon := range newAddons {
-		if existingAddon, ok := existingAddons[newAddon.Name]; ok {
-			// merge addon versions
-			existingVersions := existingAddon.AvailableVersions
-			newVersions := newAddon.AvailableVersions
-
-			mergedVersionsSet := make(map[string]bool)
-
-			for _, item := range existingVersions {
-				mergedVersionsSet[item] = true
-			}
-			for _, item := range newVersions {
-				mergedVersionsSet[item] = true
+// lockAddon saves the lock record of the installed addon with the resolved versions of its dependencies
+func (h *Installer) lockAddon(ctx context.Context, addon *InstallPackage) error {
+	lock := &AddonLock{Name: addon.Name, Version: addon.Version, Registry: h.r.Name, Dependencies: addon.Dependencies}
+	if h.resolution != nil {
+		for _, dep := range addon.Dependencies {
+			if resolved := h.resolution.Get(dep.Name); resolved != nil {
+				if lock.ResolvedDependencies == nil {
+					lock.ResolvedDependencies = map[string]string{}
+				}
+				lock.ResolvedDependencies[dep.Name] = resolved.Version
 			}
-
-			mergedVersions := make([]string, 0, len(mergedVersionsSet))
-			for item := range mergedVersionsSet {
-				mergedVersions = append(mergedVersions, item)
-			}
-
-			mergedVersions = sortVersionsDescending(mergedVersions)
-
-			existingAddon.AvailableVersions = mergedVersions
-			mergedAddons[existingAddon.Name] = existingAddon
-		} else {
-			mergedAddons[newAddon.Name] = newAddon
 		}
 	}
-	return mergedAddons
+	return saveAddonLock(ctx, h.cli, addon.Name, lock)
 }
 
 // listInstalledAddons fetches a collection of addons installed in the cluster.
@@ -1350,6 +1253,23 @@ func checkDependencyNeedInstall(ctx context.Context, k8sClient client.Client, de
 	return needInstallAddonDep, nil
 }
 
+// checkDependencyNeedUpgrade checks whether the installed dependency can be upgraded to the resolved version, the
+// addons installed locally are never overridden
+func checkDependencyNeedUpgrade(ctx context.Context, k8sClient client.Client, depName string) (bool, error) {
+	depApp, err := FetchAddonRelatedApp(ctx, k8sClient, depName)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return true, nil
+		}
+		return false, err
+	}
+	if depApp.GetLabels()[oam.LabelAddonRegistry] == LocalAddonRegistryName {
+		klog.Warningf("%v is installed locally and won't be upgraded with the dependencies, please upgrade it manually.", depName)
+		return false, nil
+	}
+	return true, nil
+}
+
 // getDependencyArgs get the dependent addon's install args according to the upstream addon's clusters parameter's value
 // If dep addon has not defined clusters parameter, don't need to set clusters parameter value,
 // If dep addon has not installed, set the clusters value same to addon's clusters
@@ -1683,16 +1603,20 @@ func (h *Installer) continueOrRestartWorkflow() error {
 // getAddonVersionMeetSystemRequirement return the addon's latest version which meet the system requirements
 func (h *Installer) getAddonVersionMeetSystemRequirement(addonName string) string {
 	if h.r != nil && IsVersionRegistry(*h.r) {
-		versionedRegistry := BuildVersionedRegistry(h.r.Name, h.r.Helm.URL, &common.HTTPOption{
-			Username: h.r.Helm.Username,
-			Password: h.r.Helm.Password,
-		})
+		versionedRegistry, err := ToVersionedRegistry(*h.r)
+		if err != nil {
+			return ""
+		}
 		versions, err := versionedRegistry.GetAddonAvailableVersion(addonName)
 		if err != nil {
 			return ""
 		}
 		for _, version := range versions {
-			req := LoadSystemRequirements(version.Annotations)
+			annotations, err := loadVersionAnnotations(h.ctx, versionedRegistry, addonName, version)
+			if err != nil {
+				return ""
+			}
+			req := LoadSystemRequirements(annotations)
 			if checkAddonVersionMeetRequired(h.ctx, req, h.cli, h.dc) == nil {
 				return version.Version
 			}
@@ -26,7 +26,6 @@ import (
 	"k8s.io/klog/v2"
 
 	"github.com/oam-dev/kubevela/pkg/utils"
-	"github.com/oam-dev/kubevela/pkg/utils/common"
 )
 
 // We have three addon layer here
@@ -112,11 +111,11 @@ func (u *Cache) GetUIData(r Registry, addonName, version string) (*UIData, error
 			return nil, err
 		}
 	} else {
-		versionedRegistry := BuildVersionedRegistry(r.Name, r.Helm.URL, &common.HTTPOption{
-			Username:        r.Helm.Username,
-			Password:        r.Helm.Password,
-			InsecureSkipTLS: r.Helm.InsecureSkipTLS,
-		})
+		var versionedRegistry VersionedRegistry
+		versionedRegistry, err = ToVersionedRegistry(r)
+		if err != nil {
+			return nil, err
+		}
 		addon, err = versionedRegistry.GetAddonUIData(context.Background(), addonName, version)
 		if err != nil {
 			klog.Errorf("fail to get addons from registry %s for cache updating, %v", utils.Sanitize(r.Name), err)
@@ -321,11 +320,10 @@ func (u *Cache) listUIDataAndCache(r Registry) ([]*UIData, error) {
 }
 
 func (u *Cache) listVersionRegistryUIDataAndCache(r Registry) ([]*UIData, error) {
-	versionedRegistry := BuildVersionedRegistry(r.Name, r.Helm.URL, &common.HTTPOption{
-		Username:        r.Helm.Username,
-		Password:        r.Helm.Password,
-		InsecureSkipTLS: r.Helm.InsecureSkipTLS,
-	})
+	versionedRegistry, err := ToVersionedRegistry(r)
+	if err != nil {
+		return nil, err
+	}
 	uiDatas, err := versionedRegistry.ListAddon()
 	if err != nil {
 		klog.Errorf("fail to get addons from registry %s for cache updating, %v", r.Name, err)
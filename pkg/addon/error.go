@@ -46,6 +46,12 @@ var (
 
 	// ErrFetch means fetch addon package error(package not exist or parse archive error and so on)
 	ErrFetch = NewAddonError("cannot fetch addon package")
+
+	// ErrUnsigned means the addon package has no signature while the registry requires one
+	ErrUnsigned = NewAddonError("addon package is not signed")
+
+	// ErrSignatureMismatch means the addon package is tampered or not signed by the trusted keys
+	ErrSignatureMismatch = NewAddonError("addon package signature verification failed")
 )
 
 // WrapErrRateLimit return ErrRateLimit if is the situation, or return error directly
@@ -35,7 +35,6 @@ import (
 	"github.com/oam-dev/kubevela/pkg/oam"
 	addonutil "github.com/oam-dev/kubevela/pkg/utils/addon"
 	"github.com/oam-dev/kubevela/pkg/utils/apply"
-	"github.com/oam-dev/kubevela/pkg/utils/common"
 )
 
 const (
@@ -83,7 +82,10 @@ func DisableAddon(ctx context.Context, cli client.Client, name string, config *r
 		}
 	}
 
-	return cli.Delete(ctx, app)
+	if err := cli.Delete(ctx, app); err != nil {
+		return err
+	}
+	return saveAddonLock(ctx, cli, name, nil)
 }
 
 // EnableAddonByLocalDir enable an addon from local dir
@@ -239,11 +241,10 @@ func FindAddonPackagesDetailFromRegistry(ctx context.Context, k8sClient client.C
 	// Find matched addons in registries
 	for _, r := range registries {
 		if IsVersionRegistry(r) {
-			vr := BuildVersionedRegistry(r.Name, r.Helm.URL, &common.HTTPOption{
-				Username:        r.Helm.Username,
-				Password:        r.Helm.Password,
-				InsecureSkipTLS: r.Helm.InsecureSkipTLS,
-			})
+			vr, err := ToVersionedRegistry(r)
+			if err != nil {
+				continue
+			}
 			for _, addonName := range addonNames {
 				wholePackage, err := vr.GetDetailedAddon(ctx, addonName, "")
 				if err != nil {
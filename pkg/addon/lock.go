@@ -0,0 +1,111 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package addon
+
+import (
+	"context"
+	"encoding/json"
+
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	velatypes "github.com/oam-dev/kubevela/apis/types"
+)
+
+const lockConfigMapName = "vela-addon-lock"
+const locksKey = "locks"
+
+// AddonLock records the resolved version and dependencies of an installed addon
+type AddonLock struct {
+	Name     string `json:"name"`
+	Version  string `json:"version"`
+	Registry string `json:"registry,omitempty"`
+	// Dependencies are the dependency constraints declared by the installed version of the addon
+	Dependencies []*Dependency `json:"dependencies,omitempty"`
+	// ResolvedDependencies are the versions of the dependencies resolved when the addon is installed
+	ResolvedDependencies map[string]string `json:"resolvedDependencies,omitempty"`
+}
+
+// GetAddonLocks return the lock records of all installed addons
+func GetAddonLocks(ctx context.Context, cli client.Client) (map[string]AddonLock, error) {
+	locks, _, err := getAddonLocks(ctx, cli)
+	return locks, err
+}
+
+func getAddonLocks(ctx context.Context, cli client.Client) (map[string]AddonLock, *v1.ConfigMap, error) {
+	cm := &v1.ConfigMap{}
+	err := cli.Get(ctx, types.NamespacedName{Namespace: velatypes.DefaultKubeVelaNS, Name: lockConfigMapName}, cm)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return map[string]AddonLock{}, nil, nil
+		}
+		return nil, nil, err
+	}
+	locks := map[string]AddonLock{}
+	if data, ok := cm.Data[locksKey]; ok {
+		if err := json.Unmarshal([]byte(data), &locks); err != nil {
+			return nil, nil, err
+		}
+	}
+	return locks, cm, nil
+}
+
+// saveAddonLock saves the lock record of the addon, the lock record is deleted if lock is nil. The save is retried
+// if the ConfigMap is updated or created by others, e.g. two addons are enabled at the same time.
+func saveAddonLock(ctx context.Context, cli client.Client, name string, lock *AddonLock) error {
+	return retry.OnError(retry.DefaultRetry, isLockWriteConflict, func() error {
+		locks, cm, err := getAddonLocks(ctx, cli)
+		if err != nil {
+			return err
+		}
+		if lock != nil {
+			locks[name] = *lock
+		} else {
+			if _, ok := locks[name]; !ok {
+				return nil
+			}
+			delete(locks, name)
+		}
+		b, err := json.Marshal(locks)
+		if err != nil {
+			return err
+		}
+		if cm == nil {
+			cm = &v1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      lockConfigMapName,
+					Namespace: velatypes.DefaultKubeVelaNS,
+				},
+				Data: map[string]string{locksKey: string(b)},
+			}
+			return cli.Create(ctx, cm)
+		}
+		if cm.Data == nil {
+			cm.Data = map[string]string{}
+		}
+		cm.Data[locksKey] = string(b)
+		return cli.Update(ctx, cm)
+	})
+}
+
+func isLockWriteConflict(err error) bool {
+	return apierrors.IsConflict(err) || apierrors.IsAlreadyExists(err)
+}
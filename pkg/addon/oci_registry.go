@@ -0,0 +1,450 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package addon
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"io"
+	"net/http"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/remote/transport"
+	"github.com/google/go-containerregistry/pkg/v1/static"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+	"github.com/pkg/errors"
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/chart/loader"
+	"helm.sh/helm/v3/pkg/repo"
+	"k8s.io/klog/v2"
+
+	"github.com/oam-dev/kubevela/pkg/utils"
+)
+
+const (
+	// OCIScheme is the scheme prefix of the OCI addon registry url
+	OCIScheme = "oci://"
+
+	// ociChartConfigMediaType is the media type of the helm chart config, which holds the Chart.yaml in json
+	ociChartConfigMediaType types.MediaType = "application/vnd.cncf.helm.config.v1+json"
+	// ociChartLayerMediaType is the media type of the helm chart package
+	ociChartLayerMediaType types.MediaType = "application/vnd.cncf.helm.chart.content.v1.tar+gzip"
+)
+
+// BuildOCIVersionedRegistry build versioned addon registry from the OCI registry
+func BuildOCIVersionedRegistry(name string, source *OCIAddonSource) (VersionedRegistry, error) {
+	return newOCIRegistry(name, source)
+}
+
+// ociRegistry reads addons from an OCI registry, addons are stored in the repositories under the registry url and
+// versions of the addon are the tags of the repository
+type ociRegistry struct {
+	name     string
+	source   *OCIAddonSource
+	keychain authn.Keychain
+	// publicKeys are the trusted keys to verify the signature of the addon package to be installed
+	publicKeys []string
+}
+
+func newOCIRegistry(name string, source *OCIAddonSource) (*ociRegistry, error) {
+	r := &ociRegistry{name: name, source: source, keychain: authn.DefaultKeychain}
+	if source.DockerConfigJSON != "" {
+		keychain, err := newDockerConfigKeychain([]byte(source.DockerConfigJSON))
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid docker config for addon registry %s", name)
+		}
+		r.keychain = keychain
+	}
+	return r, nil
+}
+
+func (o *ociRegistry) nameOptions() []name.Option {
+	if o.source.PlainHTTP {
+		return []name.Option{name.Insecure}
+	}
+	return nil
+}
+
+func (o *ociRegistry) remoteOptions(ctx context.Context) []remote.Option {
+	opts := []remote.Option{remote.WithContext(ctx), remote.WithAuthFromKeychain(o.keychain)}
+	if o.source.InsecureSkipTLS {
+		t := remote.DefaultTransport.(*http.Transport).Clone()
+		// nolint:gosec
+		t.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+		opts = append(opts, remote.WithTransport(t))
+	}
+	return opts
+}
+
+// basePath returns the registry host and the repository path prefix of the addons
+func (o *ociRegistry) basePath() string {
+	return strings.TrimSuffix(strings.TrimPrefix(o.source.URL, OCIScheme), "/")
+}
+
+func (o *ociRegistry) repository(addonName string) (name.Repository, error) {
+	return name.NewRepository(path.Join(o.basePath(), addonName), o.nameOptions()...)
+}
+
+// ListAddon list the latest version of all addons in the registry, which requires the catalog api of the registry
+func (o *ociRegistry) ListAddon() ([]*UIData, error) {
+	ctx := context.Background()
+	host, prefix, _ := strings.Cut(o.basePath(), "/")
+	reg, err := name.NewRegistry(host, o.nameOptions()...)
+	if err != nil {
+		return nil, err
+	}
+	repositories, err := remote.Catalog(ctx, reg, o.remoteOptions(ctx)...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot list repositories in addon registry %s", o.name)
+	}
+	var res []*UIData
+	for _, repository := range repositories {
+		addonName := repository
+		if prefix != "" {
+			if !strings.HasPrefix(repository, prefix+"/") {
+				continue
+			}
+			addonName = strings.TrimPrefix(repository, prefix+"/")
+		}
+		if strings.Contains(addonName, "/") {
+			continue
+		}
+		versions, err := o.listVersions(ctx, addonName)
+		if err != nil {
+			klog.Warningf("failed to list versions of addon %s in registry %s: %v", addonName, o.name, err)
+			continue
+		}
+		if len(versions) == 0 {
+			continue
+		}
+		latest, err := o.loadChartMetadata(ctx, addonName, versions[0].Version)
+		if err != nil {
+			klog.Warningf("failed to load the addon %s:%s in registry %s: %v", addonName, versions[0].Version, o.name, err)
+			continue
+		}
+		var availableVersions []string
+		for _, version := range versions {
+			availableVersions = append(availableVersions, version.Version)
+		}
+		res = append(res, &UIData{Meta: Meta{
+			Name:        addonName,
+			Icon:        latest.Icon,
+			Tags:        latest.Keywords,
+			Description: latest.Description,
+			Version:     latest.Version,
+		}, RegistryName: o.name, AvailableVersions: availableVersions})
+	}
+	return res, nil
+}
+
+func (o *ociRegistry) GetAddonUIData(ctx context.Context, addonName, version string) (*UIData, error) {
+	wholePackage, err := o.loadAddon(ctx, addonName, version, false)
+	if err != nil {
+		return nil, err
+	}
+	return &UIData{
+		Meta:              wholePackage.Meta,
+		APISchema:         wholePackage.APISchema,
+		Parameters:        wholePackage.Parameters,
+		Detail:            wholePackage.Detail,
+		Definitions:       wholePackage.Definitions,
+		AvailableVersions: wholePackage.AvailableVersions,
+		CUEDefinitions:    wholePackage.CUEDefinitions,
+	}, nil
+}
+
+func (o *ociRegistry) GetAddonInstallPackage(ctx context.Context, addonName, version string) (*InstallPackage, error) {
+	wholePackage, err := o.loadAddon(ctx, addonName, version, true)
+	if err != nil {
+		return nil, err
+	}
+	return &wholePackage.InstallPackage, nil
+}
+
+func (o *ociRegistry) GetDetailedAddon(ctx context.Context, addonName, version string) (*WholeAddonPackage, error) {
+	return o.loadAddon(ctx, addonName, version, true)
+}
+
+// GetAddonAvailableVersion will return all available versions of the addon, and the versions are sorted from last to
+// first. Only the name and version are set in the chart metadata, as loading the whole metadata costs two round-trips
+// to the registry for each version, use loadVersionAnnotations to load the annotations of the versions in need.
+func (o *ociRegistry) GetAddonAvailableVersion(addonName string) ([]*repo.ChartVersion, error) {
+	versions, err := o.listVersions(context.Background(), addonName)
+	if err != nil {
+		return nil, err
+	}
+	if len(versions) == 0 {
+		return nil, ErrNotExist
+	}
+	return versions, nil
+}
+
+// listVersions list the tags of the addon repository as versions, tags that are not semantic versions are ignored.
+// Only the version is set in the returned chart versions, which are sorted from last to first.
+func (o *ociRegistry) listVersions(ctx context.Context, addonName string) (repo.ChartVersions, error) {
+	repository, err := o.repository(addonName)
+	if err != nil {
+		return nil, err
+	}
+	tags, err := remote.List(repository, o.remoteOptions(ctx)...)
+	if err != nil {
+		if isOCINotFound(err) {
+			return nil, ErrNotExist
+		}
+		return nil, err
+	}
+	var versions repo.ChartVersions
+	for _, tag := range tags {
+		version := tagToVersion(tag)
+		if _, err := semver.NewVersion(version); err != nil {
+			continue
+		}
+		versions = append(versions, &repo.ChartVersion{Metadata: &chart.Metadata{Name: addonName, Version: version}})
+	}
+	sort.Sort(sort.Reverse(versions))
+	return versions, nil
+}
+
+// fetchManifest fetch the manifest of the addon with the given version
+func (o *ociRegistry) fetchManifest(ctx context.Context, addonName, version string) (name.Repository, *v1.Manifest, error) {
+	repository, err := o.repository(addonName)
+	if err != nil {
+		return repository, nil, err
+	}
+	desc, err := remote.Get(repository.Tag(versionToTag(version)), o.remoteOptions(ctx)...)
+	if err != nil {
+		if isOCINotFound(err) {
+			return repository, nil, ErrNotExist
+		}
+		return repository, nil, err
+	}
+	manifest, err := v1.ParseManifest(bytes.NewReader(desc.Manifest))
+	if err != nil {
+		return repository, nil, err
+	}
+	return repository, manifest, nil
+}
+
+func (o *ociRegistry) fetchBlob(ctx context.Context, repository name.Repository, digest v1.Hash) ([]byte, error) {
+	layer, err := remote.Layer(repository.Digest(digest.String()), o.remoteOptions(ctx)...)
+	if err != nil {
+		return nil, err
+	}
+	rc, err := layer.Compressed()
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = rc.Close()
+	}()
+	return io.ReadAll(rc)
+}
+
+func (o *ociRegistry) loadChartMetadata(ctx context.Context, addonName, version string) (*chart.Metadata, error) {
+	repository, manifest, err := o.fetchManifest(ctx, addonName, version)
+	if err != nil {
+		return nil, err
+	}
+	if manifest.Config.MediaType != ociChartConfigMediaType {
+		return nil, errors.Errorf("addon %s:%s is not a helm chart artifact, config media type is %s", addonName, version, manifest.Config.MediaType)
+	}
+	config, err := o.fetchBlob(ctx, repository, manifest.Config.Digest)
+	if err != nil {
+		return nil, err
+	}
+	metadata := &chart.Metadata{}
+	if err := json.Unmarshal(config, metadata); err != nil {
+		return nil, errors.Wrapf(err, "invalid chart metadata of addon %s:%s", addonName, version)
+	}
+	return metadata, nil
+}
+
+// loadAddon load the addon package, the signature of the package will be verified if verify is true and the registry
+// has trusted public keys
+func (o *ociRegistry) loadAddon(ctx context.Context, addonName, version string, verify bool) (*WholeAddonPackage, error) {
+	versions, err := o.listVersions(ctx, addonName)
+	if err != nil {
+		return nil, err
+	}
+	if len(versions) == 0 {
+		return nil, ErrNotExist
+	}
+	addonVersion, availableVersions := chooseVersion(version, versions)
+	if addonVersion == nil {
+		return nil, errors.Errorf("specified version %s for addon %s not exist", utils.Sanitize(version), addonName)
+	}
+	repository, manifest, err := o.fetchManifest(ctx, addonName, addonVersion.Version)
+	if err != nil {
+		return nil, err
+	}
+	var content []byte
+	for _, layer := range manifest.Layers {
+		if layer.MediaType != ociChartLayerMediaType {
+			continue
+		}
+		if content, err = o.fetchBlob(ctx, repository, layer.Digest); err != nil {
+			klog.Warningf("failed to download the addon package %s:%s: %v", addonName, addonVersion.Version, err)
+			return nil, ErrFetch
+		}
+		break
+	}
+	if content == nil {
+		return nil, errors.Errorf("addon %s:%s has no helm chart content layer", addonName, addonVersion.Version)
+	}
+	bufferedFile, err := loader.LoadArchiveFiles(bytes.NewReader(content))
+	if err != nil {
+		klog.Warningf("failed to load the addon package:%s", err.Error())
+		return nil, ErrFetch
+	}
+	var publicKeys []string
+	if verify {
+		publicKeys = o.publicKeys
+	}
+	addonPkg, err := loadAddonPackage(addonName, bufferedFile, publicKeys)
+	if err != nil {
+		return nil, err
+	}
+	addonPkg.AvailableVersions = availableVersions
+	addonPkg.RegistryName = o.name
+	metadata, err := o.loadChartMetadata(ctx, addonName, addonVersion.Version)
+	if err != nil {
+		return nil, err
+	}
+	addonPkg.Meta.SystemRequirements = LoadSystemRequirements(metadata.Annotations)
+	klog.V(5).Infof("Addon '%s' with version '%s' loaded successfully from registry '%s'", addonName, addonVersion.Version, o.name)
+	return addonPkg, nil
+}
+
+// PushAddon push the addon package, which is a packaged helm chart, to the registry. The chart metadata is stored as
+// the config of the artifact and the version is used as the tag.
+func (o *ociRegistry) PushAddon(ctx context.Context, metadata *chart.Metadata, content []byte) (name.Tag, error) {
+	repository, err := o.repository(metadata.Name)
+	if err != nil {
+		return name.Tag{}, err
+	}
+	tag := repository.Tag(versionToTag(metadata.Version))
+	config, err := json.Marshal(metadata)
+	if err != nil {
+		return tag, err
+	}
+	configLayer := static.NewLayer(config, ociChartConfigMediaType)
+	contentLayer := static.NewLayer(content, ociChartLayerMediaType)
+	manifest := &v1.Manifest{
+		SchemaVersion: 2,
+		MediaType:     types.OCIManifestSchema1,
+	}
+	for i, layer := range []v1.Layer{configLayer, contentLayer} {
+		if err := remote.WriteLayer(repository, layer, o.remoteOptions(ctx)...); err != nil {
+			return tag, errors.Wrap(err, "cannot upload the addon package")
+		}
+		digest, _ := layer.Digest()
+		size, _ := layer.Size()
+		mediaType, _ := layer.MediaType()
+		desc := v1.Descriptor{MediaType: mediaType, Digest: digest, Size: size}
+		if i == 0 {
+			manifest.Config = desc
+		} else {
+			manifest.Layers = append(manifest.Layers, desc)
+		}
+	}
+	raw, err := json.Marshal(manifest)
+	if err != nil {
+		return tag, err
+	}
+	if err := remote.Put(tag, ociManifest(raw), o.remoteOptions(ctx)...); err != nil {
+		return tag, errors.Wrap(err, "cannot upload the addon manifest")
+	}
+	return tag, nil
+}
+
+// ociManifest is the raw OCI image manifest to be uploaded
+type ociManifest []byte
+
+// RawManifest implements remote.Taggable
+func (m ociManifest) RawManifest() ([]byte, error) {
+	return m, nil
+}
+
+// MediaType returns the media type of the manifest
+func (m ociManifest) MediaType() (types.MediaType, error) {
+	return types.OCIManifestSchema1, nil
+}
+
+// versionToTag convert the addon version to the OCI tag, as `+` is not allowed in tags
+func versionToTag(version string) string {
+	return strings.ReplaceAll(version, "+", "_")
+}
+
+// tagToVersion convert the OCI tag back to the addon version
+func tagToVersion(tag string) string {
+	return strings.ReplaceAll(tag, "_", "+")
+}
+
+func isOCINotFound(err error) bool {
+	var terr *transport.Error
+	if !errors.As(err, &terr) {
+		return false
+	}
+	if terr.StatusCode == http.StatusNotFound {
+		return true
+	}
+	for _, e := range terr.Errors {
+		if e.Code == transport.NameUnknownErrorCode || e.Code == transport.ManifestUnknownErrorCode {
+			return true
+		}
+	}
+	return false
+}
+
+// dockerConfigKeychain resolves the credentials of the registry from the docker config json
+type dockerConfigKeychain struct {
+	auths map[string]authn.AuthConfig
+}
+
+func newDockerConfigKeychain(data []byte) (authn.Keychain, error) {
+	config := struct {
+		Auths map[string]authn.AuthConfig `json:"auths"`
+	}{}
+	if err := json.Unmarshal(data, &config); err != nil {
+		return nil, err
+	}
+	return &dockerConfigKeychain{auths: config.Auths}, nil
+}
+
+// Resolve implements authn.Keychain
+func (k *dockerConfigKeychain) Resolve(target authn.Resource) (authn.Authenticator, error) {
+	registry := target.RegistryStr()
+	keys := []string{registry, "https://" + registry, "http://" + registry}
+	if registry == name.DefaultRegistry {
+		keys = append(keys, authn.DefaultAuthKey)
+	}
+	for _, key := range keys {
+		if config, ok := k.auths[key]; ok {
+			return authn.FromConfig(config), nil
+		}
+	}
+	return authn.Anonymous, nil
+}
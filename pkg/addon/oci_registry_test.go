@@ -0,0 +1,169 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package addon
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/registry"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestOCIServer(t *testing.T, username, password string) *httptest.Server {
+	handler := registry.New(registry.Logger(log.New(io.Discard, "", 0)))
+	if username != "" {
+		inner := handler
+		handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if u, p, ok := r.BasicAuth(); !ok || u != username || p != password {
+				w.Header().Set("WWW-Authenticate", `Basic realm="test"`)
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			inner.ServeHTTP(w, r)
+		})
+	}
+	ts := httptest.NewServer(handler)
+	t.Cleanup(ts.Close)
+	return ts
+}
+
+func TestOCIRegistry(t *testing.T) {
+	r := require.New(t)
+	ctx := context.Background()
+	ts := newTestOCIServer(t, "", "")
+	url := OCIScheme + strings.TrimPrefix(ts.URL, "http://") + "/kubevela/addons"
+
+	for _, version := range []string{"1.0.1", "1.1.0", "2.0.0-beta.1"} {
+		out := &bytes.Buffer{}
+		p := &PushCmd{ChartName: "testdata/charts/sample-1.0.1.tgz", RepoName: url, ChartVersion: version, UseHTTP: true, Out: out}
+		r.NoError(p.Push(ctx))
+		// the progress is written to the output of the command
+		r.Contains(out.String(), "Pushing sample-"+version+".tgz")
+		r.Contains(out.String(), "Done")
+	}
+
+	vr, err := ToVersionedRegistry(Registry{Name: "oci-registry", OCI: &OCIAddonSource{URL: url, PlainHTTP: true}})
+	r.NoError(err)
+	addons, err := vr.ListAddon()
+	r.NoError(err)
+	r.Equal(1, len(addons))
+	r.Equal("sample", addons[0].Name)
+	r.Equal("2.0.0-beta.1", addons[0].Version)
+	r.Equal("oci-registry", addons[0].RegistryName)
+	r.Equal([]string{"2.0.0-beta.1", "1.1.0", "1.0.1"}, addons[0].AvailableVersions)
+
+	// latest stable version is chosen by default
+	pkg, err := vr.GetDetailedAddon(ctx, "sample", "")
+	r.NoError(err)
+	r.Equal("sample", pkg.Name)
+	r.Equal("oci-registry", pkg.RegistryName)
+	r.Equal([]string{"2.0.0-beta.1", "1.1.0", "1.0.1"}, pkg.AvailableVersions)
+
+	uiData, err := vr.GetAddonUIData(ctx, "sample", "v1.0.1")
+	r.NoError(err)
+	r.Equal("sample", uiData.Name)
+
+	_, err = vr.GetAddonInstallPackage(ctx, "sample", "3.0.0")
+	r.Error(err)
+	_, err = vr.GetAddonInstallPackage(ctx, "not-exist", "")
+	r.ErrorIs(err, ErrNotExist)
+
+	versions, err := vr.GetAddonAvailableVersion("sample")
+	r.NoError(err)
+	r.Equal(3, len(versions))
+	r.Equal("2.0.0-beta.1", versions[0].Version)
+	r.Equal("sample", versions[0].Name)
+	// the chart metadata is not loaded together with the versions
+	r.Empty(versions[0].Description)
+	annotations, err := loadVersionAnnotations(ctx, vr, "sample", versions[0])
+	r.NoError(err)
+	r.Empty(annotations)
+}
+
+func TestOCIRegistryWithDockerConfig(t *testing.T) {
+	r := require.New(t)
+	ctx := context.Background()
+	ts := newTestOCIServer(t, "user", "pass")
+	host := strings.TrimPrefix(ts.URL, "http://")
+	url := OCIScheme + host
+
+	p := &PushCmd{ChartName: "testdata/charts/sample-1.0.1.tgz", RepoName: url, UseHTTP: true, Out: io.Discard}
+	r.Error(p.Push(ctx))
+	p.Username, p.Password = "user", "pass"
+	r.NoError(p.Push(ctx))
+
+	vr, err := ToVersionedRegistry(Registry{Name: "oci-registry", OCI: &OCIAddonSource{URL: url, PlainHTTP: true}})
+	r.NoError(err)
+	_, err = vr.GetAddonUIData(ctx, "sample", "")
+	r.Error(err)
+
+	auth := base64.StdEncoding.EncodeToString([]byte("user:pass"))
+	dockerConfig := fmt.Sprintf(`{"auths":{"%s":{"auth":"%s"}}}`, host, auth)
+	vr, err = ToVersionedRegistry(Registry{Name: "oci-registry", OCI: &OCIAddonSource{URL: url, PlainHTTP: true, DockerConfigJSON: dockerConfig}})
+	r.NoError(err)
+	addons, err := vr.ListAddon()
+	r.NoError(err)
+	r.Equal(1, len(addons))
+	r.Equal("sample", addons[0].Name)
+	uiData, err := vr.GetAddonUIData(ctx, "sample", "")
+	r.NoError(err)
+	r.Equal("sample", uiData.Name)
+
+	_, err = ToVersionedRegistry(Registry{Name: "oci-registry", OCI: &OCIAddonSource{URL: url, DockerConfigJSON: "invalid"}})
+	r.Error(err)
+}
+
+func TestDockerConfigKeychain(t *testing.T) {
+	r := require.New(t)
+	keychain, err := newDockerConfigKeychain([]byte(`{"auths":{
+		"https://registry.example.com":{"username":"foo","password":"bar"},
+		"https://index.docker.io/v1/":{"username":"hub","password":"secret"}
+	}}`))
+	r.NoError(err)
+	for reg, expected := range map[string]*authn.AuthConfig{
+		"registry.example.com": {Username: "foo", Password: "bar"},
+		"index.docker.io":      {Username: "hub", Password: "secret"},
+		"other.example.com":    {},
+	} {
+		target, err := name.NewRegistry(reg)
+		r.NoError(err)
+		auth, err := keychain.Resolve(target)
+		r.NoError(err)
+		config, err := auth.Authorization()
+		r.NoError(err)
+		r.Equal(expected.Username, config.Username, reg)
+		r.Equal(expected.Password, config.Password, reg)
+	}
+}
+
+func TestVersionTagConversion(t *testing.T) {
+	r := require.New(t)
+	r.Equal("1.0.0_build.1", versionToTag("1.0.0+build.1"))
+	r.Equal("1.0.0+build.1", tagToVersion("1.0.0_build.1"))
+	r.True(bytes.Equal([]byte("1.0.0"), []byte(versionToTag("1.0.0"))))
+}
@@ -31,7 +31,9 @@ import (
 	cm "github.com/chartmuseum/helm-push/pkg/chartmuseum"
 	cmhelm "github.com/chartmuseum/helm-push/pkg/helm"
 	"github.com/fatih/color"
+	"github.com/google/go-containerregistry/pkg/authn"
 	helmrepo "helm.sh/helm/v3/pkg/repo"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -66,36 +68,24 @@ func (p *PushCmd) Push(ctx context.Context) error {
 	var repo *cmhelm.Repo
 	var err error
 
-	// Get the user specified Helm repo
-	repo, err = GetHelmRepo(ctx, p.Client, p.RepoName)
+	// Push to the OCI registry if the user specified one
+	ociSource, err := GetOCISource(ctx, p.Client, p.RepoName)
 	if err != nil {
 		return err
 	}
-
-	// Make the addon dir a Helm Chart
-	// The user can decide if they want Chart.yaml be in sync with addon metadata.yaml
-	// By default, it will recreate Chart.yaml according to addon metadata.yaml
-	err = MakeChartCompatible(p.ChartName, !p.KeepChartMetadata)
-	// `Not a directory` errors are ignored, that's fine,
-	// since .tgz files are also supported.
-	if err != nil && !strings.Contains(err.Error(), "is not a directory") {
-		return err
+	if ociSource != nil {
+		return p.pushOCI(ctx, ociSource)
 	}
 
-	// Get chart from a directory or .tgz package
-	chart, err := cmhelm.GetChartByName(p.ChartName)
+	// Get the user specified Helm repo
+	repo, err = GetHelmRepo(ctx, p.Client, p.RepoName)
 	if err != nil {
 		return err
 	}
 
-	// Override chart version using specified version
-	if p.ChartVersion != "" {
-		chart.SetVersion(p.ChartVersion)
-	}
-
-	// Override app version using specified version
-	if p.AppVersion != "" {
-		chart.SetAppVersion(p.AppVersion)
+	chart, err := p.loadChart()
+	if err != nil {
+		return err
 	}
 
 	// Override username and password using specified values
@@ -157,7 +147,7 @@ func (p *PushCmd) Push(ctx context.Context) error {
 		return err
 	}
 
-	_, _ = fmt.Fprintf(os.Stderr, "Pushing %s to %s... ",
+	_, _ = fmt.Fprintf(p.out(), "Pushing %s to %s... ",
 		color.New(color.Bold).Sprintf("%s", filepath.Base(chartPackagePath)),
 		formatRepoNameAndURL(p.RepoName, repo.Config.URL),
 	)
@@ -170,7 +160,130 @@ func (p *PushCmd) Push(ctx context.Context) error {
 	defer func() {
 		_ = resp.Body.Close()
 	}()
-	return handlePushResponse(resp)
+	return handlePushResponse(p.out(), resp)
+}
+
+// loadChart makes the addon dir a Helm Chart and loads it
+func (p *PushCmd) loadChart() (*cmhelm.Chart, error) {
+	// Make the addon dir a Helm Chart
+	// The user can decide if they want Chart.yaml be in sync with addon metadata.yaml
+	// By default, it will recreate Chart.yaml according to addon metadata.yaml
+	// The Chart.yaml of signed addon is never recreated since it is signed together with the other files.
+	_, err := os.Stat(filepath.Join(p.ChartName, ChecksumsSignatureFileName))
+	signed := err == nil
+	err = MakeChartCompatible(p.ChartName, !p.KeepChartMetadata && !signed)
+	// `Not a directory` errors are ignored, that's fine,
+	// since .tgz files are also supported.
+	if err != nil && !strings.Contains(err.Error(), "is not a directory") {
+		return nil, err
+	}
+
+	// Get chart from a directory or .tgz package
+	chart, err := cmhelm.GetChartByName(p.ChartName)
+	if err != nil {
+		return nil, err
+	}
+	for _, file := range chart.Files {
+		signed = signed || file.Name == ChecksumsSignatureFileName
+	}
+
+	// Override chart version using specified version
+	if p.ChartVersion != "" && p.ChartVersion != chart.Metadata.Version {
+		if signed {
+			return nil, fmt.Errorf("cannot override the version of signed addon %s, update the version in %s and sign it again", chart.Name(), MetadataFileName)
+		}
+		chart.SetVersion(p.ChartVersion)
+	}
+
+	// Override app version using specified version
+	if p.AppVersion != "" && p.AppVersion != chart.Metadata.AppVersion {
+		if signed {
+			return nil, fmt.Errorf("cannot override the app version of signed addon %s", chart.Name())
+		}
+		chart.SetAppVersion(p.AppVersion)
+	}
+	return chart, nil
+}
+
+// pushOCI packages the addon and pushes it to the OCI registry as a helm chart artifact
+func (p *PushCmd) pushOCI(ctx context.Context, source *OCIAddonSource) error {
+	chart, err := p.loadChart()
+	if err != nil {
+		return err
+	}
+
+	// Use a temporary dir to hold packaged .tgz Charts
+	tmp, err := os.MkdirTemp("", "addon-push-")
+	if err != nil {
+		return err
+	}
+	defer func(path string) {
+		_ = os.RemoveAll(path)
+	}(tmp)
+
+	chartPackagePath, err := cmhelm.CreateChartPackage(chart, tmp)
+	if err != nil {
+		return err
+	}
+	content, err := os.ReadFile(filepath.Clean(chartPackagePath))
+	if err != nil {
+		return err
+	}
+
+	source.PlainHTTP = source.PlainHTTP || p.UseHTTP
+	source.InsecureSkipTLS = source.InsecureSkipTLS || p.InsecureSkipVerify
+	registry, err := newOCIRegistry(p.RepoName, source)
+	if err != nil {
+		return err
+	}
+	// Override the credentials using specified username and password
+	if p.Username != "" && p.Password != "" {
+		registry.keychain = staticKeychain{authn.FromConfig(authn.AuthConfig{Username: p.Username, Password: p.Password})}
+	}
+
+	_, _ = fmt.Fprintf(p.out(), "Pushing %s to %s... ",
+		color.New(color.Bold).Sprintf("%s", filepath.Base(chartPackagePath)),
+		formatRepoNameAndURL(p.RepoName, source.URL),
+	)
+	tag, err := registry.PushAddon(ctx, chart.Metadata, content)
+	if err != nil {
+		_, _ = fmt.Fprintf(p.out(), "%s\n", color.RedString("Failed"))
+		return err
+	}
+	_, _ = fmt.Fprintf(p.out(), "%s\n", color.GreenString("Done"))
+	_, _ = fmt.Fprintf(p.out(), "Pushed: %s\n", tag.String())
+	return nil
+}
+
+// GetOCISource searches for an OCI addon source by name.
+// If an OCI url (oci://) is provided, a temp source is returned.
+// If a name is provided, we will try to find it in local addon registries (only OCI type).
+// Return nil if the name does not refer to an OCI registry.
+func GetOCISource(ctx context.Context, c client.Client, repoName string) (*OCIAddonSource, error) {
+	if strings.HasPrefix(repoName, OCIScheme) {
+		return &OCIAddonSource{URL: repoName}, nil
+	}
+	if regexp.MustCompile(`^https?://`).MatchString(repoName) || c == nil {
+		return nil, nil
+	}
+	registry, err := NewRegistryDataStore(c).GetRegistry(ctx, repoName)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return registry.OCI, nil
+}
+
+// staticKeychain always resolves to the same authenticator
+type staticKeychain struct {
+	authn.Authenticator
+}
+
+// Resolve implements authn.Keychain
+func (k staticKeychain) Resolve(authn.Resource) (authn.Authenticator, error) {
+	return k.Authenticator, nil
 }
 
 // GetHelmRepo searches for a Helm repo by name.
@@ -262,17 +375,25 @@ func (p *PushCmd) SetFieldsFromEnv() {
 	}
 }
 
+// out returns the writer of the push progress, which is stderr if Out is not set
+func (p *PushCmd) out() io.Writer {
+	if p.Out != nil {
+		return p.Out
+	}
+	return os.Stderr
+}
+
 // handlePushResponse checks response from ChartMuseum
-func handlePushResponse(resp *http.Response) error {
+func handlePushResponse(out io.Writer, resp *http.Response) error {
 	if resp.StatusCode != 201 && resp.StatusCode != 202 {
-		_, _ = fmt.Fprintf(os.Stderr, "%s\n", color.RedString("Failed"))
+		_, _ = fmt.Fprintf(out, "%s\n", color.RedString("Failed"))
 		b, err := io.ReadAll(resp.Body)
 		if err != nil {
 			return err
 		}
 		return getChartMuseumError(b, resp.StatusCode)
 	}
-	_, _ = fmt.Fprintf(os.Stderr, "%s\n", color.GreenString("Done"))
+	_, _ = fmt.Fprintf(out, "%s\n", color.GreenString("Done"))
 	return nil
 }
 
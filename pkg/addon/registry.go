@@ -71,6 +71,11 @@ type Registry struct {
 	OSS    *OSSAddonSource    `json:"oss,omitempty"`
 	Gitee  *GiteeAddonSource  `json:"gitee,omitempty"`
 	Gitlab *GitlabAddonSource `json:"gitlab,omitempty"`
+	OCI    *OCIAddonSource    `json:"oci,omitempty"`
+
+	// PublicKeys are the trusted public keys in PEM format. If set, addon packages must be signed by any of them
+	// to be installed from this registry.
+	PublicKeys []string `json:"publicKeys,omitempty"`
 }
 
 // RegistryDataStore CRUD addon registry data in configmap
@@ -122,6 +127,9 @@ func (r registryImpl) ListRegistries(ctx context.Context) ([]Registry, error) {
 		if err := loadTokenFromSecret(ctx, r.client, &registry); err != nil {
 			return nil, err
 		}
+		if err := loadDockerConfigFromSecret(ctx, r.client, &registry); err != nil {
+			return nil, err
+		}
 		res = append(res, registry)
 	}
 	return res, nil
@@ -284,6 +292,9 @@ func (r registryImpl) GetRegistry(ctx context.Context, name string) (Registry, e
 	if err := loadTokenFromSecret(ctx, r.client, &res); err != nil {
 		return res, err
 	}
+	if err := loadDockerConfigFromSecret(ctx, r.client, &res); err != nil {
+		return res, err
+	}
 	return res, nil
 }
 
@@ -315,3 +326,20 @@ func loadTokenFromSecret(ctx context.Context, cli client.Client, registry *Regis
 	source.SetToken(string(secret.Data["token"]))
 	return nil
 }
+
+// loadDockerConfigFromSecret will load the docker config json from the secret referenced by the OCI source
+func loadDockerConfigFromSecret(ctx context.Context, cli client.Client, registry *Registry) error {
+	if registry.OCI == nil || registry.OCI.DockerConfigSecretRef == "" {
+		return nil
+	}
+	secret := &v1.Secret{}
+	if err := cli.Get(ctx, types.NamespacedName{Namespace: velatypes.DefaultKubeVelaNS, Name: registry.OCI.DockerConfigSecretRef}, secret); err != nil {
+		if apierrors.IsNotFound(err) {
+			// If the secret is not found, the registry will be accessed anonymously
+			return nil
+		}
+		return err
+	}
+	registry.OCI.DockerConfigJSON = string(secret.Data[v1.DockerConfigJsonKey])
+	return nil
+}
@@ -0,0 +1,509 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package addon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/pkg/errors"
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/repo"
+)
+
+// ErrDependencyConflict means the dependencies of the addon cannot be satisfied at the same time
+var ErrDependencyConflict = NewAddonError("addon dependency conflict")
+
+// ResolvedAddon is an addon with the version chosen by the DependencyResolver
+type ResolvedAddon struct {
+	Name     string `json:"name"`
+	Version  string `json:"version"`
+	Registry string `json:"registry,omitempty"`
+	// Dependencies are the dependency constraints declared by this version of the addon
+	Dependencies []*Dependency `json:"dependencies,omitempty"`
+	// Installed means the addon is already installed with the resolved version
+	Installed bool `json:"-"`
+}
+
+// Resolution is the result of the dependency resolution
+type Resolution struct {
+	// Addons are sorted in the order to be installed, dependencies come before the addons depending on them,
+	// and the addon to be enabled is the last one
+	Addons []*ResolvedAddon
+}
+
+// Get return the resolved addon by name
+func (r *Resolution) Get(name string) *ResolvedAddon {
+	for _, addon := range r.Addons {
+		if addon.Name == name {
+			return addon
+		}
+	}
+	return nil
+}
+
+// addonCatalog lists the versions and dependencies of addons in a registry for the DependencyResolver
+type addonCatalog interface {
+	registryName() string
+	// listVersions return the available versions of the addon, ErrNotExist if the addon is not in the registry
+	listVersions(ctx context.Context, addonName string) ([]string, error)
+	getDependencies(ctx context.Context, addonName, version string) ([]*Dependency, error)
+}
+
+// DependencyResolver builds the full dependency graph of an addon from all registries, and chooses a version for
+// every addon in the graph which satisfies all the version constraints on it.
+type DependencyResolver struct {
+	catalogs []addonCatalog
+	// installed are the versions of the installed addons, which are kept as is unless updateInstalled is set
+	installed map[string]string
+	// locks are the lock records of the installed addons. The constraints of the installed addons are respected,
+	// and the locked versions are preferred when the addon needs to be installed again.
+	locks map[string]AddonLock
+	// updateInstalled allows choosing versions other than the installed ones for the dependencies
+	updateInstalled bool
+
+	versions map[string][]*addonCandidate
+}
+
+type addonCandidate struct {
+	version      string
+	catalog      addonCatalog
+	dependencies []*Dependency
+	loaded       bool
+}
+
+// requirement is a version constraint on an addon and where it comes from
+type requirement struct {
+	constraint string
+	requiredBy string
+}
+
+// resolveState is the addons chosen and the constraints collected in the resolving, it's copied before trying a
+// candidate so that it can be rolled back easily
+type resolveState struct {
+	chosen       map[string]*ResolvedAddon
+	requirements map[string][]requirement
+	order        []string
+}
+
+func (s *resolveState) clone() *resolveState {
+	res := &resolveState{chosen: map[string]*ResolvedAddon{}, requirements: map[string][]requirement{}}
+	for k, v := range s.chosen {
+		res.chosen[k] = v
+	}
+	for k, v := range s.requirements {
+		res.requirements[k] = append([]requirement{}, v...)
+	}
+	res.order = append(res.order, s.order...)
+	return res
+}
+
+// NewDependencyResolver create a resolver reading addons from the registries, the former registries are preferred
+// if there are same versions of an addon in multiple registries.
+func NewDependencyResolver(registries []Registry, installed map[string]string, locks map[string]AddonLock, updateInstalled bool) *DependencyResolver {
+	var catalogs []addonCatalog
+	for i := range registries {
+		if IsLocalRegistry(registries[i]) {
+			continue
+		}
+		catalogs = append(catalogs, newRegistryCatalog(registries[i]))
+	}
+	return newDependencyResolver(catalogs, installed, locks, updateInstalled)
+}
+
+func newDependencyResolver(catalogs []addonCatalog, installed map[string]string, locks map[string]AddonLock, updateInstalled bool) *DependencyResolver {
+	if installed == nil {
+		installed = map[string]string{}
+	}
+	if locks == nil {
+		locks = map[string]AddonLock{}
+	}
+	return &DependencyResolver{
+		catalogs:        catalogs,
+		installed:       installed,
+		locks:           locks,
+		updateInstalled: updateInstalled,
+		versions:        map[string][]*addonCandidate{},
+	}
+}
+
+// Resolve chooses the versions of all the dependencies of the addon, the addon itself is always the last one of
+// the resolution. ErrDependencyConflict is returned if the constraints cannot be satisfied.
+func (d *DependencyResolver) Resolve(ctx context.Context, addon *InstallPackage) (*Resolution, error) {
+	state := &resolveState{chosen: map[string]*ResolvedAddon{}, requirements: map[string][]requirement{}}
+	root := &ResolvedAddon{Name: addon.Name, Version: addon.Version, Dependencies: addon.Dependencies}
+	state.chosen[root.Name] = root
+	// the constraints of the other installed addons should be respected if their dependencies may be changed
+	if d.updateInstalled {
+		for name, lock := range d.locks {
+			if _, installed := d.installed[name]; !installed || name == addon.Name {
+				continue
+			}
+			for _, dep := range lock.Dependencies {
+				state.requirements[dep.Name] = append(state.requirements[dep.Name], requirement{constraint: dep.Version, requiredBy: name})
+			}
+		}
+	}
+	var pending []string
+	for _, dep := range addon.Dependencies {
+		if dep.Name == addon.Name {
+			return nil, errors.Wrapf(ErrDependencyConflict, "addon %s depends on itself", addon.Name)
+		}
+		state.requirements[dep.Name] = append(state.requirements[dep.Name], requirement{constraint: dep.Version, requiredBy: addon.Name})
+		pending = append(pending, dep.Name)
+	}
+	result, err := d.resolve(ctx, pending, state)
+	if err != nil {
+		return nil, err
+	}
+	resolution := &Resolution{}
+	for _, name := range installOrder(result, addon.Name) {
+		resolution.Addons = append(resolution.Addons, result.chosen[name])
+	}
+	return resolution, nil
+}
+
+// resolve chooses the version of the pending addons one by one in the depth-first order, and goes back to try the
+// next candidate if the dependencies of the chosen version conflict with the chosen addons.
+func (d *DependencyResolver) resolve(ctx context.Context, pending []string, state *resolveState) (*resolveState, error) {
+	if len(pending) == 0 {
+		return state, nil
+	}
+	name := pending[0]
+	if chosen, ok := state.chosen[name]; ok {
+		if err := checkRequirements(name, chosen.Version, state.requirements[name]); err != nil {
+			return nil, err
+		}
+		return d.resolve(ctx, pending[1:], state)
+	}
+
+	candidates, err := d.candidates(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	var lastErr error
+	for _, candidate := range candidates {
+		if !satisfies(candidate.version, state.requirements[name]) {
+			continue
+		}
+		if err := d.loadDependencies(ctx, name, candidate); err != nil {
+			return nil, err
+		}
+		next := state.clone()
+		next.chosen[name] = &ResolvedAddon{
+			Name:         name,
+			Version:      candidate.version,
+			Registry:     candidate.catalog.registryName(),
+			Dependencies: candidate.dependencies,
+			Installed:    d.installed[name] == candidate.version,
+		}
+		next.order = append(next.order, name)
+		nextPending := append([]string{}, pending[1:]...)
+		for _, dep := range candidate.dependencies {
+			next.requirements[dep.Name] = append(next.requirements[dep.Name], requirement{constraint: dep.Version, requiredBy: name})
+			nextPending = append(nextPending, dep.Name)
+		}
+		result, err := d.resolve(ctx, nextPending, next)
+		if err == nil {
+			return result, nil
+		}
+		if !errors.Is(err, ErrDependencyConflict) {
+			return nil, err
+		}
+		lastErr = err
+	}
+	if lastErr != nil {
+		return nil, lastErr
+	}
+	if len(candidates) == 0 {
+		var requiredBy []string
+		for _, req := range state.requirements[name] {
+			requiredBy = append(requiredBy, req.requiredBy)
+		}
+		return nil, errors.Wrapf(ErrDependencyConflict, "addon %s required by %s cannot be found in any registry",
+			name, strings.Join(requiredBy, ", "))
+	}
+	var versions []string
+	for _, candidate := range candidates {
+		versions = append(versions, candidate.version)
+	}
+	if installed, ok := d.installed[name]; ok && !d.updateInstalled {
+		return nil, errors.Wrapf(ErrDependencyConflict, "installed version %s of addon %s doesn't satisfy %s, "+
+			"upgrade it first or update the dependencies together", installed, name, formatRequirements(state.requirements[name]))
+	}
+	return nil, errors.Wrapf(ErrDependencyConflict, "no version of addon %s satisfies %s, available versions: %s",
+		name, formatRequirements(state.requirements[name]), strings.Join(versions, ", "))
+}
+
+// candidates return the versions of the addon to try in order. The installed version is the only candidate if the
+// installed addons are not allowed to be updated. Otherwise, the installed and the locked version are preferred,
+// then the stable versions from new to old, and the pre-release versions at last.
+func (d *DependencyResolver) candidates(ctx context.Context, name string) ([]*addonCandidate, error) {
+	if installed, ok := d.installed[name]; ok && !d.updateInstalled {
+		candidate := &addonCandidate{version: installed, catalog: staticCatalog(d.locks[name].Registry)}
+		if lock, ok := d.locks[name]; ok {
+			candidate.dependencies = lock.Dependencies
+		}
+		candidate.loaded = true
+		return []*addonCandidate{candidate}, nil
+	}
+	all, err := d.listCandidates(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	preferred := map[string]bool{}
+	if installed, ok := d.installed[name]; ok {
+		preferred[installed] = true
+	} else if lock, ok := d.locks[name]; ok {
+		preferred[lock.Version] = true
+	}
+	var res []*addonCandidate
+	for _, candidate := range all {
+		if preferred[candidate.version] {
+			res = append(res, candidate)
+		}
+	}
+	for _, candidate := range all {
+		if !preferred[candidate.version] {
+			res = append(res, candidate)
+		}
+	}
+	return res, nil
+}
+
+// listCandidates merges the versions of the addon in all registries, the same version in the former registry is
+// preferred
+func (d *DependencyResolver) listCandidates(ctx context.Context, name string) ([]*addonCandidate, error) {
+	if res, ok := d.versions[name]; ok {
+		return res, nil
+	}
+	seen := map[string]bool{}
+	var res, others []*addonCandidate
+	for _, catalog := range d.catalogs {
+		versions, err := catalog.listVersions(ctx, name)
+		if err != nil {
+			if errors.Is(err, ErrNotExist) {
+				continue
+			}
+			return nil, errors.Wrapf(err, "fail to list versions of addon %s in registry %s", name, catalog.registryName())
+		}
+		for _, version := range versions {
+			v, err := semver.NewVersion(version)
+			if err != nil {
+				// versions not following semver can only be chosen if there is no constraint on them
+				others = append(others, &addonCandidate{version: version, catalog: catalog})
+				continue
+			}
+			if seen[v.String()] {
+				continue
+			}
+			seen[v.String()] = true
+			res = append(res, &addonCandidate{version: version, catalog: catalog})
+		}
+	}
+	sort.SliceStable(res, func(i, j int) bool {
+		vi, vj := semver.MustParse(res[i].version), semver.MustParse(res[j].version)
+		if (vi.Prerelease() == "") != (vj.Prerelease() == "") {
+			return vi.Prerelease() == ""
+		}
+		return vi.GreaterThan(vj)
+	})
+	res = append(res, others...)
+	d.versions[name] = res
+	return res, nil
+}
+
+func (d *DependencyResolver) loadDependencies(ctx context.Context, name string, candidate *addonCandidate) error {
+	if candidate.loaded {
+		return nil
+	}
+	dependencies, err := candidate.catalog.getDependencies(ctx, name, candidate.version)
+	if err != nil {
+		return errors.Wrapf(err, "fail to load dependencies of addon %s:%s", name, candidate.version)
+	}
+	candidate.dependencies = dependencies
+	candidate.loaded = true
+	return nil
+}
+
+// installOrder sorts the chosen addons so that dependencies are installed before the addons depending on them, the
+// root addon is always the last one even if there are circular dependencies
+func installOrder(state *resolveState, root string) []string {
+	var res []string
+	visited := map[string]bool{root: true}
+	var visit func(name string)
+	visit = func(name string) {
+		if visited[name] {
+			return
+		}
+		visited[name] = true
+		addon, ok := state.chosen[name]
+		if !ok {
+			return
+		}
+		for _, dep := range addon.Dependencies {
+			visit(dep.Name)
+		}
+		res = append(res, name)
+	}
+	for _, name := range state.order {
+		visit(name)
+	}
+	return append(res, root)
+}
+
+func satisfies(version string, requirements []requirement) bool {
+	for _, req := range requirements {
+		if match, _ := checkSemVer(version, req.constraint); !match {
+			return false
+		}
+	}
+	return true
+}
+
+func checkRequirements(name, version string, requirements []requirement) error {
+	if satisfies(version, requirements) {
+		return nil
+	}
+	return errors.Wrapf(ErrDependencyConflict, "chosen version %s of addon %s doesn't satisfy %s",
+		version, name, formatRequirements(requirements))
+}
+
+func formatRequirements(requirements []requirement) string {
+	var res []string
+	for _, req := range requirements {
+		constraint := req.constraint
+		if constraint == "" {
+			constraint = "any version"
+		}
+		res = append(res, fmt.Sprintf("%s (required by %s)", constraint, req.requiredBy))
+	}
+	return strings.Join(res, ", ")
+}
+
+// staticCatalog is the registry of the installed addons whose versions are not allowed to change
+type staticCatalog string
+
+func (s staticCatalog) registryName() string {
+	return string(s)
+}
+
+func (s staticCatalog) listVersions(_ context.Context, _ string) ([]string, error) {
+	return nil, ErrNotExist
+}
+
+func (s staticCatalog) getDependencies(_ context.Context, _, _ string) ([]*Dependency, error) {
+	return nil, nil
+}
+
+// registryCatalog reads addons from a Registry
+type registryCatalog struct {
+	registry Registry
+	// metas are the addons in the registry which doesn't support multi-version
+	metas map[string]SourceMeta
+	// annotations are the chart annotations of the addon versions in the versioned registry
+	annotations map[string]map[string]string
+}
+
+func newRegistryCatalog(registry Registry) *registryCatalog {
+	return &registryCatalog{registry: registry, annotations: map[string]map[string]string{}}
+}
+
+func (r *registryCatalog) registryName() string {
+	return r.registry.Name
+}
+
+func (r *registryCatalog) listVersions(_ context.Context, addonName string) ([]string, error) {
+	if !IsVersionRegistry(r.registry) {
+		uiData, err := r.getUIData(addonName)
+		if err != nil {
+			return nil, err
+		}
+		return []string{uiData.Version}, nil
+	}
+	versionedRegistry, err := ToVersionedRegistry(r.registry)
+	if err != nil {
+		return nil, err
+	}
+	versions, err := versionedRegistry.GetAddonAvailableVersion(addonName)
+	if err != nil {
+		return nil, err
+	}
+	_, lazy := versionedRegistry.(chartMetadataLoader)
+	var res []string
+	for _, version := range versions {
+		res = append(res, version.Version)
+		// the annotations of the versions listed without the whole metadata are loaded lazily in getDependencies
+		if version.Metadata != nil && !lazy {
+			r.annotations[addonName+"/"+version.Version] = version.Annotations
+		}
+	}
+	return res, nil
+}
+
+func (r *registryCatalog) getDependencies(ctx context.Context, addonName, version string) ([]*Dependency, error) {
+	if !IsVersionRegistry(r.registry) {
+		uiData, err := r.getUIData(addonName)
+		if err != nil {
+			return nil, err
+		}
+		return uiData.Dependencies, nil
+	}
+	versionedRegistry, err := ToVersionedRegistry(r.registry)
+	if err != nil {
+		return nil, err
+	}
+	// the dependencies are recorded in the chart annotations if the addon is packaged by vela, so that the
+	// resolver doesn't need to download every addon package to build the dependency graph
+	key := addonName + "/" + version
+	if _, ok := r.annotations[key]; !ok {
+		if r.annotations[key], err = loadVersionAnnotations(ctx, versionedRegistry, addonName, &repo.ChartVersion{Metadata: &chart.Metadata{Version: version}}); err != nil {
+			return nil, err
+		}
+	}
+	if dependencies, ok := r.annotations[key][addonDependencies]; ok {
+		var res []*Dependency
+		if err := json.Unmarshal([]byte(dependencies), &res); err != nil {
+			return nil, errors.Wrapf(err, "invalid annotation %s", addonDependencies)
+		}
+		return res, nil
+	}
+	uiData, err := versionedRegistry.GetAddonUIData(ctx, addonName, version)
+	if err != nil {
+		return nil, err
+	}
+	return uiData.Dependencies, nil
+}
+
+func (r *registryCatalog) getUIData(addonName string) (*UIData, error) {
+	if r.metas == nil {
+		metas, err := r.registry.ListAddonMeta()
+		if err != nil {
+			return nil, err
+		}
+		r.metas = metas
+	}
+	meta, ok := r.metas[addonName]
+	if !ok {
+		return nil, ErrNotExist
+	}
+	return r.registry.GetUIData(&meta, UIMetaOptions)
+}
@@ -0,0 +1,272 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package addon
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+// fakeCatalog is an addon registry with addon name -> version -> dependencies
+type fakeCatalog struct {
+	name   string
+	addons map[string]map[string][]*Dependency
+}
+
+func (f *fakeCatalog) registryName() string {
+	return f.name
+}
+
+func (f *fakeCatalog) listVersions(_ context.Context, addonName string) ([]string, error) {
+	versions, ok := f.addons[addonName]
+	if !ok {
+		return nil, ErrNotExist
+	}
+	var res []string
+	for version := range versions {
+		res = append(res, version)
+	}
+	return res, nil
+}
+
+func (f *fakeCatalog) getDependencies(_ context.Context, addonName, version string) ([]*Dependency, error) {
+	return f.addons[addonName][version], nil
+}
+
+func dep(name, version string) *Dependency {
+	return &Dependency{Name: name, Version: version}
+}
+
+func resolvedVersions(resolution *Resolution) []string {
+	var res []string
+	for _, addon := range resolution.Addons {
+		res = append(res, addon.Name+":"+addon.Version)
+	}
+	return res
+}
+
+func TestDependencyResolver(t *testing.T) {
+	catalog := &fakeCatalog{name: "official", addons: map[string]map[string][]*Dependency{
+		"fluxcd": {
+			"1.0.0":        nil,
+			"1.1.0":        nil,
+			"2.0.0":        nil,
+			"2.1.0-beta.1": nil,
+		},
+		"terraform": {
+			"1.0.0": {dep("fluxcd", ">=1.0.0")},
+			"2.0.0": {dep("fluxcd", ">=2.0.0")},
+		},
+		"velaux": {
+			"1.0.0": {dep("terraform", "")},
+		},
+		"loop-a": {
+			"1.0.0": {dep("loop-b", "")},
+		},
+		"loop-b": {
+			"1.0.0": {dep("loop-a", "")},
+		},
+	}}
+	testCases := map[string]struct {
+		addon           *InstallPackage
+		installed       map[string]string
+		locks           map[string]AddonLock
+		updateInstalled bool
+		expected        []string
+		expectedErr     string
+	}{
+		"latest stable versions are chosen": {
+			addon:    &InstallPackage{Meta: Meta{Name: "velaux", Version: "1.0.0", Dependencies: []*Dependency{dep("terraform", "")}}},
+			expected: []string{"fluxcd:2.0.0", "terraform:2.0.0", "velaux:1.0.0"},
+		},
+		"go back to older version if conflict": {
+			addon: &InstallPackage{Meta: Meta{Name: "velaux", Version: "1.0.0", Dependencies: []*Dependency{
+				dep("terraform", ""), dep("fluxcd", "<2.0.0"),
+			}}},
+			expected: []string{"fluxcd:1.1.0", "terraform:1.0.0", "velaux:1.0.0"},
+		},
+		"pre-release version can be required explicitly": {
+			addon:    &InstallPackage{Meta: Meta{Name: "velaux", Version: "1.0.0", Dependencies: []*Dependency{dep("fluxcd", ">=2.1.0-0")}}},
+			expected: []string{"fluxcd:2.1.0-beta.1", "velaux:1.0.0"},
+		},
+		"no version satisfies all constraints": {
+			addon: &InstallPackage{Meta: Meta{Name: "velaux", Version: "1.0.0", Dependencies: []*Dependency{
+				dep("terraform", ">=2.0.0"), dep("fluxcd", "<2.0.0"),
+			}}},
+			expectedErr: "fluxcd",
+		},
+		"addon not found": {
+			addon:       &InstallPackage{Meta: Meta{Name: "velaux", Version: "1.0.0", Dependencies: []*Dependency{dep("not-exist", "")}}},
+			expectedErr: "addon not-exist required by velaux cannot be found in any registry",
+		},
+		"installed version is kept": {
+			addon:     &InstallPackage{Meta: Meta{Name: "velaux", Version: "1.0.0", Dependencies: []*Dependency{dep("terraform", "")}}},
+			installed: map[string]string{"terraform": "1.0.0", "fluxcd": "1.0.0"},
+			locks: map[string]AddonLock{
+				"terraform": {Name: "terraform", Version: "1.0.0", Registry: "official", Dependencies: []*Dependency{dep("fluxcd", ">=1.0.0")}},
+			},
+			expected: []string{"fluxcd:1.0.0", "terraform:1.0.0", "velaux:1.0.0"},
+		},
+		"installed version conflicts": {
+			addon:       &InstallPackage{Meta: Meta{Name: "velaux", Version: "1.0.0", Dependencies: []*Dependency{dep("fluxcd", ">=2.0.0")}}},
+			installed:   map[string]string{"fluxcd": "1.0.0"},
+			expectedErr: "installed version 1.0.0 of addon fluxcd doesn't satisfy >=2.0.0 (required by velaux)",
+		},
+		"installed version is updated": {
+			addon:           &InstallPackage{Meta: Meta{Name: "velaux", Version: "1.0.0", Dependencies: []*Dependency{dep("fluxcd", ">=1.1.0")}}},
+			installed:       map[string]string{"fluxcd": "1.0.0"},
+			updateInstalled: true,
+			expected:        []string{"fluxcd:2.0.0", "velaux:1.0.0"},
+		},
+		"constraints of other installed addons are respected when updating": {
+			addon:     &InstallPackage{Meta: Meta{Name: "velaux", Version: "1.0.0", Dependencies: []*Dependency{dep("fluxcd", ">=1.1.0")}}},
+			installed: map[string]string{"fluxcd": "1.0.0", "other": "1.0.0"},
+			locks: map[string]AddonLock{
+				"other": {Name: "other", Version: "1.0.0", Dependencies: []*Dependency{dep("fluxcd", "<2.0.0")}},
+				// lock of the addon not installed anymore is ignored
+				"removed": {Name: "removed", Version: "1.0.0", Dependencies: []*Dependency{dep("fluxcd", "<1.1.0")}},
+			},
+			updateInstalled: true,
+			expected:        []string{"fluxcd:1.1.0", "velaux:1.0.0"},
+		},
+		"locked version is preferred": {
+			addon: &InstallPackage{Meta: Meta{Name: "velaux", Version: "1.0.0", Dependencies: []*Dependency{dep("fluxcd", "")}}},
+			locks: map[string]AddonLock{
+				"fluxcd": {Name: "fluxcd", Version: "1.1.0"},
+			},
+			expected: []string{"fluxcd:1.1.0", "velaux:1.0.0"},
+		},
+		"circular dependencies": {
+			addon:    &InstallPackage{Meta: Meta{Name: "loop-a", Version: "1.0.0", Dependencies: []*Dependency{dep("loop-b", "")}}},
+			expected: []string{"loop-b:1.0.0", "loop-a:1.0.0"},
+		},
+		"depends on itself": {
+			addon:       &InstallPackage{Meta: Meta{Name: "velaux", Version: "1.0.0", Dependencies: []*Dependency{dep("velaux", "")}}},
+			expectedErr: "depends on itself",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			r := require.New(t)
+			resolver := newDependencyResolver([]addonCatalog{catalog}, tc.installed, tc.locks, tc.updateInstalled)
+			resolution, err := resolver.Resolve(context.Background(), tc.addon)
+			if tc.expectedErr != "" {
+				r.ErrorIs(err, ErrDependencyConflict)
+				r.Contains(err.Error(), tc.expectedErr)
+				return
+			}
+			r.NoError(err)
+			r.Equal(tc.expected, resolvedVersions(resolution))
+		})
+	}
+}
+
+func TestDependencyResolverMultipleRegistries(t *testing.T) {
+	r := require.New(t)
+	official := &fakeCatalog{name: "official", addons: map[string]map[string][]*Dependency{
+		"fluxcd": {"1.0.0": nil},
+	}}
+	experimental := &fakeCatalog{name: "experimental", addons: map[string]map[string][]*Dependency{
+		"fluxcd":    {"v1.0.0": nil, "2.0.0": nil},
+		"terraform": {"1.0.0": {dep("fluxcd", "<2.0.0")}},
+	}}
+	resolver := newDependencyResolver([]addonCatalog{official, experimental}, map[string]string{"terraform": "0.1.0"}, nil, true)
+	resolution, err := resolver.Resolve(context.Background(), &InstallPackage{Meta: Meta{Name: "velaux", Version: "1.0.0", Dependencies: []*Dependency{dep("terraform", "")}}})
+	r.NoError(err)
+	r.Equal([]string{"fluxcd:1.0.0", "terraform:1.0.0", "velaux:1.0.0"}, resolvedVersions(resolution))
+	// same version in the former registry is preferred
+	r.Equal("official", resolution.Get("fluxcd").Registry)
+	r.Equal("experimental", resolution.Get("terraform").Registry)
+	r.False(resolution.Get("terraform").Installed)
+	r.Nil(resolution.Get("not-exist"))
+}
+
+func TestAddonLock(t *testing.T) {
+	r := require.New(t)
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	r.NoError(v1.AddToScheme(scheme))
+	cli := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+	locks, err := GetAddonLocks(ctx, cli)
+	r.NoError(err)
+	r.Empty(locks)
+
+	fluxcd := AddonLock{Name: "fluxcd", Version: "1.0.0", Registry: "official"}
+	terraform := AddonLock{Name: "terraform", Version: "1.0.0", Registry: "official",
+		Dependencies: []*Dependency{dep("fluxcd", ">=1.0.0")}, ResolvedDependencies: map[string]string{"fluxcd": "1.0.0"}}
+	r.NoError(saveAddonLock(ctx, cli, fluxcd.Name, &fluxcd))
+	r.NoError(saveAddonLock(ctx, cli, terraform.Name, &terraform))
+	locks, err = GetAddonLocks(ctx, cli)
+	r.NoError(err)
+	r.Equal(map[string]AddonLock{"fluxcd": fluxcd, "terraform": terraform}, locks)
+
+	fluxcd.Version = "2.0.0"
+	r.NoError(saveAddonLock(ctx, cli, fluxcd.Name, &fluxcd))
+	r.NoError(saveAddonLock(ctx, cli, terraform.Name, nil))
+	r.NoError(saveAddonLock(ctx, cli, "not-exist", nil))
+	locks, err = GetAddonLocks(ctx, cli)
+	r.NoError(err)
+	r.Equal(map[string]AddonLock{"fluxcd": fluxcd}, locks)
+}
+
+func TestAddonLockConcurrentCreate(t *testing.T) {
+	r := require.New(t)
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	r.NoError(v1.AddToScheme(scheme))
+	fluxcd := AddonLock{Name: "fluxcd", Version: "1.0.0", Registry: "official"}
+	terraform := AddonLock{Name: "terraform", Version: "1.0.0", Registry: "official"}
+	raced := false
+	cli := fake.NewClientBuilder().WithScheme(scheme).WithInterceptorFuncs(interceptor.Funcs{
+		Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+			if !raced {
+				// another addon creates the lock ConfigMap first
+				raced = true
+				r.NoError(saveAddonLock(ctx, c, fluxcd.Name, &fluxcd))
+			}
+			return c.Create(ctx, obj, opts...)
+		},
+	}).Build()
+
+	r.NoError(saveAddonLock(ctx, cli, terraform.Name, &terraform))
+	locks, err := GetAddonLocks(ctx, cli)
+	r.NoError(err)
+	r.Equal(map[string]AddonLock{"fluxcd": fluxcd, "terraform": terraform}, locks)
+}
+
+func TestDependenciesResolved(t *testing.T) {
+	r := require.New(t)
+	h := &Installer{}
+	fluxcd := &InstallPackage{Meta: Meta{Name: "fluxcd", Version: "1.0.0"}}
+	r.False(h.dependenciesResolved(fluxcd))
+
+	h.resolution = &Resolution{Addons: []*ResolvedAddon{
+		{Name: "fluxcd", Version: "1.0.0"},
+		{Name: "terraform", Version: "1.0.0"},
+	}}
+	r.True(h.dependenciesResolved(fluxcd))
+	r.False(h.dependenciesResolved(&InstallPackage{Meta: Meta{Name: "fluxcd", Version: "2.0.0"}}))
+	r.False(h.dependenciesResolved(&InstallPackage{Meta: Meta{Name: "velaux", Version: "1.0.0"}}))
+}
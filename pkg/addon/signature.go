@@ -0,0 +1,281 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package addon
+
+import (
+	"bufio"
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"helm.sh/helm/v3/pkg/chart/loader"
+	"helm.sh/helm/v3/pkg/chartutil"
+)
+
+const (
+	// ChecksumsFileName is the checksum manifest of the addon package, in the format of `sha256sum`
+	ChecksumsFileName = "checksums.txt"
+	// ChecksumsSignatureFileName is the base64 encoded detached signature of the checksum manifest, which is
+	// compatible with `cosign sign-blob`
+	ChecksumsSignatureFileName = "checksums.txt.sig"
+)
+
+// unsignedFiles are the files excluded from the checksum manifest
+var unsignedFiles = map[string]bool{
+	ChecksumsFileName:          true,
+	ChecksumsSignatureFileName: true,
+}
+
+// VerifyAddonPackage verifies the checksum manifest of the addon package against the files in the package, and the
+// signature of the manifest against the trusted public keys. The manifest must be signed by any of the keys.
+func VerifyAddonPackage(r AsyncReader, meta *SourceMeta, publicKeys []string) error {
+	keys, err := ParsePublicKeys(publicKeys)
+	if err != nil {
+		return err
+	}
+	files := addonPackageFiles(r, meta)
+	checksumsPath, foundChecksums := files[ChecksumsFileName]
+	signaturePath, foundSignature := files[ChecksumsSignatureFileName]
+	if !foundChecksums || !foundSignature {
+		return errors.Wrapf(ErrUnsigned, "addon %s has no %s or %s", meta.Name, ChecksumsFileName, ChecksumsSignatureFileName)
+	}
+	manifest, err := r.ReadFile(checksumsPath)
+	if err != nil {
+		return err
+	}
+	signature, err := r.ReadFile(signaturePath)
+	if err != nil {
+		return err
+	}
+	if !verifySignature(keys, []byte(manifest), signature) {
+		return errors.Wrapf(ErrSignatureMismatch, "signature of addon %s is not signed by any trusted key", meta.Name)
+	}
+	checksums, err := parseChecksums(manifest)
+	if err != nil {
+		return errors.Wrapf(ErrSignatureMismatch, "invalid %s of addon %s: %s", ChecksumsFileName, meta.Name, err.Error())
+	}
+	actual, err := computeChecksums(r, files)
+	if err != nil {
+		return err
+	}
+	for file, sum := range actual {
+		expected, ok := checksums[file]
+		if !ok {
+			return errors.Wrapf(ErrSignatureMismatch, "file %s of addon %s is not in %s", file, meta.Name, ChecksumsFileName)
+		}
+		if expected != sum {
+			return errors.Wrapf(ErrSignatureMismatch, "checksum of file %s of addon %s mismatch", file, meta.Name)
+		}
+	}
+	for file := range checksums {
+		if _, ok := actual[file]; !ok {
+			return errors.Wrapf(ErrSignatureMismatch, "file %s of addon %s is missing", file, meta.Name)
+		}
+	}
+	return nil
+}
+
+// SignAddonDir generates the checksum manifest of the addon directory and signs it with the private key in PEM format.
+// Both the manifest and the signature are written into the addon directory.
+func SignAddonDir(dir string, privateKeyPEM []byte) error {
+	key, err := parsePrivateKey(privateKeyPEM)
+	if err != nil {
+		return err
+	}
+	isAddonDir, err := IsAddonDir(dir)
+	if !isAddonDir {
+		return fmt.Errorf("%s is not an addon dir: %w", dir, err)
+	}
+	if err = normalizeChartfile(dir); err != nil {
+		return err
+	}
+	name := filepath.Base(filepath.Clean(dir))
+	r := localReader{dir: dir, name: name}
+	metas, err := r.ListAddonMeta()
+	if err != nil {
+		return err
+	}
+	meta := metas[name]
+	checksums, err := computeChecksums(r, addonPackageFiles(r, &meta))
+	if err != nil {
+		return err
+	}
+	manifest := formatChecksums(checksums)
+	digest := sha256.Sum256(manifest)
+	var signature []byte
+	switch k := key.(type) {
+	case ed25519.PrivateKey:
+		signature, err = k.Sign(rand.Reader, manifest, crypto.Hash(0))
+	case crypto.Signer:
+		signature, err = k.Sign(rand.Reader, digest[:], crypto.SHA256)
+	default:
+		err = fmt.Errorf("unsupported private key type %T", key)
+	}
+	if err != nil {
+		return err
+	}
+	if err = os.WriteFile(filepath.Join(dir, ChecksumsFileName), manifest, 0600); err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(dir, ChecksumsSignatureFileName), []byte(base64.StdEncoding.EncodeToString(signature)), 0600)
+}
+
+// ParsePublicKeys parses the public keys in PEM format, the ECDSA, ED25519 and RSA keys are supported
+func ParsePublicKeys(publicKeys []string) ([]crypto.PublicKey, error) {
+	var keys []crypto.PublicKey
+	for _, publicKey := range publicKeys {
+		block, _ := pem.Decode([]byte(publicKey))
+		if block == nil {
+			return nil, errors.New("invalid public key, PEM format is required")
+		}
+		key, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid public key")
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+func parsePrivateKey(privateKeyPEM []byte) (crypto.PrivateKey, error) {
+	block, _ := pem.Decode(privateKeyPEM)
+	if block == nil {
+		return nil, errors.New("invalid private key, PEM format is required")
+	}
+	switch block.Type {
+	case "EC PRIVATE KEY":
+		return x509.ParseECPrivateKey(block.Bytes)
+	case "RSA PRIVATE KEY":
+		return x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "PRIVATE KEY":
+		return x509.ParsePKCS8PrivateKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("unsupported private key type %s, encrypted keys should be decrypted first", block.Type)
+	}
+}
+
+func verifySignature(keys []crypto.PublicKey, manifest []byte, encodedSignature string) bool {
+	signature, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encodedSignature))
+	if err != nil {
+		return false
+	}
+	digest := sha256.Sum256(manifest)
+	for _, key := range keys {
+		switch k := key.(type) {
+		case *ecdsa.PublicKey:
+			if ecdsa.VerifyASN1(k, digest[:], signature) {
+				return true
+			}
+		case ed25519.PublicKey:
+			if ed25519.Verify(k, manifest, signature) {
+				return true
+			}
+		case *rsa.PublicKey:
+			if rsa.VerifyPKCS1v15(k, crypto.SHA256, digest[:], signature) == nil {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// normalizeChartfile creates the Chart.yaml of the addon if not exists, and rewrites it in the same way as helm does
+// when packaging the chart, so that the signed Chart.yaml is kept unchanged when the addon is pushed to a registry
+func normalizeChartfile(dir string) error {
+	if err := MakeChartCompatible(dir, false); err != nil {
+		return err
+	}
+	ch, err := loader.LoadDir(dir)
+	if err != nil {
+		return err
+	}
+	return chartutil.SaveChartfile(filepath.Join(dir, chartutil.ChartfileName), ch.Metadata)
+}
+
+// addonPackageFiles returns the mapping from the path relative to the addon root to the path for the reader
+func addonPackageFiles(r AsyncReader, meta *SourceMeta) map[string]string {
+	files := map[string]string{}
+	for _, item := range meta.Items {
+		if item.GetType() != FileType {
+			continue
+		}
+		readPath := r.RelativePath(item)
+		files[strings.TrimPrefix(filepath.ToSlash(readPath), meta.Name+"/")] = readPath
+	}
+	return files
+}
+
+func computeChecksums(r AsyncReader, files map[string]string) (map[string]string, error) {
+	checksums := map[string]string{}
+	for file, readPath := range files {
+		if unsignedFiles[file] {
+			continue
+		}
+		content, err := r.ReadFile(readPath)
+		if err != nil {
+			return nil, errors.Wrapf(err, "cannot read file %s", file)
+		}
+		sum := sha256.Sum256([]byte(content))
+		checksums[file] = hex.EncodeToString(sum[:])
+	}
+	return checksums, nil
+}
+
+func formatChecksums(checksums map[string]string) []byte {
+	var files []string
+	for file := range checksums {
+		files = append(files, file)
+	}
+	sort.Strings(files)
+	buf := &bytes.Buffer{}
+	for _, file := range files {
+		_, _ = fmt.Fprintf(buf, "%s  %s\n", checksums[file], file)
+	}
+	return buf.Bytes()
+}
+
+func parseChecksums(manifest string) (map[string]string, error) {
+	checksums := map[string]string{}
+	scanner := bufio.NewScanner(strings.NewReader(manifest))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		sum, file, found := strings.Cut(line, " ")
+		if !found {
+			return nil, fmt.Errorf("invalid line %q", line)
+		}
+		// sha256sum uses '*' to mark binary mode
+		checksums[strings.TrimPrefix(strings.TrimSpace(file), "*")] = sum
+	}
+	return checksums, scanner.Err()
+}
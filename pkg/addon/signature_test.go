@@ -0,0 +1,215 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package addon
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"helm.sh/helm/v3/pkg/chart/loader"
+	"helm.sh/helm/v3/pkg/chartutil"
+)
+
+func generateTestKeyPair(t *testing.T, keyType string) (privateKeyPEM []byte, publicKeyPEM string) {
+	var key crypto.Signer
+	var err error
+	switch keyType {
+	case "ecdsa":
+		key, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	case "ed25519":
+		_, key, err = ed25519.GenerateKey(rand.Reader)
+	case "rsa":
+		key, err = rsa.GenerateKey(rand.Reader, 2048)
+	}
+	require.NoError(t, err)
+	privateKey, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+	publicKey, err := x509.MarshalPKIXPublicKey(key.Public())
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: privateKey}),
+		string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicKey}))
+}
+
+// copyTestAddon copies the example addon into a temp dir, so that it can be signed and tampered
+func copyTestAddon(t *testing.T) string {
+	dir := filepath.Join(t.TempDir(), "example")
+	require.NoError(t, os.CopyFS(dir, os.DirFS("testdata/example")))
+	return dir
+}
+
+func verifyTestAddon(dir string, publicKeys []string) error {
+	r := localReader{dir: dir, name: filepath.Base(dir)}
+	metas, err := r.ListAddonMeta()
+	if err != nil {
+		return err
+	}
+	meta := metas[r.name]
+	return VerifyAddonPackage(r, &meta, publicKeys)
+}
+
+func TestSignAndVerifyAddonPackage(t *testing.T) {
+	for _, keyType := range []string{"ecdsa", "ed25519", "rsa"} {
+		t.Run(keyType, func(t *testing.T) {
+			r := require.New(t)
+			privateKey, publicKey := generateTestKeyPair(t, keyType)
+			_, otherPublicKey := generateTestKeyPair(t, keyType)
+			dir := copyTestAddon(t)
+
+			r.ErrorIs(verifyTestAddon(dir, []string{publicKey}), ErrUnsigned)
+			r.NoError(SignAddonDir(dir, privateKey))
+			manifest, err := os.ReadFile(filepath.Join(dir, ChecksumsFileName))
+			r.NoError(err)
+			r.Contains(string(manifest), "  metadata.yaml\n")
+			r.Contains(string(manifest), "  resources/")
+			r.Contains(string(manifest), "  Chart.yaml\n")
+
+			r.NoError(verifyTestAddon(dir, []string{publicKey}))
+			r.NoError(verifyTestAddon(dir, []string{otherPublicKey, publicKey}))
+			r.ErrorIs(verifyTestAddon(dir, []string{otherPublicKey}), ErrSignatureMismatch)
+
+			// Chart.yaml is signed as well
+			r.NoError(os.WriteFile(filepath.Join(dir, "Chart.yaml"), []byte("name: example\nversion: 2.0.0\n"), 0600))
+			r.ErrorIs(verifyTestAddon(dir, []string{publicKey}), ErrSignatureMismatch)
+		})
+	}
+}
+
+func TestVerifyTamperedAddonPackage(t *testing.T) {
+	privateKey, publicKey := generateTestKeyPair(t, "ecdsa")
+	testCases := map[string]func(dir string) error{
+		"modified file": func(dir string) error {
+			return os.WriteFile(filepath.Join(dir, "template.cue"), []byte("output: {}"), 0600)
+		},
+		"added file": func(dir string) error {
+			return os.WriteFile(filepath.Join(dir, "resources", "backdoor.cue"), []byte("output: {}"), 0600)
+		},
+		"removed file": func(dir string) error {
+			return os.Remove(filepath.Join(dir, "readme.md"))
+		},
+		"modified manifest": func(dir string) error {
+			return os.WriteFile(filepath.Join(dir, ChecksumsFileName), []byte("0000  metadata.yaml\n"), 0600)
+		},
+		"invalid signature": func(dir string) error {
+			return os.WriteFile(filepath.Join(dir, ChecksumsSignatureFileName), []byte("not-base64"), 0600)
+		},
+	}
+	for name, tamper := range testCases {
+		t.Run(name, func(t *testing.T) {
+			r := require.New(t)
+			dir := copyTestAddon(t)
+			r.NoError(SignAddonDir(dir, privateKey))
+			r.NoError(tamper(dir))
+			r.ErrorIs(verifyTestAddon(dir, []string{publicKey}), ErrSignatureMismatch)
+		})
+	}
+
+	t.Run("missing signature", func(t *testing.T) {
+		r := require.New(t)
+		dir := copyTestAddon(t)
+		r.NoError(SignAddonDir(dir, privateKey))
+		r.NoError(os.Remove(filepath.Join(dir, ChecksumsSignatureFileName)))
+		r.ErrorIs(verifyTestAddon(dir, []string{publicKey}), ErrUnsigned)
+	})
+}
+
+func TestParseKeys(t *testing.T) {
+	r := require.New(t)
+	_, err := ParsePublicKeys([]string{"invalid"})
+	r.Error(err)
+	_, err = parsePrivateKey([]byte("invalid"))
+	r.Error(err)
+	_, err = parsePrivateKey(pem.EncodeToMemory(&pem.Block{Type: "ENCRYPTED PRIVATE KEY", Bytes: []byte("x")}))
+	r.Error(err)
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	r.NoError(err)
+	ecKey, err := x509.MarshalECPrivateKey(key)
+	r.NoError(err)
+	parsed, err := parsePrivateKey(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: ecKey}))
+	r.NoError(err)
+	r.True(key.Equal(parsed))
+}
+
+func TestVersionedRegistryVerifySignature(t *testing.T) {
+	r := require.New(t)
+	ctx := context.Background()
+	privateKey, publicKey := generateTestKeyPair(t, "ecdsa")
+	_, otherPublicKey := generateTestKeyPair(t, "ecdsa")
+	ts := newTestOCIServer(t, "", "")
+	url := OCIScheme + strings.TrimPrefix(ts.URL, "http://")
+
+	dir := copyTestAddon(t)
+	r.NoError(SignAddonDir(dir, privateKey))
+	ch, err := loader.LoadDir(dir)
+	r.NoError(err)
+	archive, err := chartutil.Save(ch, t.TempDir())
+	r.NoError(err)
+	p := &PushCmd{ChartName: archive, RepoName: url, UseHTTP: true, Out: io.Discard}
+	r.NoError(p.Push(ctx))
+	// the version of the signed addon cannot be overridden since Chart.yaml is signed
+	p = &PushCmd{ChartName: archive, RepoName: url, ChartVersion: "1.1.0", UseHTTP: true, Out: io.Discard}
+	r.ErrorContains(p.Push(ctx), "cannot override the version of signed addon example")
+	p = &PushCmd{ChartName: dir, RepoName: url, UseHTTP: true, Out: io.Discard}
+	r.NoError(p.Push(ctx))
+
+	vr, err := ToVersionedRegistry(Registry{Name: "signed", OCI: &OCIAddonSource{URL: url, PlainHTTP: true}, PublicKeys: []string{publicKey}})
+	r.NoError(err)
+	pkg, err := vr.GetAddonInstallPackage(ctx, "example", "1.0.1")
+	r.NoError(err)
+	r.Equal("example", pkg.Name)
+
+	vr, err = ToVersionedRegistry(Registry{Name: "signed", OCI: &OCIAddonSource{URL: url, PlainHTTP: true}, PublicKeys: []string{otherPublicKey}})
+	r.NoError(err)
+	_, err = vr.GetAddonInstallPackage(ctx, "example", "1.0.1")
+	r.ErrorIs(err, ErrSignatureMismatch)
+	_, err = vr.GetDetailedAddon(ctx, "example", "")
+	r.ErrorIs(err, ErrSignatureMismatch)
+	// the signature is not required to show the addon
+	_, err = vr.GetAddonUIData(ctx, "example", "")
+	r.NoError(err)
+
+	r.NoError(os.Remove(filepath.Join(dir, ChecksumsSignatureFileName)))
+	ch, err = loader.LoadDir(dir)
+	r.NoError(err)
+	archive, err = chartutil.Save(ch, t.TempDir())
+	r.NoError(err)
+	p = &PushCmd{ChartName: archive, RepoName: url, ChartVersion: "1.2.0", UseHTTP: true, Out: io.Discard}
+	r.NoError(p.Push(ctx))
+	vr, err = ToVersionedRegistry(Registry{Name: "signed", OCI: &OCIAddonSource{URL: url, PlainHTTP: true}, PublicKeys: []string{publicKey}})
+	r.NoError(err)
+	_, err = vr.GetAddonInstallPackage(ctx, "example", "1.2.0")
+	r.ErrorIs(err, ErrUnsigned)
+
+	// verification is skipped when the registry has no trusted keys
+	vr, err = ToVersionedRegistry(Registry{Name: "unsigned", OCI: &OCIAddonSource{URL: url, PlainHTTP: true}})
+	r.NoError(err)
+	_, err = vr.GetAddonInstallPackage(ctx, "example", "1.2.0")
+	r.NoError(err)
+}
@@ -189,6 +189,32 @@ type HelmSource struct {
 	Password        string `json:"password,omitempty"`
 }
 
+// OCIAddonSource defines the information about the OCI registry addon source. Each addon is stored as a helm chart
+// artifact in the repository <url>/<addon name>, and tagged by its version.
+type OCIAddonSource struct {
+	URL             string `json:"url,omitempty" validate:"required"`
+	PlainHTTP       bool   `json:"plainHTTP,omitempty"`
+	InsecureSkipTLS bool   `json:"insecureSkipTLS,omitempty"`
+	// DockerConfigSecretRef is the name of the secret with type kubernetes.io/dockerconfigjson in vela-system
+	// namespace, which provides the credentials to access the OCI registry
+	DockerConfigSecretRef string `json:"dockerConfigSecretRef,omitempty"`
+	// DockerConfigJSON is loaded from the DockerConfigSecretRef, it is never persisted
+	DockerConfigJSON string `json:"-"`
+}
+
+// SafeCopy hides field DockerConfigJSON
+func (o *OCIAddonSource) SafeCopy() *OCIAddonSource {
+	if o == nil {
+		return nil
+	}
+	return &OCIAddonSource{
+		URL:                   o.URL,
+		PlainHTTP:             o.PlainHTTP,
+		InsecureSkipTLS:       o.InsecureSkipTLS,
+		DockerConfigSecretRef: o.DockerConfigSecretRef,
+	}
+}
+
 // SafeCopier is an interface to copy struct without sensitive fields, such as Token, Username, Password
 type SafeCopier interface {
 	SafeCopy() interface{}
@@ -406,12 +432,18 @@ func (r *Registry) ListUIData(registryAddonMeta map[string]SourceMeta, opt ListO
 	return ListAddonUIDataFromReader(reader, registryAddonMeta, r.Name, opt)
 }
 
-// GetInstallPackage get install package which is all needed to enable an addon from addon registry
+// GetInstallPackage get install package which is all needed to enable an addon from addon registry.
+// The signature of the addon package will be verified if the registry has trusted public keys.
 func (r *Registry) GetInstallPackage(meta *SourceMeta, uiData *UIData) (*InstallPackage, error) {
 	reader, err := r.BuildReader()
 	if err != nil {
 		return nil, err
 	}
+	if len(r.PublicKeys) != 0 {
+		if err := VerifyAddonPackage(reader, meta, r.PublicKeys); err != nil {
+			return nil, err
+		}
+	}
 	return GetInstallPackageFromReader(reader, meta, uiData)
 }
 
@@ -18,6 +18,7 @@ package addon
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -39,7 +40,6 @@ import (
 	"github.com/oam-dev/kubevela/pkg/oam"
 	"github.com/oam-dev/kubevela/pkg/oam/util"
 	"github.com/oam-dev/kubevela/pkg/utils/addon"
-	"github.com/oam-dev/kubevela/pkg/utils/common"
 )
 
 const (
@@ -200,11 +200,10 @@ func findLegacyAddonDefs(ctx context.Context, k8sClient client.Client, addonName
 					return errors.Wrapf(err, "cannot fetch addon difinition files from registry")
 				}
 			} else {
-				versionedRegistry := BuildVersionedRegistry(registry.Name, registry.Helm.URL, &common.HTTPOption{
-					Username:        registry.Helm.Username,
-					Password:        registry.Helm.Password,
-					InsecureSkipTLS: registry.Helm.InsecureSkipTLS,
-				})
+				versionedRegistry, err := ToVersionedRegistry(registry)
+				if err != nil {
+					return err
+				}
 				uiData, err = versionedRegistry.GetAddonUIData(ctx, addonName, "")
 				if err != nil {
 					return errors.Wrapf(err, "cannot fetch addon difinition files from registry")
@@ -266,7 +265,7 @@ func IsLocalRegistry(r Registry) bool {
 
 // IsVersionRegistry  check the repo source if support multi-version addon
 func IsVersionRegistry(r Registry) bool {
-	return r.Helm != nil
+	return r.Helm != nil || r.OCI != nil
 }
 
 // InstallOption define additional option for installation
@@ -277,6 +276,16 @@ func SkipValidateVersion(installer *Installer) {
 	installer.skipVersionValidate = true
 }
 
+// InsecureSkipVerify means skip verifying the signature of the addon package even if the registry has trusted keys
+func InsecureSkipVerify(installer *Installer) {
+	installer.insecureSkipVerify = true
+}
+
+// UpdateDependencies means the installed dependencies can be upgraded to the latest versions satisfying the constraints
+func UpdateDependencies(installer *Installer) {
+	installer.updateDependencies = true
+}
+
 // DryRunAddon means only generate yaml for addon instead of installing it
 func DryRunAddon(installer *Installer) {
 	installer.dryRun = true
@@ -448,6 +457,11 @@ func generateAnnotation(meta *Meta) map[string]string {
 			res[kubernetesSystemRequirement] = meta.SystemRequirements.KubernetesVersion
 		}
 	}
+	if len(meta.Dependencies) != 0 {
+		if dependencies, err := json.Marshal(meta.Dependencies); err == nil {
+			res[addonDependencies] = string(dependencies)
+		}
+	}
 	res[addonSystemRequirement] = meta.Name
 	return res
 }
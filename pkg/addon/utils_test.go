@@ -501,6 +501,11 @@ func TestInstallOptions(t *testing.T) {
 		SkipValidateVersion(installer)
 		assert.True(t, installer.skipVersionValidate)
 	})
+	t.Run("InsecureSkipVerify", func(t *testing.T) {
+		installer := &Installer{}
+		InsecureSkipVerify(installer)
+		assert.True(t, installer.insecureSkipVerify)
+	})
 	t.Run("DryRunAddon", func(t *testing.T) {
 		installer := &Installer{}
 		DryRunAddon(installer)
@@ -24,6 +24,7 @@ import (
 
 	"github.com/Masterminds/semver/v3"
 	"github.com/pkg/errors"
+	"helm.sh/helm/v3/pkg/chart"
 	"helm.sh/helm/v3/pkg/chart/loader"
 	"helm.sh/helm/v3/pkg/repo"
 	"k8s.io/klog/v2"
@@ -40,6 +41,9 @@ const (
 	kubernetesSystemRequirement = `system.kubernetes`
 	// addonSystemRequirement is the annotation key to identity an addon from helm chart structure
 	addonSystemRequirement = `addon.name`
+	// addonDependencies is the annotation key of the addon dependencies in json, which is used to resolve the
+	// dependencies without downloading the addon package
+	addonDependencies = `addon.dependencies`
 )
 
 // VersionedRegistry is the interface of support version registry
@@ -66,11 +70,25 @@ func ToVersionedRegistry(registry Registry) (VersionedRegistry, error) {
 	if !IsVersionRegistry(registry) {
 		return nil, errors.Errorf("registry '%s' is not a versioned registry", registry.Name)
 	}
-	return BuildVersionedRegistry(registry.Name, registry.Helm.URL, &common.HTTPOption{
-		Username:        registry.Helm.Username,
-		Password:        registry.Helm.Password,
-		InsecureSkipTLS: registry.Helm.InsecureSkipTLS,
-	}), nil
+	if registry.OCI != nil {
+		r, err := newOCIRegistry(registry.Name, registry.OCI)
+		if err != nil {
+			return nil, err
+		}
+		r.publicKeys = registry.PublicKeys
+		return r, nil
+	}
+	return &versionedRegistry{
+		name: registry.Name,
+		url:  registry.Helm.URL,
+		h:    helm.NewHelperWithCache(),
+		Opts: &common.HTTPOption{
+			Username:        registry.Helm.Username,
+			Password:        registry.Helm.Password,
+			InsecureSkipTLS: registry.Helm.InsecureSkipTLS,
+		},
+		publicKeys: registry.PublicKeys,
+	}, nil
 }
 
 type versionedRegistry struct {
@@ -79,6 +97,8 @@ type versionedRegistry struct {
 	h    *helm.Helper
 	// username and password for registry needs basic auth
 	Opts *common.HTTPOption
+	// publicKeys are the trusted keys to verify the signature of the addon package to be installed
+	publicKeys []string
 }
 
 func (i *versionedRegistry) ListAddon() ([]*UIData, error) {
@@ -90,7 +110,7 @@ func (i *versionedRegistry) ListAddon() ([]*UIData, error) {
 }
 
 func (i *versionedRegistry) GetAddonUIData(ctx context.Context, addonName, version string) (*UIData, error) {
-	wholePackage, err := i.loadAddon(ctx, addonName, version)
+	wholePackage, err := i.loadAddon(ctx, addonName, version, false)
 	if err != nil {
 		return nil, err
 	}
@@ -106,7 +126,7 @@ func (i *versionedRegistry) GetAddonUIData(ctx context.Context, addonName, versi
 }
 
 func (i *versionedRegistry) GetAddonInstallPackage(ctx context.Context, addonName, version string) (*InstallPackage, error) {
-	wholePackage, err := i.loadAddon(ctx, addonName, version)
+	wholePackage, err := i.loadAddon(ctx, addonName, version, true)
 	if err != nil {
 		return nil, err
 	}
@@ -114,7 +134,7 @@ func (i *versionedRegistry) GetAddonInstallPackage(ctx context.Context, addonNam
 }
 
 func (i *versionedRegistry) GetDetailedAddon(ctx context.Context, addonName, version string) (*WholeAddonPackage, error) {
-	wholePackage, err := i.loadAddon(ctx, addonName, version)
+	wholePackage, err := i.loadAddon(ctx, addonName, version, true)
 	if err != nil {
 		return nil, err
 	}
@@ -126,6 +146,28 @@ func (i versionedRegistry) GetAddonAvailableVersion(addonName string) ([]*repo.C
 	return i.loadAddonVersions(addonName)
 }
 
+// chartMetadataLoader loads the chart metadata of an addon version, it is implemented by the registries listing the
+// available versions without the whole chart metadata
+type chartMetadataLoader interface {
+	loadChartMetadata(ctx context.Context, addonName, version string) (*chart.Metadata, error)
+}
+
+// loadVersionAnnotations returns the chart annotations of the addon version listed by GetAddonAvailableVersion, the
+// chart metadata is loaded from the registry if it is not listed together with the versions
+func loadVersionAnnotations(ctx context.Context, registry VersionedRegistry, addonName string, version *repo.ChartVersion) (map[string]string, error) {
+	if loader, ok := registry.(chartMetadataLoader); ok {
+		metadata, err := loader.loadChartMetadata(ctx, addonName, version.Version)
+		if err != nil {
+			return nil, err
+		}
+		return metadata.Annotations, nil
+	}
+	if version.Metadata == nil {
+		return nil, nil
+	}
+	return version.Annotations, nil
+}
+
 func (i *versionedRegistry) resolveAddonListFromIndex(repoName string, index *repo.IndexFile) []*UIData {
 	var res []*UIData
 	for addonName, versions := range index.Entries {
@@ -150,7 +192,9 @@ func (i *versionedRegistry) resolveAddonListFromIndex(repoName string, index *re
 	return res
 }
 
-func (i versionedRegistry) loadAddon(ctx context.Context, name, version string) (*WholeAddonPackage, error) {
+// loadAddon load the addon package, the signature of the package will be verified if verify is true and the registry
+// has trusted public keys
+func (i versionedRegistry) loadAddon(ctx context.Context, name, version string, verify bool) (*WholeAddonPackage, error) {
 	versions, err := i.h.ListVersions(i.url, name, false, i.Opts)
 	if err != nil {
 		return nil, err
@@ -180,7 +224,11 @@ func (i versionedRegistry) loadAddon(ctx context.Context, name, version string)
 			klog.Warningf("failed to load the addon package:%s", err.Error())
 			continue
 		}
-		addonPkg, err := loadAddonPackage(name, bufferedFile)
+		var publicKeys []string
+		if verify {
+			publicKeys = i.publicKeys
+		}
+		addonPkg, err := loadAddonPackage(name, bufferedFile, publicKeys)
 		if err != nil {
 			return nil, err
 		}
@@ -208,13 +256,20 @@ func (i versionedRegistry) loadAddonVersions(addonName string) ([]*repo.ChartVer
 	return versions, nil
 }
 
-func loadAddonPackage(addonName string, files []*loader.BufferedFile) (*WholeAddonPackage, error) {
+// loadAddonPackage load the addon package from files, the signature of the package will be verified if publicKeys
+// is not empty
+func loadAddonPackage(addonName string, files []*loader.BufferedFile, publicKeys []string) (*WholeAddonPackage, error) {
 	mr := MemoryReader{Name: addonName, Files: files}
 	metas, err := mr.ListAddonMeta()
 	if err != nil {
 		return nil, err
 	}
 	meta := metas[addonName]
+	if len(publicKeys) != 0 {
+		if err := VerifyAddonPackage(&mr, &meta, publicKeys); err != nil {
+			return nil, err
+		}
+	}
 	addonUIData, err := GetUIDataFromReader(&mr, &meta, UIMetaOptions)
 	if err != nil {
 		return nil, err
@@ -368,7 +368,7 @@ func TestLoadAddon(t *testing.T) {
 				Opts: nil,
 			}
 
-			pkg, err := reg.loadAddon(context.Background(), tc.addonName, tc.addonVersion)
+			pkg, err := reg.loadAddon(context.Background(), tc.addonName, tc.addonVersion, true)
 
 			if tc.expectErr {
 				assert.Error(t, err)
@@ -52,6 +52,7 @@ import (
 	"github.com/oam-dev/kubevela/pkg/cue/process"
 	"github.com/oam-dev/kubevela/pkg/oam"
 	"github.com/oam-dev/kubevela/pkg/oam/util"
+	"github.com/oam-dev/kubevela/pkg/workflow/step"
 )
 
 func TestWorkflow(t *testing.T) {
@@ -1207,6 +1208,31 @@ func TestWorkflowClient(t *testing.T) {
 		assert.NoError(t, err)
 		assert.Equal(t, cm, retrievedCm)
 	})
+
+	t.Run("Without AppRevision, parameterized workflow cached with resolved steps", func(t *testing.T) {
+		af := &Appfile{}
+		wf := workflow.DeepCopy()
+		wf.SetAnnotations(map[string]string{oam.AnnotationWorkflowParameterSchema: "cluster: string"})
+		wf.Steps = []workflowv1alpha1.WorkflowStep{{WorkflowStepBase: workflowv1alpha1.WorkflowStepBase{
+			Name:       "deploy",
+			Type:       "deploy",
+			Properties: &runtime.RawExtension{Raw: []byte(`{"clusters":["{{parameter.cluster}}"]}`)},
+		}}}
+		cli := fake.NewClientBuilder().WithObjects(wf).Build()
+		app := &v1beta1.Application{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default"},
+			Spec: v1beta1.ApplicationSpec{Workflow: &v1beta1.Workflow{
+				Ref:        wf.Name,
+				Parameters: &runtime.RawExtension{Raw: []byte(`{"cluster":"eu-1"}`)},
+			}},
+		}
+		generator := &step.RefWorkflowStepGenerator{Context: ctx, Client: af.WorkflowClient(cli)}
+		steps, err := generator.Generate(app, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, `{"clusters":["eu-1"]}`, string(steps[0].Properties.Raw))
+		// the workflow recorded in the application revision keeps the resolved steps
+		assert.Equal(t, steps, af.ExternalWorkflow.Steps)
+	})
 }
 
 func TestSetWorkloadRefToTrait(t *testing.T) {
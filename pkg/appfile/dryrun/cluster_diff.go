@@ -0,0 +1,213 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dryrun
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1beta1"
+	"github.com/oam-dev/kubevela/pkg/oam"
+	"github.com/oam-dev/kubevela/pkg/resourcekeeper"
+	"github.com/oam-dev/kubevela/pkg/utils/apply"
+)
+
+// DiffCluster renders the application and dry-runs the dispatch of the resources to every placement cluster, with
+// the same resource policies as the application controller. The results are compared with the live objects in the
+// clusters, and the fields of live objects changed since the last apply are reported as drifts.
+func (l *LiveDiffOption) DiffCluster(ctx context.Context, app *v1beta1.Application) (*DiffEntry, error) {
+	result, err := l.GenerateDryRunResult(ctx, app)
+	if err != nil {
+		return nil, errors.WithMessagef(err, "cannot dry-run for app %q", app.Name)
+	}
+	// the manifests dispatched later override the former ones in the same place
+	var manifests []*unstructured.Unstructured
+	index := map[string]int{}
+	for _, m := range result.Manifests {
+		obj := m.Object.DeepCopy()
+		oam.SetClusterIfEmpty(obj, m.Cluster)
+		if m.Namespace != "" && m.Namespace != result.Namespace {
+			obj.SetNamespace(m.Namespace)
+		}
+		key := oam.GetCluster(obj) + "/" + resourceName(obj)
+		if i, found := index[key]; found {
+			manifests[i] = obj
+			continue
+		}
+		index[key] = len(manifests)
+		manifests = append(manifests, obj)
+	}
+
+	keeperApp := app.DeepCopy()
+	keeperApp.Namespace = result.Namespace
+	rk, err := resourcekeeper.NewResourceKeeper(ctx, l.Client, keeperApp)
+	if err != nil {
+		return nil, errors.WithMessagef(err, "cannot create resourcekeeper for app %q", app.Name)
+	}
+	report := &resourcekeeper.DispatchDryRunReport{}
+	if err = rk.Dispatch(ctx, manifests, nil, resourcekeeper.DryRunDispatchOption{Report: report}); err != nil {
+		return nil, errors.WithMessagef(err, "cannot dry-run the dispatch for app %q", app.Name)
+	}
+
+	entry := &DiffEntry{Name: app.Name, Kind: AppKind}
+	clusters := map[string]*DiffEntry{}
+	for _, res := range report.Resources {
+		if clusters[res.Cluster] == nil {
+			clusters[res.Cluster] = &DiffEntry{Name: res.Cluster, Kind: ClusterKind}
+			entry.Subs = append(entry.Subs, clusters[res.Cluster])
+		}
+		clusters[res.Cluster].Subs = append(clusters[res.Cluster].Subs, diffClusterResource(res))
+	}
+	sort.Slice(entry.Subs, func(i, j int) bool { return entry.Subs[i].Name < entry.Subs[j].Name })
+	for _, sub := range entry.Subs {
+		if sub.HasChanges() {
+			sub.DiffType = ModifyDiff
+			entry.DiffType = ModifyDiff
+		}
+	}
+	return entry, nil
+}
+
+// diffClusterResource compares the result of the dispatch dry-run with the live object
+func diffClusterResource(res resourcekeeper.DispatchDryRunResource) *DiffEntry {
+	entry := &DiffEntry{Name: resourceName(res.Manifest), Kind: ResourceKind, Error: res.Error}
+	if res.Error != "" {
+		return entry
+	}
+	live, err := newClusterObjectManifest(res.Live)
+	if err != nil {
+		entry.Error = err.Error()
+		return entry
+	}
+	desired, err := newClusterObjectManifest(res.Result)
+	if err != nil {
+		entry.Error = err.Error()
+		return entry
+	}
+	entry.Diffs = diffManifest(live, desired)
+	switch {
+	case res.Live == nil:
+		entry.DiffType = AddDiff
+	case hasChanges(entry.Diffs):
+		entry.DiffType = ModifyDiff
+		entry.Patches = patchManifest(live, desired)
+	}
+	if res.Live != nil && !res.ReadOnly {
+		entry.Drifts = findDrifts(res.Live)
+	}
+	return entry
+}
+
+// resourceName identifies the resource by its type and key
+func resourceName(obj *unstructured.Unstructured) string {
+	if obj.GetNamespace() == "" {
+		return fmt.Sprintf("%s %s %s", obj.GetAPIVersion(), obj.GetKind(), obj.GetName())
+	}
+	return fmt.Sprintf("%s %s %s/%s", obj.GetAPIVersion(), obj.GetKind(), obj.GetNamespace(), obj.GetName())
+}
+
+// newClusterObjectManifest generates the manifest of the object in cluster, the fields maintained by the server or
+// changed for every application revision are removed
+func newClusterObjectManifest(obj *unstructured.Unstructured) (*manifest, error) {
+	if obj == nil {
+		return &manifest{}, nil
+	}
+	o := obj.DeepCopy()
+	unstructured.RemoveNestedField(o.Object, "status")
+	for _, field := range []string{"resourceVersion", "generation", "uid", "creationTimestamp", "managedFields", "selfLink"} {
+		unstructured.RemoveNestedField(o.Object, "metadata", field)
+	}
+	removeRevisionRelatedLabelAndAnnotation(o)
+	if labels := o.GetLabels(); labels != nil {
+		delete(labels, apply.LabelRenderHash)
+		o.SetLabels(labels)
+	}
+	if annotations := o.GetAnnotations(); annotations != nil {
+		delete(annotations, oam.AnnotationLastAppliedConfig)
+		delete(annotations, oam.AnnotationLastAppliedTime)
+		o.SetAnnotations(annotations)
+	}
+	for _, field := range []string{"labels", "annotations"} {
+		if m, _, _ := unstructured.NestedMap(o.Object, "metadata", field); len(m) == 0 {
+			unstructured.RemoveNestedField(o.Object, "metadata", field)
+		}
+	}
+	bs, err := yaml.Marshal(o.Object)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot marshal %s", resourceName(obj))
+	}
+	return &manifest{Name: resourceName(obj), Kind: ResourceKind, Data: string(bs)}, nil
+}
+
+// findDrifts returns the changes of the fields in the live object since they are applied last time, which are
+// recorded in the last-applied-configuration annotation
+func findDrifts(live *unstructured.Unstructured) []FieldPatch {
+	var applied map[string]interface{}
+	if err := json.Unmarshal([]byte(live.GetAnnotations()[oam.AnnotationLastAppliedConfig]), &applied); err != nil {
+		return nil
+	}
+	delete(applied, "status")
+	bs, err := json.Marshal(live.Object)
+	if err != nil {
+		return nil
+	}
+	var current interface{}
+	if err = json.Unmarshal(bs, &current); err != nil {
+		return nil
+	}
+	return diffFields("", applied, projectFields(applied, current), nil)
+}
+
+// projectFields keeps the fields in the object that are also in the template, the items of lists are projected
+// by index
+func projectFields(template, obj interface{}) interface{} {
+	switch t := template.(type) {
+	case map[string]interface{}:
+		o, ok := obj.(map[string]interface{})
+		if !ok {
+			return obj
+		}
+		projected := map[string]interface{}{}
+		for k, v := range t {
+			if ov, found := o[k]; found {
+				projected[k] = projectFields(v, ov)
+			}
+		}
+		return projected
+	case []interface{}:
+		o, ok := obj.([]interface{})
+		if !ok {
+			return obj
+		}
+		projected := make([]interface{}, len(o))
+		for i := range o {
+			if i < len(t) {
+				projected[i] = projectFields(t[i], o[i])
+			} else {
+				projected[i] = o[i]
+			}
+		}
+		return projected
+	}
+	return obj
+}
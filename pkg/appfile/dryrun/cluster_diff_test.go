@@ -0,0 +1,100 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dryrun
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/oam-dev/kubevela/pkg/oam"
+	"github.com/oam-dev/kubevela/pkg/resourcekeeper"
+)
+
+func TestDiffClusterResource(t *testing.T) {
+	r := require.New(t)
+	newDeploy := func(replicas int64, image string) *unstructured.Unstructured {
+		obj := &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "apps/v1",
+			"kind":       "Deployment",
+			"metadata": map[string]interface{}{
+				"name":            "web",
+				"namespace":       "default",
+				"resourceVersion": "10",
+				"uid":             "abc",
+			},
+			"spec": map[string]interface{}{
+				"replicas": replicas,
+				"template": map[string]interface{}{"spec": map[string]interface{}{
+					"containers": []interface{}{map[string]interface{}{"name": "main", "image": image}},
+				}},
+			},
+			"status": map[string]interface{}{"readyReplicas": replicas},
+		}}
+		return obj
+	}
+
+	// the object to create
+	entry := diffClusterResource(resourcekeeper.DispatchDryRunResource{Manifest: newDeploy(1, "nginx"), Result: newDeploy(1, "nginx")})
+	r.Equal("apps/v1 Deployment default/web", entry.Name)
+	r.Equal(AddDiff, entry.DiffType)
+	r.Empty(entry.Drifts)
+
+	// the replicas is changed manually and the image would be updated
+	live := newDeploy(5, "nginx")
+	live.SetAnnotations(map[string]string{oam.AnnotationLastAppliedConfig: `{"apiVersion":"apps/v1","kind":"Deployment","spec":{"replicas":1,"template":{"spec":{"containers":[{"name":"main","image":"nginx"}]}}}}`})
+	result := newDeploy(5, "nginx:1.25")
+	result.SetResourceVersion("11")
+	entry = diffClusterResource(resourcekeeper.DispatchDryRunResource{Manifest: newDeploy(1, "nginx:1.25"), Live: live, Result: result})
+	r.Equal(ModifyDiff, entry.DiffType)
+	r.Equal([]FieldPatch{{Op: PatchReplace, Path: "/spec/template/spec/containers/0/image", Value: "nginx:1.25", OldValue: "nginx"}}, entry.Patches)
+	r.Equal([]FieldPatch{{Op: PatchReplace, Path: "/spec/replicas", Value: float64(5), OldValue: float64(1)}}, entry.Drifts)
+
+	// the read-only object is not updated, and the drifts are not reported
+	entry = diffClusterResource(resourcekeeper.DispatchDryRunResource{Manifest: newDeploy(1, "nginx"), Live: live, Result: live.DeepCopy(), ReadOnly: true})
+	r.Equal(NoDiff, entry.DiffType)
+	r.Empty(entry.Drifts)
+
+	// the failure of dispatch
+	entry = diffClusterResource(resourcekeeper.DispatchDryRunResource{Manifest: newDeploy(1, "nginx"), Error: "managed by other application"})
+	r.Equal("managed by other application", entry.Error)
+	r.Empty(entry.Diffs)
+}
+
+func TestFindDrifts(t *testing.T) {
+	r := require.New(t)
+	live := &unstructured.Unstructured{Object: map[string]interface{}{
+		"metadata": map[string]interface{}{"name": "cm"},
+		"data":     map[string]interface{}{"a": "2", "c": "3"},
+	}}
+	live.SetAnnotations(map[string]string{oam.AnnotationLastAppliedConfig: "skip"})
+	r.Nil(findDrifts(live))
+	live.SetAnnotations(map[string]string{oam.AnnotationLastAppliedConfig: `{"metadata":{"name":"cm"},"data":{"a":"1","b":"2"}}`})
+	r.Equal([]FieldPatch{
+		{Op: PatchReplace, Path: "/data/a", Value: "2", OldValue: "1"},
+		{Op: PatchRemove, Path: "/data/b", OldValue: "2"},
+	}, findDrifts(live))
+}
+
+func TestProjectFields(t *testing.T) {
+	r := require.New(t)
+	template := map[string]interface{}{"a": []interface{}{map[string]interface{}{"x": 1}}, "b": 1}
+	obj := map[string]interface{}{"a": []interface{}{map[string]interface{}{"x": 2, "y": 3}, "z"}, "c": 4}
+	r.Equal(map[string]interface{}{"a": []interface{}{map[string]interface{}{"x": 2}, "z"}}, projectFields(template, obj))
+	r.Equal("v", projectFields(template, "v"))
+}
@@ -43,7 +43,7 @@ import (
 // NewLiveDiffOption creates a live-diff option
 func NewLiveDiffOption(c client.Client, cfg *rest.Config, as []*unstructured.Unstructured) *LiveDiffOption {
 	parser := appfile.NewApplicationParser(c)
-	return &LiveDiffOption{DryRun: NewDryRunOption(c, cfg, as, false), Parser: parser}
+	return &LiveDiffOption{DryRun: NewDryRunOption(c, cfg, as, false), Parser: parser, Client: c}
 }
 
 // ManifestKind enums the kind of OAM objects
@@ -58,6 +58,8 @@ const (
 	PolicyKind        ManifestKind = "Policy"
 	WorkflowKind      ManifestKind = "Workflow"
 	ReferredObject    ManifestKind = "ReferredObject"
+	ClusterKind       ManifestKind = "Cluster"
+	ResourceKind      ManifestKind = "Resource"
 )
 
 // DiffEntry records diff info of OAM object
@@ -66,7 +68,11 @@ type DiffEntry struct {
 	Kind     ManifestKind         `json:"kind"`
 	DiffType DiffType             `json:"diffType,omitempty"`
 	Diffs    []difflib.DiffRecord `json:"diffs,omitempty"`
-	Subs     []*DiffEntry         `json:"subs,omitempty"`
+	Patches  []FieldPatch         `json:"patches,omitempty"`
+	// Drifts the fields of the live object changed since the last apply
+	Drifts []FieldPatch `json:"drifts,omitempty"`
+	Error  string       `json:"error,omitempty"`
+	Subs   []*DiffEntry `json:"subs,omitempty"`
 }
 
 // DiffType enums the type of diff
@@ -101,6 +107,8 @@ func (m *manifest) key() string {
 type LiveDiffOption struct {
 	DryRun
 	Parser *appfile.Parser
+	// Client is used to read the live objects when diffing against the clusters
+	Client client.Client
 }
 
 // LiveDiffObject wraps the objects for diff
@@ -216,6 +224,7 @@ func (l *LiveDiffOption) diffManifest(base, comparor *manifest) *DiffEntry {
 	const sep = "\n"
 	entry.Diffs = difflib.Diff(strings.Split(comparor.Data, sep), strings.Split(base.Data, sep))
 	entry.DiffType = calDiffType(entry.Diffs)
+	entry.Patches = patchManifest(comparor, base)
 	baseManifestMap, comparorManifestMap := make(map[string]*manifest), make(map[string]*manifest)
 	var keys []string
 	for _, _base := range base.Subs {
@@ -297,6 +306,7 @@ func (l *LiveDiffOption) calculateDiff(oldApp, newApp *manifest) *DiffEntry {
 	if hasChanges(appDiffs) {
 		r.DiffType = ModifyDiff
 		r.Diffs = appDiffs
+		r.Patches = patchManifest(oldApp, newApp)
 	}
 
 	// check modified and removed components
@@ -337,6 +347,7 @@ func (l *LiveDiffOption) calculateDiff(oldApp, newApp *manifest) *DiffEntry {
 					diffs = diffManifest(oldAccSub, newAccSub)
 					if hasChanges(diffs) {
 						accSubDiffEntry.DiffType = ModifyDiff
+						accSubDiffEntry.Patches = patchManifest(oldAccSub, newAccSub)
 					} else {
 						accSubDiffEntry.DiffType = NoDiff
 					}
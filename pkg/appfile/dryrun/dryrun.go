@@ -50,6 +50,7 @@ import (
 // DryRun executes dry-run on an application
 type DryRun interface {
 	ExecuteDryRun(ctx context.Context, app *v1beta1.Application) ([]*types.ComponentManifest, []*unstructured.Unstructured, error)
+	GenerateDryRunResult(ctx context.Context, app *v1beta1.Application) (*Result, error)
 }
 
 // NewDryRunOption creates a dry-run option
@@ -218,9 +219,31 @@ func (d *Option) PrintDryRun(buff *bytes.Buffer, appName string, comps []*types.
 	return nil
 }
 
+// dryRunTarget is the dry-run result of a deploy workflow step with one of its topology policies
+type dryRunTarget struct {
+	name       string
+	topologies []v1beta1.AppPolicy
+	overrides  []v1beta1.AppPolicy
+	comps      []*types.ComponentManifest
+	policies   []*unstructured.Unstructured
+}
+
 // ExecuteDryRunWithPolicies is similar to ExecuteDryRun func, but considers deploy workflow step and topology+override policies
 func (d *Option) ExecuteDryRunWithPolicies(ctx context.Context, application *v1beta1.Application, buff *bytes.Buffer) error {
+	_, _, targets, err := d.executeDryRunTargets(ctx, application)
+	if err != nil {
+		return err
+	}
+	for _, target := range targets {
+		if err = d.PrintDryRun(buff, target.name, target.comps, target.policies); err != nil {
+			return err
+		}
+	}
+	return nil
+}
 
+// executeDryRunTargets executes dry-run for each deploy workflow step and topology policy of the application
+func (d *Option) executeDryRunTargets(ctx context.Context, application *v1beta1.Application) (context.Context, *v1beta1.Application, []dryRunTarget, error) {
 	app := application.DeepCopy()
 	appNs := ctx.Value(oamutil.AppDefinitionNamespace)
 	if appNs == nil {
@@ -234,64 +257,71 @@ func (d *Option) ExecuteDryRunWithPolicies(ctx context.Context, application *v1b
 	parser := appfile.NewDryRunApplicationParser(d.Client, d.Auxiliaries)
 	af, err := parser.GenerateAppFileFromApp(ctx, app)
 	if err != nil {
-		return err
+		return nil, nil, nil, err
 	}
-	deployWorkflowCount := 0
+	var targets []dryRunTarget
 	for _, wfs := range af.WorkflowSteps {
-		if wfs.Type == step.DeployWorkflowStep {
-			deployWorkflowCount++
-			deployWorkflowStepSpec := &step.DeployWorkflowStepSpec{}
-			if err := utils.StrictUnmarshal(wfs.Properties.Raw, deployWorkflowStepSpec); err != nil {
-				return err
-			}
+		if wfs.Type != step.DeployWorkflowStep {
+			continue
+		}
+		deployWorkflowStepSpec := &step.DeployWorkflowStepSpec{}
+		if err := utils.StrictUnmarshal(wfs.Properties.Raw, deployWorkflowStepSpec); err != nil {
+			return nil, nil, nil, err
+		}
 
-			topologyPolicies, overridePolicies, err := filterPolicies(af.Policies, deployWorkflowStepSpec.Policies)
-			if err != nil {
-				return err
-			}
-			if len(topologyPolicies) > 0 {
-				for _, tp := range topologyPolicies {
-					patchedApp, err := patchApp(app, overridePolicies)
-					if err != nil {
-						return err
-					}
-					comps, pms, err := d.ExecuteDryRun(ctx, patchedApp)
-					if err != nil {
-						return err
-					}
-					err = d.PrintDryRun(buff, fmt.Sprintf("%s with topology %s", patchedApp.Name, tp.Name), comps, pms)
-					if err != nil {
-						return err
-					}
-				}
-			} else {
+		topologyPolicies, overridePolicies, err := filterPolicies(af.Policies, deployWorkflowStepSpec.Policies)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		if len(topologyPolicies) > 0 {
+			for _, tp := range topologyPolicies {
 				patchedApp, err := patchApp(app, overridePolicies)
 				if err != nil {
-					return err
+					return nil, nil, nil, err
 				}
 				comps, pms, err := d.ExecuteDryRun(ctx, patchedApp)
 				if err != nil {
-					return err
-				}
-				err = d.PrintDryRun(buff, fmt.Sprintf("%s only with override policies", patchedApp.Name), comps, pms)
-				if err != nil {
-					return err
+					return nil, nil, nil, err
 				}
+				targets = append(targets, dryRunTarget{
+					name:       fmt.Sprintf("%s with topology %s", patchedApp.Name, tp.Name),
+					topologies: []v1beta1.AppPolicy{tp},
+					overrides:  overridePolicies,
+					comps:      comps,
+					policies:   pms,
+				})
 			}
+		} else {
+			patchedApp, err := patchApp(app, overridePolicies)
+			if err != nil {
+				return nil, nil, nil, err
+			}
+			comps, pms, err := d.ExecuteDryRun(ctx, patchedApp)
+			if err != nil {
+				return nil, nil, nil, err
+			}
+			targets = append(targets, dryRunTarget{
+				name:      fmt.Sprintf("%s only with override policies", patchedApp.Name),
+				overrides: overridePolicies,
+				comps:     comps,
+				policies:  pms,
+			})
 		}
 	}
-	if deployWorkflowCount == 0 {
+	if len(targets) == 0 {
 		comps, pms, err := d.ExecuteDryRun(ctx, app)
 		if err != nil {
-			return err
+			return nil, nil, nil, err
 		}
-		err = d.PrintDryRun(buff, app.Name, comps, pms)
-		if err != nil {
-			return err
+		var topologyPolicies []v1beta1.AppPolicy
+		for _, policy := range af.Policies {
+			if policy.Type == v1alpha1.TopologyPolicyType {
+				topologyPolicies = append(topologyPolicies, policy)
+			}
 		}
+		targets = append(targets, dryRunTarget{name: app.Name, topologies: topologyPolicies, comps: comps, policies: pms})
 	}
-
-	return nil
+	return ctx, app, targets, nil
 }
 
 func filterPolicies(policies []v1beta1.AppPolicy, policyNames []string) ([]v1beta1.AppPolicy, []v1beta1.AppPolicy, error) {
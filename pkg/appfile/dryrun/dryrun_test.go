@@ -110,6 +110,34 @@ var _ = Describe("Test dry run with policies", func() {
 		Expect(buff.String()).Should(ContainSubstring("kind: Service"))
 	})
 
+	It("Test dry run result with provenance", func() {
+
+		appYAML := readDataFromFile("./testdata/testing-dry-run-1.yaml")
+		app := &v1beta1.Application{}
+		Expect(yaml.Unmarshal([]byte(appYAML), &app)).Should(BeNil())
+
+		result, err := dryrunOpt.GenerateDryRunResult(context.TODO(), app)
+		Expect(err).Should(BeNil())
+		Expect(result.Application).Should(Equal("testing-app"))
+		placements := map[string]string{}
+		for _, m := range result.Manifests {
+			Expect(m.Component).Should(Equal("testing-dryrun"))
+			Expect(m.Cluster).Should(Equal("local"))
+			placements[m.Topology] = m.Namespace
+			if m.Topology == "target-prod" {
+				Expect(m.Overrides).Should(Equal([]string{"deploy-ha"}))
+			} else {
+				Expect(m.Overrides).Should(BeEmpty())
+			}
+			if m.Object.GetKind() == "Deployment" {
+				Expect(m.Trait).Should(BeEmpty())
+				replicas := map[string]int64{"target-default": 1, "target-prod": 3}[m.Topology]
+				Expect(m.Object.Object["spec"]).Should(HaveKeyWithValue("replicas", BeEquivalentTo(replicas)))
+			}
+		}
+		Expect(placements).Should(Equal(map[string]string{"target-default": "default", "target-prod": "prod"}))
+	})
+
 	It("Test dry run only with override policy", func() {
 
 		appYAML := readDataFromFile("./testdata/testing-dry-run-2.yaml")
@@ -0,0 +1,152 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dryrun
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+)
+
+// PatchOp enums the operation of field patch
+type PatchOp string
+
+// enum operations of field patch, following JSON patch (RFC 6902)
+const (
+	PatchAdd     PatchOp = "add"
+	PatchRemove  PatchOp = "remove"
+	PatchReplace PatchOp = "replace"
+)
+
+// FieldPatch records the change of a field between two manifests
+type FieldPatch struct {
+	Op PatchOp `json:"op"`
+	// Path is the JSON pointer (RFC 6901) to the field
+	Path     string      `json:"path"`
+	Value    interface{} `json:"value,omitempty"`
+	OldValue interface{} `json:"oldValue,omitempty"`
+}
+
+// HasChanges checks whether the entry or any of its subs has changes
+func (e *DiffEntry) HasChanges() bool {
+	if e == nil {
+		return false
+	}
+	if e.DiffType != NoDiff {
+		return true
+	}
+	for _, sub := range e.Subs {
+		if sub.HasChanges() {
+			return true
+		}
+	}
+	return false
+}
+
+// HasErrors checks whether the entry or any of its subs failed to be compared, the entries with errors have no
+// diff type since the changes are unknown
+func (e *DiffEntry) HasErrors() bool {
+	if e == nil {
+		return false
+	}
+	if e.Error != "" {
+		return true
+	}
+	for _, sub := range e.Subs {
+		if sub.HasErrors() {
+			return true
+		}
+	}
+	return false
+}
+
+// patchManifest calculates the field patches from the old manifest to the new one. Patches are only
+// calculated if both manifests are valid YAML objects, added or removed manifests have no patches.
+func patchManifest(old, new *manifest) []FieldPatch {
+	if old == nil || new == nil || old.Data == "" || new.Data == "" {
+		return nil
+	}
+	var oldObj, newObj interface{}
+	if yaml.Unmarshal([]byte(old.Data), &oldObj) != nil || yaml.Unmarshal([]byte(new.Data), &newObj) != nil {
+		return nil
+	}
+	return diffFields("", oldObj, newObj, nil)
+}
+
+func diffFields(path string, old, new interface{}, patches []FieldPatch) []FieldPatch {
+	switch o := old.(type) {
+	case map[string]interface{}:
+		n, ok := new.(map[string]interface{})
+		if !ok {
+			break
+		}
+		keys := make([]string, 0, len(o)+len(n))
+		for k := range o {
+			keys = append(keys, k)
+		}
+		for k := range n {
+			if _, found := o[k]; !found {
+				keys = append(keys, k)
+			}
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			ov, inOld := o[k]
+			nv, inNew := n[k]
+			p := path + "/" + escapePointer(k)
+			switch {
+			case !inNew:
+				patches = append(patches, FieldPatch{Op: PatchRemove, Path: p, OldValue: ov})
+			case !inOld:
+				patches = append(patches, FieldPatch{Op: PatchAdd, Path: p, Value: nv})
+			default:
+				patches = diffFields(p, ov, nv, patches)
+			}
+		}
+		return patches
+	case []interface{}:
+		n, ok := new.([]interface{})
+		if !ok {
+			break
+		}
+		for i := 0; i < len(n); i++ {
+			p := fmt.Sprintf("%s/%d", path, i)
+			if i < len(o) {
+				patches = diffFields(p, o[i], n[i], patches)
+			} else {
+				patches = append(patches, FieldPatch{Op: PatchAdd, Path: p, Value: n[i]})
+			}
+		}
+		// remove the trailing items from the last one, so that the patches can be applied in order
+		for i := len(o) - 1; i >= len(n); i-- {
+			patches = append(patches, FieldPatch{Op: PatchRemove, Path: fmt.Sprintf("%s/%d", path, i), OldValue: o[i]})
+		}
+		return patches
+	}
+	if !reflect.DeepEqual(old, new) {
+		patches = append(patches, FieldPatch{Op: PatchReplace, Path: path, Value: new, OldValue: old})
+	}
+	return patches
+}
+
+// escapePointer escapes the reference token of JSON pointer
+func escapePointer(token string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(token, "~", "~0"), "/", "~1")
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dryrun
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPatchManifest(t *testing.T) {
+	r := require.New(t)
+	old := &manifest{Data: `
+metadata:
+  name: app
+  labels:
+    app.oam.dev/name: app
+spec:
+  replicas: 1
+  args: [a, b, c]
+  ports:
+  - port: 80
+`}
+	new := &manifest{Data: `
+metadata:
+  name: app
+  annotations:
+    a/b: c
+spec:
+  replicas: 3
+  args: [a]
+  ports:
+  - port: 8080
+  - port: 9090
+`}
+	r.Equal([]FieldPatch{
+		{Op: PatchAdd, Path: "/metadata/annotations", Value: map[string]interface{}{"a/b": "c"}},
+		{Op: PatchRemove, Path: "/metadata/labels", OldValue: map[string]interface{}{"app.oam.dev/name": "app"}},
+		{Op: PatchRemove, Path: "/spec/args/2", OldValue: "c"},
+		{Op: PatchRemove, Path: "/spec/args/1", OldValue: "b"},
+		{Op: PatchReplace, Path: "/spec/ports/0/port", Value: float64(8080), OldValue: float64(80)},
+		{Op: PatchAdd, Path: "/spec/ports/1", Value: map[string]interface{}{"port": float64(9090)}},
+		{Op: PatchReplace, Path: "/spec/replicas", Value: float64(3), OldValue: float64(1)},
+	}, patchManifest(old, new))
+	r.Empty(patchManifest(old, old))
+	r.Nil(patchManifest(&manifest{}, new))
+	r.Equal("/a~1b~0c", "/"+escapePointer("a/b~c"))
+}
+
+func TestDiffEntryHasChanges(t *testing.T) {
+	r := require.New(t)
+	entry := &DiffEntry{Subs: []*DiffEntry{{Subs: []*DiffEntry{{}}}}}
+	r.False(entry.HasChanges())
+	entry.Subs[0].Subs[0].DiffType = ModifyDiff
+	r.True(entry.HasChanges())
+	r.False((*DiffEntry)(nil).HasChanges())
+}
+
+func TestDiffEntryHasErrors(t *testing.T) {
+	r := require.New(t)
+	entry := &DiffEntry{Subs: []*DiffEntry{{Subs: []*DiffEntry{{}}}}}
+	r.False(entry.HasErrors())
+	entry.Subs[0].Subs[0].Error = "managed by other application"
+	r.True(entry.HasErrors())
+	r.False(entry.HasChanges())
+	r.False((*DiffEntry)(nil).HasErrors())
+}
@@ -75,27 +75,55 @@ func (r *ReportDiffOption) printDiffReport(diff *DiffEntry, prefix string) {
 		header = "External Workflow"
 	case ReferredObject:
 		header = "Referred Object"
+	case ClusterKind:
+	case ResourceKind:
+		header = "Resource"
 	default:
 		return
 	}
-	if diff.Kind != AppConfigCompKind {
+	if diff.Kind != AppConfigCompKind && diff.Kind != ClusterKind {
 		editMsg := r.DiffMsgs[diff.DiffType]
-		if diff.DiffType != NoDiff {
+		switch {
+		case diff.Error != "":
+			_, _ = red.Fprintf(r.To, "* %s%s (%s) cannot be compared: %s\n", prefix, header, diff.Name, diff.Error)
+		case diff.DiffType != NoDiff:
 			_, _ = yellow.Fprintf(r.To, "* %s%s (%s) %s\n", prefix, header, diff.Name, editMsg)
 			printDiffs(diff.Diffs, r.Context, r.To)
-		} else {
+		default:
 			_, _ = white.Fprintf(r.To, "* %s%s (%s) %s\n", prefix, header, diff.Name, editMsg)
 		}
+		printDrifts(diff.Drifts, r.To)
 	}
 	for _, sub := range diff.Subs {
 		var subPrefix string
 		if sub.Kind == TraitKind && diff.Kind == AppConfigCompKind {
 			subPrefix = fmt.Sprintf("Component (%s) / ", diff.Name)
 		}
+		if sub.Kind == ResourceKind && diff.Kind == ClusterKind {
+			subPrefix = fmt.Sprintf("Cluster (%s) / ", diff.Name)
+		}
 		r.printDiffReport(sub, subPrefix)
 	}
 }
 
+// printDrifts prints the fields changed in the cluster since the last apply
+func printDrifts(drifts []FieldPatch, to io.Writer) {
+	if len(drifts) == 0 {
+		return
+	}
+	_, _ = red.Fprintf(to, "  drifted from the last applied configuration:\n")
+	for _, drift := range drifts {
+		switch drift.Op {
+		case PatchAdd:
+			_, _ = red.Fprintf(to, "    %s: <none> -> %v\n", drift.Path, drift.Value)
+		case PatchRemove:
+			_, _ = red.Fprintf(to, "    %s: %v -> <none>\n", drift.Path, drift.OldValue)
+		default:
+			_, _ = red.Fprintf(to, "    %s: %v -> %v\n", drift.Path, drift.OldValue, drift.Value)
+		}
+	}
+}
+
 func printDiffs(diffs []difflib.DiffRecord, context int, to io.Writer) {
 	if context > 0 {
 		ctx := calculateContext(diffs)
@@ -0,0 +1,134 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dryrun
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/klog/v2"
+
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1alpha1"
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1beta1"
+	"github.com/oam-dev/kubevela/pkg/multicluster"
+	"github.com/oam-dev/kubevela/pkg/oam"
+	"github.com/oam-dev/kubevela/pkg/policy"
+	"github.com/oam-dev/kubevela/pkg/utils"
+)
+
+// RenderedManifest is a resource rendered by dry-run, together with where it comes from and where it goes
+type RenderedManifest struct {
+	// Component is the component rendering the resource
+	Component string `json:"component,omitempty"`
+	// Trait is the type of the trait rendering the resource, empty for the workload of the component
+	Trait string `json:"trait,omitempty"`
+	// Policy is the policy rendering the resource
+	Policy string `json:"policy,omitempty"`
+	// Topology is the topology policy dispatching the resource
+	Topology string `json:"topology,omitempty"`
+	// Overrides are the override policies patched to the component
+	Overrides []string `json:"overrides,omitempty"`
+	// Cluster is the cluster the resource is dispatched to
+	Cluster string `json:"cluster,omitempty"`
+	// Namespace is the namespace the resource is dispatched to
+	Namespace string                     `json:"namespace,omitempty"`
+	Object    *unstructured.Unstructured `json:"object"`
+}
+
+// Result is the machine-readable result of dry-run
+type Result struct {
+	Application string             `json:"application"`
+	Namespace   string             `json:"namespace"`
+	Manifests   []RenderedManifest `json:"manifests"`
+}
+
+// GenerateDryRunResult is similar to ExecuteDryRunWithPolicies, but returns the rendered resources with their
+// provenance instead of printing them
+func (d *Option) GenerateDryRunResult(ctx context.Context, application *v1beta1.Application) (*Result, error) {
+	ctx, app, targets, err := d.executeDryRunTargets(ctx, application)
+	if err != nil {
+		return nil, err
+	}
+	result := &Result{Application: app.Name, Namespace: app.Namespace, Manifests: []RenderedManifest{}}
+	for _, target := range targets {
+		var overrides []string
+		for _, override := range target.overrides {
+			overrides = append(overrides, override.Name)
+		}
+		topology := ""
+		if len(target.topologies) == 1 {
+			topology = target.topologies[0].Name
+		}
+		for _, placement := range d.getPlacements(ctx, app, target.topologies) {
+			ns := placement.Namespace
+			if ns == "" {
+				ns = app.Namespace
+			}
+			for _, comp := range target.comps {
+				m := RenderedManifest{
+					Component: comp.Name,
+					Topology:  topology,
+					Overrides: overrides,
+					Cluster:   placement.Cluster,
+					Namespace: ns,
+				}
+				if comp.ComponentOutput != nil {
+					m.Object = comp.ComponentOutput
+					result.Manifests = append(result.Manifests, m)
+				}
+				for _, t := range comp.ComponentOutputsAndTraits {
+					m.Trait, m.Object = t.GetLabels()[oam.TraitTypeLabel], t
+					result.Manifests = append(result.Manifests, m)
+				}
+			}
+		}
+		// resources rendered by policies are kept in the hub cluster
+		for _, plc := range target.policies {
+			result.Manifests = append(result.Manifests, RenderedManifest{
+				Policy:    plc.GetName(),
+				Cluster:   multicluster.ClusterLocalName,
+				Namespace: plc.GetNamespace(),
+				Object:    plc,
+			})
+		}
+	}
+	return result, nil
+}
+
+// getPlacements resolves the clusters the resources are dispatched to by the topology policies. The clusters
+// listed in the topology policies are used directly if they cannot be resolved, for example in offline mode.
+func (d *Option) getPlacements(ctx context.Context, app *v1beta1.Application, topologies []v1beta1.AppPolicy) []v1alpha1.PlacementDecision {
+	placements, err := policy.GetPlacementsFromTopologyPolicies(ctx, d.Client, app.Namespace, topologies, true, app.DeepCopy())
+	if err == nil {
+		return placements
+	}
+	klog.Warningf("failed to resolve the placements of application %s: %v", app.Name, err)
+	placements = nil
+	for _, topology := range topologies {
+		topologySpec := &v1alpha1.TopologyPolicySpec{}
+		if topology.Properties == nil || utils.StrictUnmarshal(topology.Properties.Raw, topologySpec) != nil {
+			continue
+		}
+		for _, cluster := range topologySpec.Clusters {
+			placements = append(placements, v1alpha1.PlacementDecision{Cluster: cluster, Namespace: topologySpec.Namespace})
+		}
+	}
+	if len(placements) == 0 {
+		placements = append(placements, v1alpha1.PlacementDecision{Cluster: multicluster.ClusterLocalName})
+	}
+	return placements
+}
@@ -52,12 +52,16 @@ const (
 type Template struct {
 	TemplateStr        string
 	Health             string
+	HealthStatus       string
 	CustomStatus       string
 	Details            string
 	CapabilityCategory types.CapabilityCategory
 	Reference          common.WorkloadTypeDescriptor
 	Terraform          *common.Terraform
 
+	// DisableBuiltinHealthCheck disables the built-in health assessors used when Health and HealthStatus are empty
+	DisableBuiltinHealthCheck bool
+
 	ComponentDefinition *v1beta1.ComponentDefinition
 	WorkloadDefinition  *v1beta1.WorkloadDefinition
 	TraitDefinition     *v1beta1.TraitDefinition
@@ -353,7 +357,9 @@ func loadSchematicToTemplate(tmpl *Template, status *common.Status, schematic *c
 	if status != nil {
 		tmpl.CustomStatus = status.CustomStatus
 		tmpl.Health = status.HealthPolicy
+		tmpl.HealthStatus = status.HealthStatus
 		tmpl.Details = status.Details
+		tmpl.DisableBuiltinHealthCheck = status.DisableBuiltinHealthCheck
 	}
 
 	if schematic != nil {
@@ -405,9 +411,11 @@ func ConvertTemplateJSON2Object(capabilityName string, in *runtime.RawExtension,
 
 func (t *Template) AsStatusRequest(parameter map[string]interface{}) *health.StatusRequest {
 	return &health.StatusRequest{
-		Health:    t.Health,
-		Custom:    t.CustomStatus,
-		Details:   t.Details,
-		Parameter: parameter,
+		Health:                    t.Health,
+		HealthStatus:              t.HealthStatus,
+		Custom:                    t.CustomStatus,
+		Details:                   t.Details,
+		Parameter:                 parameter,
+		DisableBuiltinHealthCheck: t.DisableBuiltinHealthCheck,
 	}
 }
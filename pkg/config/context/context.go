@@ -23,6 +23,7 @@ var DefaultContext = []byte(`
 	context: {
 		name: string
 		namespace: string
+		random: string
 	}
 `)
 
@@ -30,7 +31,13 @@ var DefaultContext = []byte(`
 type ConfigRenderContext struct {
 	Name      string `json:"name"`
 	Namespace string `json:"namespace"`
+	// Random is generated for every render, the rotation of the template could generate the credentials with it
+	Random string `json:"random"`
 }
 
 // ReadConfigProvider the provide function for reading the config properties
 type ReadConfigProvider func(ctx context.Context, namespace string, name string) (map[string]interface{}, error)
+
+// ReadSecretProvider the provide function for reading the data of a secret, such as the credentials referenced by
+// the expanded writer
+type ReadSecretProvider func(ctx context.Context, namespace string, name string) (map[string][]byte, error)
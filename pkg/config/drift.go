@@ -0,0 +1,222 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	pkgtypes "k8s.io/apimachinery/pkg/types"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/common"
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1beta1"
+	"github.com/oam-dev/kubevela/apis/types"
+	"github.com/oam-dev/kubevela/pkg/multicluster"
+)
+
+const (
+	// SyncStateInSync means the distributed config has the same content as the config
+	SyncStateInSync = "InSync"
+	// SyncStateOutOfSync means the distributed config is changed or deleted in the target
+	SyncStateOutOfSync = "OutOfSync"
+	// SyncStateUnknown means the distributed config can not be read from the target
+	SyncStateUnknown = "Unknown"
+)
+
+// TargetSyncStatus the sync state of a config distributed to a target
+type TargetSyncStatus struct {
+	ClusterTarget
+	Config  NamespacedName `json:"config"`
+	State   string         `json:"state"`
+	Message string         `json:"message,omitempty"`
+	// LastTransitionTime is the time the state or the message changed, it is kept across the checks finding no
+	// change so that the recorded status is not rewritten by every check
+	LastTransitionTime time.Time `json:"lastTransitionTime"`
+}
+
+// ConfigDataHash returns the hash of the content of the config secret, the metadata is not included
+func ConfigDataHash(secret *v1.Secret) string {
+	keys := make([]string, 0, len(secret.Data))
+	for key := range secret.Data {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	h := sha256.New()
+	h.Write([]byte(secret.Type))
+	for _, key := range keys {
+		h.Write([]byte{0})
+		h.Write([]byte(key))
+		h.Write([]byte{0})
+		h.Write(secret.Data[key])
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// getSyncStatus reads the sync status recorded in the distribution application
+func getSyncStatus(app *v1beta1.Application) []*TargetSyncStatus {
+	var statuses []*TargetSyncStatus
+	if data, ok := app.Annotations[types.AnnotationConfigDistributionSyncStatus]; ok {
+		if err := json.Unmarshal([]byte(data), &statuses); err != nil {
+			klog.Warningf("fail to parse the sync status of the distribution %s: %s", app.Name, err.Error())
+		}
+	}
+	return statuses
+}
+
+// checkTarget compares the config with the copy distributed to the target
+func (k *kubeConfigFactory) checkTarget(ctx context.Context, config *v1.Secret, target *ClusterTarget) *TargetSyncStatus {
+	status := &TargetSyncStatus{
+		ClusterTarget: *target,
+		Config:        NamespacedName{Name: config.Name, Namespace: config.Namespace},
+		State:         SyncStateInSync,
+	}
+	namespace := target.Namespace
+	if namespace == "" {
+		namespace = config.Namespace
+	}
+	var distributed v1.Secret
+	if err := k.cli.Get(multicluster.ContextWithClusterName(ctx, target.ClusterName), pkgtypes.NamespacedName{Namespace: namespace, Name: config.Name}, &distributed); err != nil {
+		if apierrors.IsNotFound(err) {
+			status.State = SyncStateOutOfSync
+			status.Message = "the config is deleted from the target"
+			return status
+		}
+		status.State = SyncStateUnknown
+		status.Message = fmt.Sprintf("fail to read the config from the target: %s", err.Error())
+		return status
+	}
+	if ConfigDataHash(&distributed) != ConfigDataHash(config) {
+		status.State = SyncStateOutOfSync
+		status.Message = "the config is changed in the target"
+	}
+	return status
+}
+
+// CheckDistribution compares the configs with the copies distributed to the targets and records the sync status
+// to the distribution. The distribution is re-applied if any config is out of sync and the resync is true.
+// The distribution is skipped before the workflow applies the configs.
+func (k *kubeConfigFactory) CheckDistribution(ctx context.Context, namespace, name string, resync bool) ([]*TargetSyncStatus, error) {
+	app := &v1beta1.Application{}
+	if err := k.cli.Get(ctx, pkgtypes.NamespacedName{Namespace: namespace, Name: name}, app); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, ErrNotFoundDistribution
+		}
+		return nil, err
+	}
+	if app.Status.Phase != common.ApplicationRunning {
+		return getSyncStatus(app), nil
+	}
+	var spec CreateDistributionSpec
+	if err := json.Unmarshal([]byte(app.Annotations[types.AnnotationConfigDistributionSpec]), &spec); err != nil {
+		return nil, fmt.Errorf("fail to parse the spec of the distribution %s: %w", name, err)
+	}
+	recorded := getSyncStatus(app)
+	var statuses []*TargetSyncStatus
+	outOfSync := false
+	for _, ref := range spec.Configs {
+		var config v1.Secret
+		if err := k.cli.Get(ctx, pkgtypes.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, &config); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return nil, err
+		}
+		for _, target := range spec.Targets {
+			status := k.checkTarget(ctx, &config, target)
+			status.LastTransitionTime = time.Now()
+			for _, last := range recorded {
+				if last.ClusterTarget == status.ClusterTarget && last.Config == status.Config &&
+					last.State == status.State && last.Message == status.Message {
+					status.LastTransitionTime = last.LastTransitionTime
+				}
+			}
+			outOfSync = outOfSync || status.State == SyncStateOutOfSync
+			statuses = append(statuses, status)
+		}
+	}
+	data, err := json.Marshal(statuses)
+	if err != nil {
+		return nil, err
+	}
+	// the distribution is only patched if the state or the message of any target is changed
+	if app.Annotations[types.AnnotationConfigDistributionSyncStatus] != string(data) {
+		patch := client.MergeFrom(app.DeepCopy())
+		if app.Annotations == nil {
+			app.Annotations = map[string]string{}
+		}
+		app.Annotations[types.AnnotationConfigDistributionSyncStatus] = string(data)
+		if err := k.cli.Patch(ctx, app, patch); err != nil {
+			return nil, fmt.Errorf("fail to record the sync status of the distribution %s: %w", name, err)
+		}
+	}
+	if outOfSync && resync {
+		klog.InfoS("Re-applying the distribution with the configs out of sync", "distribution", klog.KObj(app))
+		if err := k.CreateOrUpdateDistribution(ctx, namespace, name, &spec); err != nil {
+			return statuses, fmt.Errorf("fail to re-apply the distribution %s: %w", name, err)
+		}
+	}
+	return statuses, nil
+}
+
+// CheckDistributions checks all distributions, see CheckDistribution
+func (k *kubeConfigFactory) CheckDistributions(ctx context.Context, resync bool) error {
+	distributions, err := k.ListDistributions(ctx, "")
+	if err != nil {
+		return err
+	}
+	var errs []error
+	for _, dis := range distributions {
+		if _, err := k.CheckDistribution(ctx, dis.Namespace, dis.Name, resync); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return utilerrors.NewAggregate(errs)
+}
+
+// DriftManager checks the distributed configs periodically
+type DriftManager struct {
+	factory Factory
+	period  time.Duration
+	resync  bool
+}
+
+// NewDriftManager creates a drift manager checking the distributions in every period, the distributions with the
+// configs out of sync are re-applied if the resync is true
+func NewDriftManager(cli client.Client, period time.Duration, resync bool) *DriftManager {
+	return &DriftManager{factory: NewConfigFactory(cli), period: period, resync: resync}
+}
+
+// Start checks the distributions until the context is done, it implements the manager.Runnable
+func (m *DriftManager) Start(ctx context.Context) error {
+	wait.UntilWithContext(ctx, func(ctx context.Context) {
+		if err := m.factory.CheckDistributions(ctx, m.resync); err != nil {
+			klog.ErrorS(err, "Failed to check the distributed configs")
+		}
+	}, m.period)
+	return nil
+}
@@ -0,0 +1,121 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	pkgtypes "k8s.io/apimachinery/pkg/types"
+
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/common"
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1beta1"
+	"github.com/oam-dev/kubevela/apis/types"
+	"github.com/oam-dev/kubevela/pkg/oam"
+)
+
+func TestConfigDataHash(t *testing.T) {
+	r := require.New(t)
+	secret := &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "default"},
+		Type:       v1.SecretTypeOpaque,
+		Data:       map[string][]byte{"user": []byte("root"), "password": []byte("p1")},
+	}
+	distributed := secret.DeepCopy()
+	distributed.Namespace = "prod"
+	distributed.Labels = map[string]string{types.LabelConfigCatalog: types.CatalogConfigDistribution}
+	r.Equal(ConfigDataHash(secret), ConfigDataHash(distributed))
+
+	distributed.Data["password"] = []byte("p2")
+	r.NotEqual(ConfigDataHash(secret), ConfigDataHash(distributed))
+	distributed.Data = map[string][]byte{"user": []byte("root"), "passwordp1": nil}
+	r.NotEqual(ConfigDataHash(secret), ConfigDataHash(distributed))
+}
+
+func TestCheckDistribution(t *testing.T) {
+	r := require.New(t)
+	ctx := context.Background()
+	fac, cli := newTestFactory(t)
+
+	applyPasswordConfig(t, fac, "p1")
+	r.NoError(fac.CreateOrUpdateDistribution(ctx, "default", DefaultDistributionName("db"), &CreateDistributionSpec{
+		Configs: []*NamespacedName{{Name: "db", Namespace: "default"}},
+		Targets: []*ClusterTarget{{ClusterName: "local", Namespace: "prod"}},
+	}))
+
+	// the distribution is not checked before the workflow applies the configs
+	statuses, err := fac.CheckDistribution(ctx, "default", DefaultDistributionName("db"), false)
+	r.NoError(err)
+	r.Empty(statuses)
+
+	var app v1beta1.Application
+	r.NoError(cli.Get(ctx, pkgtypes.NamespacedName{Namespace: "default", Name: DefaultDistributionName("db")}, &app))
+	app.Status.Phase = common.ApplicationRunning
+	r.NoError(cli.Update(ctx, &app))
+
+	statuses, err = fac.CheckDistribution(ctx, "default", DefaultDistributionName("db"), false)
+	r.NoError(err)
+	r.Len(statuses, 1)
+	r.Equal(SyncStateOutOfSync, statuses[0].State)
+	r.Equal("the config is deleted from the target", statuses[0].Message)
+
+	var secret v1.Secret
+	r.NoError(cli.Get(ctx, pkgtypes.NamespacedName{Namespace: "default", Name: "db"}, &secret))
+	distributed := &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "prod"},
+		Type:       secret.Type,
+		Data:       secret.Data,
+	}
+	r.NoError(cli.Create(ctx, distributed))
+	statuses, err = fac.CheckDistribution(ctx, "default", DefaultDistributionName("db"), false)
+	r.NoError(err)
+	r.Equal(SyncStateInSync, statuses[0].State)
+	config, err := fac.GetConfig(ctx, "default", "db", true)
+	r.NoError(err)
+	r.Len(config.Targets, 1)
+	r.Equal(SyncStateInSync, config.Targets[0].SyncState)
+
+	// the distribution is not patched if nothing is changed
+	r.NoError(cli.Get(ctx, pkgtypes.NamespacedName{Namespace: "default", Name: DefaultDistributionName("db")}, &app))
+	resourceVersion := app.ResourceVersion
+	transitionTime := statuses[0].LastTransitionTime
+	statuses, err = fac.CheckDistribution(ctx, "default", DefaultDistributionName("db"), false)
+	r.NoError(err)
+	r.Equal(SyncStateInSync, statuses[0].State)
+	r.True(transitionTime.Equal(statuses[0].LastTransitionTime))
+	r.NoError(cli.Get(ctx, pkgtypes.NamespacedName{Namespace: "default", Name: DefaultDistributionName("db")}, &app))
+	r.Equal(resourceVersion, app.ResourceVersion)
+
+	// the change in the target is reported, and the distribution is re-applied
+	distributed.Data["password"] = []byte("changed")
+	r.NoError(cli.Update(ctx, distributed))
+	r.NoError(cli.Get(ctx, pkgtypes.NamespacedName{Namespace: "default", Name: DefaultDistributionName("db")}, &app))
+	publishVersion := app.Annotations[oam.AnnotationPublishVersion]
+	r.NoError(fac.CheckDistributions(ctx, true))
+	config, err = fac.GetConfig(ctx, "default", "db", true)
+	r.NoError(err)
+	r.Equal(SyncStateOutOfSync, config.Targets[0].SyncState)
+	r.Equal("the config is changed in the target", config.Targets[0].SyncMessage)
+	r.NoError(cli.Get(ctx, pkgtypes.NamespacedName{Namespace: "default", Name: DefaultDistributionName("db")}, &app))
+	r.NotEqual(publishVersion, app.Annotations[oam.AnnotationPublishVersion])
+
+	_, err = fac.CheckDistribution(ctx, "default", "not-exist", false)
+	r.ErrorIs(err, ErrNotFoundDistribution)
+}
@@ -61,6 +61,9 @@ const SaveObjectReferenceKey = "objects-reference"
 // SaveExpandedWriterKey define the key name for saving the expanded writer config
 const SaveExpandedWriterKey = "expanded-writer"
 
+// SaveRotationKey define the key name for saving the rotation config
+const SaveRotationKey = "rotation"
+
 // SaveSchemaKey define the key name for saving the API schema
 const SaveSchemaKey = "schema"
 
@@ -127,6 +130,9 @@ type Template struct {
 
 	ExpandedWriter writer.ExpandedWriterConfig `json:"expandedWriter"`
 
+	// Rotation is parsed from the template.rotation field, the config could be rotated only if it is defined.
+	Rotation *Rotation `json:"rotation,omitempty"`
+
 	Schema *openapi3.Schema `json:"schema"`
 
 	ConfigMap *v1.ConfigMap `json:"-"`
@@ -160,6 +166,9 @@ type Config struct {
 	// ExpandedWriterData
 	ExpandedWriterData *writer.ExpandedWriterData `json:"expandedWriterData"`
 
+	// WriterStatuses the sync status of the expanded writers, assigned when the config is created or updated
+	WriterStatuses []writer.WriterStatus `json:"writerStatuses,omitempty"`
+
 	// OutputObjects this means users could define other objects.
 	// This field assign value only on config render stage.
 	OutputObjects map[string]*unstructured.Unstructured
@@ -176,6 +185,9 @@ type ClusterTargetStatus struct {
 	Status      string         `json:"status"`
 	Application NamespacedName `json:"application"`
 	Message     string         `json:"message"`
+	// SyncState whether the distributed config is in sync with the config, it is recorded by the periodic check
+	SyncState   string `json:"syncState,omitempty"`
+	SyncMessage string `json:"syncMessage,omitempty"`
 }
 
 // ClusterTarget kubernetes delivery target
@@ -229,10 +241,17 @@ type Factory interface {
 	CreateOrUpdateConfig(ctx context.Context, i *Config, ns string) error
 	IsExist(ctx context.Context, namespace, name string) (bool, error)
 
+	ListConfigVersions(ctx context.Context, namespace, name string) ([]*Version, error)
+	RollbackConfig(ctx context.Context, namespace, name string, version int) error
+	RotateConfig(ctx context.Context, namespace, name string) error
+	RotateDueConfigs(ctx context.Context) error
+
 	CreateOrUpdateDistribution(ctx context.Context, ns, name string, ads *CreateDistributionSpec) error
 	ListDistributions(ctx context.Context, ns string) ([]*Distribution, error)
 	DeleteDistribution(ctx context.Context, ns, name string) error
 	MergeDistributionStatus(ctx context.Context, config *Config, namespace string) error
+	CheckDistribution(ctx context.Context, namespace, name string, resync bool) ([]*TargetSyncStatus, error)
+	CheckDistributions(ctx context.Context, resync bool) error
 }
 
 // Dispatcher is a client for apply resources.
@@ -296,6 +315,10 @@ func (k *kubeConfigFactory) ParseTemplate(ctx context.Context, defaultName strin
 	if err != nil {
 		return nil, fmt.Errorf("the properties of the cue script is invalid:%w", err)
 	}
+	rotation, err := parseRotation(templateValue)
+	if err != nil {
+		return nil, err
+	}
 	template := &Template{
 		NamespacedName: NamespacedName{
 			Name: tm.Name,
@@ -306,6 +329,7 @@ func (k *kubeConfigFactory) ParseTemplate(ctx context.Context, defaultName strin
 		Template:       cueScript,
 		Schema:         schema,
 		ExpandedWriter: writer.ParseExpandedWriterConfig(templateValue),
+		Rotation:       rotation,
 	}
 
 	var configmap v1.ConfigMap
@@ -326,6 +350,13 @@ func (k *kubeConfigFactory) ParseTemplate(ctx context.Context, defaultName strin
 		return nil, err
 	}
 	configmap.Data[SaveExpandedWriterKey] = string(data)
+	if template.Rotation != nil {
+		data, err := yaml.Marshal(template.Rotation)
+		if err != nil {
+			return nil, err
+		}
+		configmap.Data[SaveRotationKey] = string(data)
+	}
 	configmap.Labels = map[string]string{
 		types.LabelConfigCatalog: types.VelaCoreConfig,
 		types.LabelConfigScope:   template.Scope,
@@ -392,6 +423,13 @@ func convertConfigMap2Template(cm v1.ConfigMap) (*Template, error) {
 		}
 		it.ExpandedWriter = config
 	}
+	if cm.Data[SaveRotationKey] != "" {
+		var rotation Rotation
+		if err := yaml.Unmarshal([]byte(cm.Data[SaveRotationKey]), &rotation); err != nil {
+			return nil, fmt.Errorf("fail to parse the rotation: %w", err)
+		}
+		it.Rotation = &rotation
+	}
 	return it, nil
 }
 
@@ -466,6 +504,7 @@ func (k *kubeConfigFactory) ParseConfig(ctx context.Context,
 		contextValue := icontext.ConfigRenderContext{
 			Name:      meta.Name,
 			Namespace: meta.Namespace,
+			Random:    generateRandom(),
 		}
 		// Compile the config template
 		val, err := template.Template.RunAndOutputWithCueX(ctx, contextValue, meta.Properties)
@@ -621,6 +660,11 @@ func (k *kubeConfigFactory) CreateOrUpdateConfig(ctx context.Context, i *Config,
 		if i.Secret.Type != "" && secret.Type != i.Secret.Type {
 			return ErrChangeSecretType
 		}
+		if err := k.recordHistory(ctx, &secret, i.Secret); err != nil {
+			return fmt.Errorf("fail to record the history of the config: %w", err)
+		}
+	} else {
+		setConfigVersion(i.Secret, 1, time.Now())
 	}
 
 	obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(i.Secret)
@@ -642,9 +686,19 @@ func (k *kubeConfigFactory) CreateOrUpdateConfig(ctx context.Context, i *Config,
 	readConfig := func(ctx context.Context, namespace, name string) (map[string]interface{}, error) {
 		return k.ReadConfig(ctx, namespace, name)
 	}
+	readSecret := func(ctx context.Context, namespace, name string) (map[string][]byte, error) {
+		var secret v1.Secret
+		if err := k.cli.Get(ctx, pkgtypes.NamespacedName{Namespace: namespace, Name: name}, &secret); err != nil {
+			return nil, err
+		}
+		return secret.Data, nil
+	}
 	if i.ExpandedWriterData != nil {
-		if errs := writer.Write(ctx, i.ExpandedWriterData, readConfig); len(errs) > 0 {
-			return errs[0]
+		i.WriterStatuses = writer.Sync(ctx, i.ExpandedWriterData, readConfig, readSecret)
+		for _, status := range i.WriterStatuses {
+			if !status.Synced {
+				return errors.New(status.Message)
+			}
 		}
 	}
 	return nil
@@ -722,6 +776,15 @@ func (k *kubeConfigFactory) DeleteConfig(ctx context.Context, namespace, name st
 		}
 	}
 
+	histories, err := k.listHistory(ctx, namespace, name)
+	if err != nil {
+		return fmt.Errorf("fail to list the history of the config %s:%w", name, err)
+	}
+	for i := range histories {
+		if err := k.cli.Delete(ctx, &histories[i]); err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("fail to clear the history %s:%w", histories[i].Name, err)
+		}
+	}
 	return k.cli.Delete(ctx, &secret)
 }
 
@@ -733,6 +796,7 @@ func (k *kubeConfigFactory) MergeDistributionStatus(ctx context.Context, config
 		}
 		return err
 	}
+	syncStatuses := getSyncStatus(app)
 	var targets []*ClusterTargetStatus
 	for _, policy := range app.Spec.Policies {
 		if policy.Type == v1alpha1.TopologyPolicyType {
@@ -749,7 +813,7 @@ func (k *kubeConfigFactory) MergeDistributionStatus(ctx context.Context, config
 			var spec v1alpha1.TopologyPolicySpec
 			if err := json.Unmarshal(policy.Properties.Raw, &spec); err == nil {
 				for _, clu := range spec.Clusters {
-					targets = append(targets, &ClusterTargetStatus{
+					target := &ClusterTargetStatus{
 						ClusterTarget: ClusterTarget{
 							Namespace:   spec.Namespace,
 							ClusterName: clu,
@@ -757,7 +821,14 @@ func (k *kubeConfigFactory) MergeDistributionStatus(ctx context.Context, config
 						Application: NamespacedName{Name: app.Name, Namespace: app.Namespace},
 						Status:      string(status),
 						Message:     message,
-					})
+					}
+					for _, syncStatus := range syncStatuses {
+						if syncStatus.Config.Name == config.Name && syncStatus.Config.Namespace == config.Namespace && syncStatus.ClusterTarget == target.ClusterTarget {
+							target.SyncState = syncStatus.State
+							target.SyncMessage = syncStatus.Message
+						}
+					}
+					targets = append(targets, target)
 				}
 			}
 		}
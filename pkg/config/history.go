@@ -0,0 +1,250 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	pkgtypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/oam-dev/kubevela/apis/types"
+)
+
+// HistoryLimit is the maximum number of the previous versions kept for a config
+var HistoryLimit = 10
+
+// ErrConfigVersionNotFound means the version of the config does not exist
+var ErrConfigVersionNotFound = errors.New("the version of the config does not exist")
+
+// Version the version of the config
+type Version struct {
+	Version    int       `json:"version"`
+	Current    bool      `json:"current"`
+	CreateTime time.Time `json:"createTime"`
+	// Properties the input properties of this version, it is empty if the config is sensitive.
+	Properties map[string]interface{} `json:"properties,omitempty"`
+}
+
+// HistorySecretName returns the name of the secret saving the previous version of the config
+func HistorySecretName(name string, version int) string {
+	return fmt.Sprintf("%s.v%d", name, version)
+}
+
+// getConfigVersion returns the version of the config secret, the config created before the versioning is version 1
+func getConfigVersion(secret *v1.Secret) int {
+	if version, err := strconv.Atoi(secret.Annotations[types.AnnotationConfigVersion]); err == nil && version > 0 {
+		return version
+	}
+	return 1
+}
+
+// getConfigVersionTime returns the time when the version of the config secret is created
+func getConfigVersionTime(secret *v1.Secret) time.Time {
+	if t, err := time.Parse(time.RFC3339, secret.Annotations[types.AnnotationConfigVersionTime]); err == nil {
+		return t
+	}
+	return secret.CreationTimestamp.Time
+}
+
+// setConfigVersion marks the version of the config secret
+func setConfigVersion(secret *v1.Secret, version int, t time.Time) {
+	if secret.Annotations == nil {
+		secret.Annotations = map[string]string{}
+	}
+	secret.Annotations[types.AnnotationConfigVersion] = strconv.Itoa(version)
+	secret.Annotations[types.AnnotationConfigVersionTime] = t.Format(time.RFC3339)
+}
+
+// isConfigDataChanged checks whether the data to apply changes the current secret. The keys not included in the
+// data to apply are kept by the patch, so they are not compared.
+func isConfigDataChanged(current, next *v1.Secret) bool {
+	for k, v := range next.Data {
+		if !bytes.Equal(current.Data[k], v) {
+			return true
+		}
+	}
+	for k, v := range next.StringData {
+		if string(current.Data[k]) != v {
+			return true
+		}
+	}
+	return false
+}
+
+// recordHistory saves the current secret as the previous version and increases the version of the secret to apply
+// if the data is changed, the previous versions out of the HistoryLimit are removed.
+func (k *kubeConfigFactory) recordHistory(ctx context.Context, current, next *v1.Secret) error {
+	version := getConfigVersion(current)
+	if !isConfigDataChanged(current, next) {
+		setConfigVersion(next, version, getConfigVersionTime(current))
+		return nil
+	}
+	history := &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      HistorySecretName(current.Name, version),
+			Namespace: current.Namespace,
+			Labels: map[string]string{
+				types.LabelConfigCatalog:   types.CatalogConfigHistory,
+				types.LabelConfigHistoryOf: current.Name,
+				types.LabelConfigType:      current.Labels[types.LabelConfigType],
+			},
+			Annotations: map[string]string{},
+		},
+		Type: current.Type,
+		Data: current.Data,
+	}
+	for key, value := range current.Annotations {
+		history.Annotations[key] = value
+	}
+	setConfigVersion(history, version, getConfigVersionTime(current))
+	if err := k.cli.Create(ctx, history); err != nil {
+		if !apierrors.IsAlreadyExists(err) {
+			return err
+		}
+		existing := &v1.Secret{}
+		if err := k.cli.Get(ctx, client.ObjectKeyFromObject(history), existing); err != nil {
+			return err
+		}
+		history.ResourceVersion = existing.ResourceVersion
+		if err := k.cli.Update(ctx, history); err != nil {
+			return err
+		}
+	}
+	setConfigVersion(next, version+1, time.Now())
+	return k.cleanupHistory(ctx, current.Namespace, current.Name)
+}
+
+// listHistory lists the secrets saving the previous versions of the config, the latest version is the first
+func (k *kubeConfigFactory) listHistory(ctx context.Context, namespace, name string) ([]v1.Secret, error) {
+	var list v1.SecretList
+	if err := k.cli.List(ctx, &list, client.InNamespace(namespace), client.MatchingLabels{
+		types.LabelConfigCatalog:   types.CatalogConfigHistory,
+		types.LabelConfigHistoryOf: name,
+	}); err != nil {
+		return nil, err
+	}
+	sort.Slice(list.Items, func(i, j int) bool {
+		return getConfigVersion(&list.Items[i]) > getConfigVersion(&list.Items[j])
+	})
+	return list.Items, nil
+}
+
+func (k *kubeConfigFactory) cleanupHistory(ctx context.Context, namespace, name string) error {
+	histories, err := k.listHistory(ctx, namespace, name)
+	if err != nil {
+		return err
+	}
+	for i := HistoryLimit; i < len(histories); i++ {
+		if err := k.cli.Delete(ctx, &histories[i]); err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// ListConfigVersions list the current and previous versions of the config, the latest version is the first
+func (k *kubeConfigFactory) ListConfigVersions(ctx context.Context, namespace, name string) ([]*Version, error) {
+	var secret v1.Secret
+	if err := k.cli.Get(ctx, pkgtypes.NamespacedName{Namespace: namespace, Name: name}, &secret); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, ErrConfigNotFound
+		}
+		return nil, err
+	}
+	histories, err := k.listHistory(ctx, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	versions := []*Version{convertSecret2Version(&secret)}
+	versions[0].Current = true
+	for i := range histories {
+		versions = append(versions, convertSecret2Version(&histories[i]))
+	}
+	return versions, nil
+}
+
+func convertSecret2Version(secret *v1.Secret) *Version {
+	version := &Version{Version: getConfigVersion(secret), CreateTime: getConfigVersionTime(secret)}
+	if secret.Annotations[types.AnnotationConfigSensitive] != "true" && len(secret.Data[SaveInputPropertiesKey]) > 0 {
+		if err := json.Unmarshal(secret.Data[SaveInputPropertiesKey], &version.Properties); err != nil {
+			klog.Warningf("fail to parse the properties of the config %s: %s", secret.Name, err.Error())
+		}
+	}
+	return version
+}
+
+// RollbackConfig re-creates the config with the properties of the previous version, the rollback generates a new
+// version, the expanded writers and the distributions are synced with the restored config.
+func (k *kubeConfigFactory) RollbackConfig(ctx context.Context, namespace, name string, version int) error {
+	var secret v1.Secret
+	if err := k.cli.Get(ctx, pkgtypes.NamespacedName{Namespace: namespace, Name: name}, &secret); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ErrConfigNotFound
+		}
+		return err
+	}
+	if getConfigVersion(&secret) == version {
+		return fmt.Errorf("the version %d is the current version of the config %s", version, name)
+	}
+	var history v1.Secret
+	if err := k.cli.Get(ctx, pkgtypes.NamespacedName{Namespace: namespace, Name: HistorySecretName(name, version)}, &history); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ErrConfigVersionNotFound
+		}
+		return err
+	}
+	properties := map[string]interface{}{}
+	if err := json.Unmarshal(history.Data[SaveInputPropertiesKey], &properties); err != nil {
+		return fmt.Errorf("fail to parse the properties of the version %d: %w", version, err)
+	}
+	return k.recreateConfig(ctx, &secret, properties, nil)
+}
+
+// recreateConfig renders the config with the template and the properties, and then applies it with the extra annotations
+func (k *kubeConfigFactory) recreateConfig(ctx context.Context, secret *v1.Secret, properties map[string]interface{}, annotations map[string]string) error {
+	config, err := k.ParseConfig(ctx, NamespacedName{
+		Name:      secret.Labels[types.LabelConfigType],
+		Namespace: secret.Annotations[types.AnnotationConfigTemplateNamespace],
+	}, Metadata{
+		NamespacedName: NamespacedName{Name: secret.Name, Namespace: secret.Namespace},
+		Alias:          secret.Annotations[types.AnnotationConfigAlias],
+		Description:    secret.Annotations[types.AnnotationConfigDescription],
+		Properties:     properties,
+	})
+	if err != nil {
+		return err
+	}
+	for key, value := range annotations {
+		config.Secret.Annotations[key] = value
+	}
+	if err := k.CreateOrUpdateConfig(ctx, config, secret.Namespace); err != nil {
+		return err
+	}
+	return k.resyncDistributions(ctx, secret.Namespace, secret.Name)
+}
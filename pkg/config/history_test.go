@@ -0,0 +1,228 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	pkgtypes "k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1beta1"
+	"github.com/oam-dev/kubevela/apis/types"
+	"github.com/oam-dev/kubevela/pkg/oam"
+	"github.com/oam-dev/kubevela/pkg/utils/apply"
+	"github.com/oam-dev/kubevela/pkg/utils/common"
+)
+
+const passwordTemplate = `
+metadata: {
+	name:      "db-password"
+	sensitive: false
+}
+
+template: {
+	output: {
+		type: "Opaque"
+		stringData: {
+			user:     parameter.user
+			password: parameter.password
+		}
+	}
+	rotation: {
+		interval: "24h"
+		properties: password: context.random
+	}
+	parameter: {
+		user:     string
+		password: string
+	}
+}
+`
+
+// mergeDispatcher applies the objects like the merge patch, the existing fields not in the object are kept
+func mergeDispatcher(cli client.Client) Dispatcher {
+	return func(ctx context.Context, objs []*unstructured.Unstructured, _ []apply.ApplyOption) error {
+		for _, obj := range objs {
+			obj = obj.DeepCopy()
+			if obj.GetKind() == "Secret" {
+				stringData, _, _ := unstructured.NestedStringMap(obj.Object, "stringData")
+				for k, v := range stringData {
+					_ = unstructured.SetNestedField(obj.Object, base64.StdEncoding.EncodeToString([]byte(v)), "data", k)
+				}
+				unstructured.RemoveNestedField(obj.Object, "stringData")
+			}
+			existing := &unstructured.Unstructured{}
+			existing.SetGroupVersionKind(obj.GroupVersionKind())
+			if err := cli.Get(ctx, client.ObjectKeyFromObject(obj), existing); err != nil {
+				if !apierrors.IsNotFound(err) {
+					return err
+				}
+				if err := cli.Create(ctx, obj); err != nil {
+					return err
+				}
+				continue
+			}
+			existing.Object = mergeProperties(existing.Object, obj.Object)
+			if err := cli.Update(ctx, existing); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+func newTestFactory(t *testing.T) (Factory, client.Client) {
+	r := require.New(t)
+	cli := fake.NewClientBuilder().WithScheme(common.Scheme).Build()
+	fac := NewConfigFactoryWithDispatcher(cli, mergeDispatcher(cli))
+	template, err := fac.ParseTemplate(context.Background(), "", []byte(passwordTemplate))
+	r.NoError(err)
+	r.Equal(&Rotation{Interval: "24h"}, template.Rotation)
+	r.NoError(fac.CreateOrUpdateConfigTemplate(context.Background(), types.DefaultKubeVelaNS, template))
+	return fac, cli
+}
+
+func applyPasswordConfig(t *testing.T, fac Factory, password string) {
+	r := require.New(t)
+	config, err := fac.ParseConfig(context.Background(), NamespacedName{Name: "db-password", Namespace: types.DefaultKubeVelaNS}, Metadata{
+		NamespacedName: NamespacedName{Name: "db", Namespace: "default"},
+		Properties:     map[string]interface{}{"user": "root", "password": password},
+	})
+	r.NoError(err)
+	r.NoError(fac.CreateOrUpdateConfig(context.Background(), config, "default"))
+}
+
+func readPassword(t *testing.T, cli client.Client) (string, string) {
+	r := require.New(t)
+	var secret v1.Secret
+	r.NoError(cli.Get(context.Background(), pkgtypes.NamespacedName{Namespace: "default", Name: "db"}, &secret))
+	return string(secret.Data["password"]), secret.Annotations[types.AnnotationConfigVersion]
+}
+
+func TestConfigHistory(t *testing.T) {
+	r := require.New(t)
+	ctx := context.Background()
+	fac, cli := newTestFactory(t)
+
+	applyPasswordConfig(t, fac, "p1")
+	password, version := readPassword(t, cli)
+	r.Equal("p1", password)
+	r.Equal("1", version)
+
+	// applying the same properties does not create a new version
+	applyPasswordConfig(t, fac, "p1")
+	_, version = readPassword(t, cli)
+	r.Equal("1", version)
+
+	applyPasswordConfig(t, fac, "p2")
+	password, version = readPassword(t, cli)
+	r.Equal("p2", password)
+	r.Equal("2", version)
+
+	versions, err := fac.ListConfigVersions(ctx, "default", "db")
+	r.NoError(err)
+	r.Len(versions, 2)
+	r.Equal(2, versions[0].Version)
+	r.True(versions[0].Current)
+	r.Equal(1, versions[1].Version)
+	r.False(versions[1].Current)
+	r.Equal("p1", versions[1].Properties["password"])
+
+	// the history is not listed as the config
+	configs, err := fac.ListConfigs(ctx, "default", "", "", false)
+	r.NoError(err)
+	r.Len(configs, 1)
+
+	r.ErrorIs(fac.RollbackConfig(ctx, "default", "db", 5), ErrConfigVersionNotFound)
+	r.Error(fac.RollbackConfig(ctx, "default", "db", 2))
+	r.NoError(fac.RollbackConfig(ctx, "default", "db", 1))
+	password, version = readPassword(t, cli)
+	r.Equal("p1", password)
+	r.Equal("3", version)
+
+	// the previous versions are bounded by the history limit
+	limit := HistoryLimit
+	HistoryLimit = 2
+	defer func() { HistoryLimit = limit }()
+	applyPasswordConfig(t, fac, "p4")
+	versions, err = fac.ListConfigVersions(ctx, "default", "db")
+	r.NoError(err)
+	r.Len(versions, 3)
+	r.Equal([]int{4, 3, 2}, []int{versions[0].Version, versions[1].Version, versions[2].Version})
+
+	r.NoError(fac.DeleteConfig(ctx, "default", "db"))
+	var secrets v1.SecretList
+	r.NoError(cli.List(ctx, &secrets, client.InNamespace("default")))
+	r.Len(secrets.Items, 0)
+}
+
+func TestRotateConfig(t *testing.T) {
+	r := require.New(t)
+	ctx := context.Background()
+	fac, cli := newTestFactory(t)
+
+	applyPasswordConfig(t, fac, "p1")
+	r.NoError(fac.CreateOrUpdateDistribution(ctx, "default", "db-distribution", &CreateDistributionSpec{
+		Configs: []*NamespacedName{{Name: "db", Namespace: "default"}},
+		Targets: []*ClusterTarget{{ClusterName: "local", Namespace: "default"}},
+	}))
+	var app v1beta1.Application
+	r.NoError(cli.Get(ctx, pkgtypes.NamespacedName{Namespace: "default", Name: "db-distribution"}, &app))
+	publishVersion := app.Annotations[oam.AnnotationPublishVersion]
+
+	// the config is not due to rotate, the fake client does not set the creation timestamp, so mark the rotated time
+	var secret v1.Secret
+	r.NoError(cli.Get(ctx, pkgtypes.NamespacedName{Namespace: "default", Name: "db"}, &secret))
+	secret.Annotations[types.AnnotationConfigRotatedTime] = time.Now().Add(-time.Hour).Format(time.RFC3339)
+	r.NoError(cli.Update(ctx, &secret))
+	r.NoError(fac.RotateDueConfigs(ctx))
+	password, version := readPassword(t, cli)
+	r.Equal("p1", password)
+	r.Equal("1", version)
+
+	r.NoError(cli.Get(ctx, pkgtypes.NamespacedName{Namespace: "default", Name: "db"}, &secret))
+	secret.Annotations[types.AnnotationConfigRotatedTime] = time.Now().Add(-25 * time.Hour).Format(time.RFC3339)
+	r.NoError(cli.Update(ctx, &secret))
+
+	r.NoError(fac.RotateDueConfigs(ctx))
+	password, version = readPassword(t, cli)
+	r.Len(password, 32)
+	r.Equal("2", version)
+	r.NoError(cli.Get(ctx, pkgtypes.NamespacedName{Namespace: "default", Name: "db"}, &secret))
+	rotatedTime, err := time.Parse(time.RFC3339, secret.Annotations[types.AnnotationConfigRotatedTime])
+	r.NoError(err)
+	r.WithinDuration(time.Now(), rotatedTime, time.Minute)
+	properties := map[string]interface{}{}
+	r.NoError(json.Unmarshal(secret.Data[SaveInputPropertiesKey], &properties))
+	r.Equal(map[string]interface{}{"user": "root", "password": password}, properties)
+
+	// the distribution is re-synced after the rotation
+	r.NoError(cli.Get(ctx, pkgtypes.NamespacedName{Namespace: "default", Name: "db-distribution"}, &app))
+	r.NotEqual(publishVersion, app.Annotations[oam.AnnotationPublishVersion])
+
+	r.ErrorIs(fac.RotateConfig(ctx, "default", "not-exist"), ErrConfigNotFound)
+}
@@ -0,0 +1,230 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"cuelang.org/go/cue"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	pkgtypes "k8s.io/apimachinery/pkg/types"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/oam-dev/kubevela/apis/types"
+	icontext "github.com/oam-dev/kubevela/pkg/config/context"
+)
+
+// TemplateRotationProperties define the key name for the properties generated by the config-template rotation
+const TemplateRotationProperties = SaveTemplateKey + ".rotation.properties"
+
+// ErrRotationNotDefined means the template of the config does not define the rotation
+var ErrRotationNotDefined = errors.New("the template of the config does not define the rotation")
+
+// Rotation the rotation of the config. When rotating, the properties generated by the template.rotation.properties
+// field are merged to the current properties, the random string could be referenced by context.random.
+type Rotation struct {
+	// Interval the interval of the scheduled rotation, such as 720h. The config is only rotated manually if it is empty.
+	Interval string `json:"interval,omitempty"`
+}
+
+// parseRotation parse the rotation from the template value
+func parseRotation(template cue.Value) (*Rotation, error) {
+	value := template.LookupPath(cue.ParsePath("rotation"))
+	if !value.Exists() {
+		return nil, nil
+	}
+	rotation := &Rotation{}
+	if interval := value.LookupPath(cue.ParsePath("interval")); interval.Exists() {
+		str, err := interval.String()
+		if err != nil {
+			return nil, fmt.Errorf("the rotation interval must be a string: %w", err)
+		}
+		if _, err := time.ParseDuration(str); err != nil {
+			return nil, fmt.Errorf("the rotation interval is invalid: %w", err)
+		}
+		rotation.Interval = str
+	}
+	return rotation, nil
+}
+
+const randomLetters = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+
+// generateRandom generates a random string with 32 letters and digits
+func generateRandom() string {
+	bs := make([]byte, 32)
+	for i := range bs {
+		n, err := rand.Int(rand.Reader, big.NewInt(int64(len(randomLetters))))
+		if err != nil {
+			panic(err)
+		}
+		bs[i] = randomLetters[n.Int64()]
+	}
+	return string(bs)
+}
+
+// mergeProperties merges the generated properties to the current properties recursively
+func mergeProperties(current, generated map[string]interface{}) map[string]interface{} {
+	for k, v := range generated {
+		if gm, ok := v.(map[string]interface{}); ok {
+			if cm, ok := current[k].(map[string]interface{}); ok {
+				current[k] = mergeProperties(cm, gm)
+				continue
+			}
+		}
+		current[k] = v
+	}
+	return current
+}
+
+// RotateConfig regenerates the properties of the config by the rotation of the template. The current version is
+// kept in the history, and the distributions of the config are re-synced.
+func (k *kubeConfigFactory) RotateConfig(ctx context.Context, namespace, name string) error {
+	var secret v1.Secret
+	if err := k.cli.Get(ctx, pkgtypes.NamespacedName{Namespace: namespace, Name: name}, &secret); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ErrConfigNotFound
+		}
+		return err
+	}
+	if secret.Labels[types.LabelConfigCatalog] != types.VelaCoreConfig {
+		return fmt.Errorf("found a secret but is not a config")
+	}
+	if secret.Labels[types.LabelConfigType] == "" {
+		return ErrRotationNotDefined
+	}
+	template, err := k.LoadTemplate(ctx, secret.Labels[types.LabelConfigType], secret.Annotations[types.AnnotationConfigTemplateNamespace])
+	if err != nil {
+		return err
+	}
+	if template.Rotation == nil {
+		return ErrRotationNotDefined
+	}
+	properties := map[string]interface{}{}
+	if err := json.Unmarshal(secret.Data[SaveInputPropertiesKey], &properties); err != nil {
+		return fmt.Errorf("fail to parse the properties of the config: %w", err)
+	}
+	contextValue := icontext.ConfigRenderContext{Name: name, Namespace: namespace, Random: generateRandom()}
+	value, err := template.Template.RunAndOutputWithCueX(ctx, contextValue, properties, TemplateRotationProperties)
+	if err != nil {
+		return fmt.Errorf("fail to render the rotation properties: %w", err)
+	}
+	var generated map[string]interface{}
+	if err := value.Decode(&generated); err != nil {
+		return fmt.Errorf("the rotation properties is invalid: %w", err)
+	}
+	return k.recreateConfig(ctx, &secret, mergeProperties(properties, generated), map[string]string{
+		types.AnnotationConfigRotatedTime: time.Now().Format(time.RFC3339),
+	})
+}
+
+// RotateDueConfigs rotates the configs whose rotation interval defined in the template is reached since the last
+// rotation or the creation
+func (k *kubeConfigFactory) RotateDueConfigs(ctx context.Context) error {
+	var list v1.SecretList
+	if err := k.cli.List(ctx, &list, client.MatchingLabels{types.LabelConfigCatalog: types.VelaCoreConfig}); err != nil {
+		return err
+	}
+	templates := map[string]*Template{}
+	var errs []error
+	for i := range list.Items {
+		secret := list.Items[i]
+		templateName, templateNamespace := secret.Labels[types.LabelConfigType], secret.Annotations[types.AnnotationConfigTemplateNamespace]
+		if templateName == "" {
+			continue
+		}
+		key := templateNamespace + "/" + templateName
+		if _, loaded := templates[key]; !loaded {
+			template, err := k.LoadTemplate(ctx, templateName, templateNamespace)
+			if err != nil && !errors.Is(err, ErrTemplateNotFound) {
+				errs = append(errs, err)
+				continue
+			}
+			templates[key] = template
+		}
+		template := templates[key]
+		if template == nil || template.Rotation == nil || template.Rotation.Interval == "" {
+			continue
+		}
+		interval, err := time.ParseDuration(template.Rotation.Interval)
+		if err != nil {
+			continue
+		}
+		last := secret.CreationTimestamp.Time
+		if t, err := time.Parse(time.RFC3339, secret.Annotations[types.AnnotationConfigRotatedTime]); err == nil {
+			last = t
+		}
+		if time.Since(last) < interval {
+			continue
+		}
+		klog.InfoS("Rotating the config", "config", klog.KObj(&secret), "interval", template.Rotation.Interval)
+		if err := k.RotateConfig(ctx, secret.Namespace, secret.Name); err != nil {
+			errs = append(errs, fmt.Errorf("fail to rotate the config %s/%s: %w", secret.Namespace, secret.Name, err))
+		}
+	}
+	return utilerrors.NewAggregate(errs)
+}
+
+// resyncDistributions re-applies the distributions of the config, so that the changed config is dispatched again
+func (k *kubeConfigFactory) resyncDistributions(ctx context.Context, namespace, name string) error {
+	distributions, err := k.ListDistributions(ctx, "")
+	if err != nil {
+		return fmt.Errorf("fail to list the distributions: %w", err)
+	}
+	for _, dis := range distributions {
+		for _, config := range dis.Configs {
+			if config == nil || config.Name != name || config.Namespace != namespace {
+				continue
+			}
+			if err := k.CreateOrUpdateDistribution(ctx, dis.Namespace, dis.Name, &CreateDistributionSpec{Configs: dis.Configs, Targets: dis.Targets}); err != nil {
+				return fmt.Errorf("fail to re-sync the distribution %s/%s: %w", dis.Namespace, dis.Name, err)
+			}
+			break
+		}
+	}
+	return nil
+}
+
+// RotationManager rotates the configs periodically according to the rotation interval defined in the templates
+type RotationManager struct {
+	factory Factory
+	period  time.Duration
+}
+
+// NewRotationManager creates a rotation manager checking the configs to rotate in every period
+func NewRotationManager(cli client.Client, period time.Duration) *RotationManager {
+	return &RotationManager{factory: NewConfigFactory(cli), period: period}
+}
+
+// Start checks and rotates the configs until the context is done, it implements the manager.Runnable
+func (m *RotationManager) Start(ctx context.Context) error {
+	wait.UntilWithContext(ctx, func(ctx context.Context) {
+		if err := m.factory.RotateDueConfigs(ctx); err != nil {
+			klog.ErrorS(err, "Failed to rotate the configs")
+		}
+	}, m.period)
+	return nil
+}
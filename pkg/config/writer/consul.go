@@ -0,0 +1,78 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package writer
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	icontext "github.com/oam-dev/kubevela/pkg/config/context"
+)
+
+// ConsulWriterName the name of the expanded writer to Consul KV
+const ConsulWriterName = "consul"
+
+// ConsulData the rendered data written to Consul KV
+type ConsulData struct {
+	KVData
+}
+
+// Name returns the writer name
+func (c *ConsulData) Name() string {
+	return ConsulWriterName
+}
+
+// Write writes the config to Consul KV
+func (c *ConsulData) Write(ctx context.Context, configReader icontext.ReadConfigProvider, secretReader icontext.ReadSecretProvider) error {
+	return c.write(ctx, ConsulWriterName, configReader, secretReader, newConsulClient)
+}
+
+// consulClient writes the keys through the HTTP API of Consul KV
+type consulClient struct {
+	*httpKVClient
+	datacenter string
+}
+
+func newConsulClient(_ context.Context, endpoint *kvEndpoint, _ *KVData) (KVClient, error) {
+	c, err := newHTTPKVClient(endpoint.Address)
+	if err != nil {
+		return nil, err
+	}
+	if token := endpoint.Credentials["token"]; token != "" {
+		c.headers["X-Consul-Token"] = token
+	}
+	return &consulClient{httpKVClient: c, datacenter: endpoint.Credentials["datacenter"]}, nil
+}
+
+// Put puts the raw value to the key, Consul responds false if the key is not updated
+func (c *consulClient) Put(ctx context.Context, key string, value []byte) error {
+	path := "/v1/kv/" + strings.TrimPrefix(key, "/")
+	if c.datacenter != "" {
+		path += "?dc=" + url.QueryEscape(c.datacenter)
+	}
+	var updated bool
+	if err := c.do(ctx, http.MethodPut, path, rawBody(value), &updated); err != nil {
+		return err
+	}
+	if !updated {
+		return fmt.Errorf("the key %s is not updated", key)
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package writer
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+
+	icontext "github.com/oam-dev/kubevela/pkg/config/context"
+)
+
+// EtcdWriterName the name of the expanded writer to etcd
+const EtcdWriterName = "etcd"
+
+// EtcdData the rendered data written to etcd
+type EtcdData struct {
+	KVData
+}
+
+// Name returns the writer name
+func (e *EtcdData) Name() string {
+	return EtcdWriterName
+}
+
+// Write writes the config to etcd
+func (e *EtcdData) Write(ctx context.Context, configReader icontext.ReadConfigProvider, secretReader icontext.ReadSecretProvider) error {
+	return e.write(ctx, EtcdWriterName, configReader, secretReader, newEtcdClient)
+}
+
+// etcdClient writes the keys through the JSON gRPC gateway of etcd v3
+type etcdClient struct {
+	*httpKVClient
+}
+
+// newEtcdClient creates the etcd client, it authenticates with the username and password if provided
+func newEtcdClient(ctx context.Context, endpoint *kvEndpoint, _ *KVData) (KVClient, error) {
+	address := endpoint.Address
+	if endpoints, ok := endpoint.Properties["endpoints"].([]interface{}); ok && address == "" && len(endpoints) > 0 {
+		address, _ = endpoints[0].(string)
+	}
+	c, err := newHTTPKVClient(address)
+	if err != nil {
+		return nil, err
+	}
+	if username := endpoint.Credentials["username"]; username != "" {
+		var resp struct {
+			Token string `json:"token"`
+		}
+		auth := map[string]string{"name": username, "password": endpoint.Credentials["password"]}
+		if err := c.do(ctx, http.MethodPost, "/v3/auth/authenticate", auth, &resp); err != nil {
+			return nil, fmt.Errorf("fail to authenticate the user %s:%w", username, err)
+		}
+		c.headers["Authorization"] = resp.Token
+	}
+	return &etcdClient{httpKVClient: c}, nil
+}
+
+// Put puts the value to the key
+func (c *etcdClient) Put(ctx context.Context, key string, value []byte) error {
+	req := map[string]string{
+		"key":   base64.StdEncoding.EncodeToString([]byte(key)),
+		"value": base64.StdEncoding.EncodeToString(value),
+	}
+	return c.do(ctx, http.MethodPost, "/v3/kv/put", req, nil)
+}
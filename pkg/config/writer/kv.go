@@ -0,0 +1,247 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package writer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"runtime/debug"
+	"strings"
+	"time"
+
+	"cuelang.org/go/cue"
+	"github.com/kubevela/workflow/pkg/cue/model/value"
+	"k8s.io/klog/v2"
+
+	"github.com/oam-dev/kubevela/apis/types"
+	icontext "github.com/oam-dev/kubevela/pkg/config/context"
+	"github.com/oam-dev/kubevela/pkg/cue/script"
+)
+
+// KVConfig defines the output to a key-value store, such as Vault, etcd and Consul
+type KVConfig struct {
+	// Endpoint references the config of the store server, including the address and the credentials
+	Endpoint ConfigRef `json:"endpoint"`
+	// Format defines the format in which Data will be output.
+	Format   string           `json:"format"`
+	Metadata KVConfigMetadata `json:"metadata"`
+}
+
+// KVConfigMetadata the metadata of the key-value config
+type KVConfigMetadata struct {
+	// Key the key or the path the content is written to
+	Key string `json:"key"`
+	// Mount the mount path of the secrets engine, only for Vault. Default to secret
+	Mount string `json:"mount,omitempty"`
+}
+
+// KVData merge the key-value endpoint config and the rendered data
+type KVData struct {
+	KVConfig
+	Content []byte `json:"-"`
+	// Client is created from the endpoint config if not set
+	Client KVClient `json:"-"`
+}
+
+// KVClient writes the value to the key-value store
+type KVClient interface {
+	Put(ctx context.Context, key string, value []byte) error
+}
+
+// kvEndpoint the server config of the key-value store
+type kvEndpoint struct {
+	Address    string
+	Properties map[string]interface{}
+	// Credentials the string properties of the endpoint config, overridden by the data of the referenced secret
+	Credentials map[string]string
+}
+
+// newKVClientFunc creates the client of the key-value store from the endpoint
+type newKVClientFunc func(ctx context.Context, endpoint *kvEndpoint, data *KVData) (KVClient, error)
+
+// parseKVConfig parse the key-value writer config with the given name, the metadata is rendered with the properties
+// when the config is created, so only the endpoint and the format are parsed here.
+func parseKVConfig(template cue.Value, name string) *KVConfig {
+	kv := template.LookupPath(cue.ParsePath(name))
+	if !kv.Exists() {
+		return nil
+	}
+	kvConfig := &KVConfig{}
+	if err := kv.LookupPath(cue.ParsePath("endpoint")).Decode(&kvConfig.Endpoint); err != nil {
+		klog.Warningf("fail to get the endpoint from the %s config: %s", name, err.Error())
+	}
+	if format := kv.LookupPath(cue.ParsePath("format")); format.Exists() {
+		kvConfig.Format, _ = format.String()
+	}
+	return kvConfig
+}
+
+func renderKV(name string, config *KVConfig, template script.CUE, context icontext.ConfigRenderContext, properties map[string]interface{}) (*KVData, error) {
+	kv, err := template.RunAndOutput(context, properties, "template", name)
+	if err != nil {
+		return nil, err
+	}
+	format, err := kv.LookupPath(cue.ParsePath("format")).String()
+	if err != nil {
+		format = config.Format
+	}
+	var kvData KVData
+	if err := value.UnmarshalTo(kv, &kvData); err != nil {
+		return nil, err
+	}
+	content := kv.LookupPath(cue.ParsePath("content"))
+	if content.Err() != nil {
+		return nil, content.Err()
+	}
+	out, err := encodingOutput(content, format)
+	if err != nil {
+		return nil, err
+	}
+	kvData.Format = format
+	kvData.Content = out
+	if kvData.Endpoint.Namespace == "" {
+		kvData.Endpoint.Namespace = types.DefaultKubeVelaNS
+	}
+	if kvData.Metadata.Key == "" {
+		return nil, fmt.Errorf("the key of the %s config is required", name)
+	}
+	return &kvData, nil
+}
+
+func (d *KVData) write(ctx context.Context, name string, configReader icontext.ReadConfigProvider, secretReader icontext.ReadSecretProvider, newClient newKVClientFunc) (err error) {
+	defer func() {
+		if rec := recover(); rec != nil {
+			err = fmt.Errorf("panic when writing the data to %s:%v", name, rec)
+			debug.PrintStack()
+		}
+	}()
+	if d.Client == nil {
+		endpoint, err := readKVEndpoint(ctx, d.Endpoint, configReader, secretReader)
+		if err != nil {
+			return fmt.Errorf("fail to read the config of the %s server:%w", name, err)
+		}
+		if d.Client, err = newClient(ctx, endpoint, d); err != nil {
+			return fmt.Errorf("fail to create the %s client:%w", name, err)
+		}
+	}
+	if err := d.Client.Put(ctx, d.Metadata.Key, d.Content); err != nil {
+		return fmt.Errorf("fail to write the config to the %s server:%w", name, err)
+	}
+	return nil
+}
+
+// readKVEndpoint reads the server config, and the credentials from the secret referenced by the authSecretRef
+// property. The secret is used because the sensitive config can not be read.
+func readKVEndpoint(ctx context.Context, ref ConfigRef, configReader icontext.ReadConfigProvider, secretReader icontext.ReadSecretProvider) (*kvEndpoint, error) {
+	properties, err := configReader(ctx, ref.Namespace, ref.Name)
+	if err != nil {
+		return nil, err
+	}
+	endpoint := &kvEndpoint{Properties: properties, Credentials: map[string]string{}}
+	for k, v := range properties {
+		if str, ok := v.(string); ok {
+			endpoint.Credentials[k] = str
+		}
+	}
+	endpoint.Address = strings.TrimSuffix(endpoint.Credentials["address"], "/")
+	secretRef, ok := properties["authSecretRef"].(map[string]interface{})
+	if !ok {
+		return endpoint, nil
+	}
+	secretName, _ := secretRef["name"].(string)
+	secretNamespace, _ := secretRef["namespace"].(string)
+	if secretNamespace == "" {
+		secretNamespace = ref.Namespace
+	}
+	// the config can only reference the secret in its own namespace, or anyone able to create a config could read
+	// the secrets in any namespace with the permission of the controller
+	if secretNamespace != ref.Namespace {
+		return nil, fmt.Errorf("the auth secret %s/%s must be in the namespace of the config %s/%s", secretNamespace, secretName, ref.Namespace, ref.Name)
+	}
+	if secretReader == nil {
+		return nil, fmt.Errorf("can not read the auth secret %s/%s", secretNamespace, secretName)
+	}
+	data, err := secretReader(ctx, secretNamespace, secretName)
+	if err != nil {
+		return nil, fmt.Errorf("fail to read the auth secret %s/%s:%w", secretNamespace, secretName, err)
+	}
+	for k, v := range data {
+		endpoint.Credentials[k] = string(v)
+	}
+	return endpoint, nil
+}
+
+// httpKVClient the common HTTP client for the stores providing the HTTP API
+type httpKVClient struct {
+	address string
+	headers map[string]string
+	client  *http.Client
+}
+
+func newHTTPKVClient(address string) (*httpKVClient, error) {
+	if address == "" {
+		return nil, fmt.Errorf("the address of the server is required")
+	}
+	return &httpKVClient{address: address, headers: map[string]string{}, client: &http.Client{Timeout: 30 * time.Second}}, nil
+}
+
+// rawBody is sent as it is instead of being encoded as JSON
+type rawBody []byte
+
+// do sends the request with the JSON body, and decodes the JSON response to the out if it is not nil
+func (c *httpKVClient) do(ctx context.Context, method, path string, body interface{}, out interface{}) error {
+	var reader io.Reader
+	if raw, ok := body.(rawBody); ok {
+		reader = bytes.NewReader(raw)
+	} else if body != nil {
+		bs, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(bs)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, c.address+path, reader)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range c.headers {
+		req.Header.Set(k, v)
+	}
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("the server responds %d: %s", resp.StatusCode, strings.TrimSpace(string(respBody)))
+	}
+	if out != nil && len(respBody) > 0 {
+		return json.Unmarshal(respBody, out)
+	}
+	return nil
+}
@@ -0,0 +1,250 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package writer
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"cuelang.org/go/cue"
+	"cuelang.org/go/cue/cuecontext"
+	"github.com/stretchr/testify/require"
+
+	configcontext "github.com/oam-dev/kubevela/pkg/config/context"
+	"github.com/oam-dev/kubevela/pkg/cue/script"
+)
+
+// fakeKVServer is an in-process fake of the HTTP API of Vault, etcd and Consul, it stores the written values in memory
+type fakeKVServer struct {
+	*httptest.Server
+	mu    sync.Mutex
+	token string
+	data  map[string]string
+}
+
+func newFakeKVServer(t *testing.T, token string) *fakeKVServer {
+	s := &fakeKVServer{token: token, data: map[string]string{}}
+	mux := http.NewServeMux()
+	// vault
+	mux.HandleFunc("/v1/auth/approle/login", func(w http.ResponseWriter, r *http.Request) {
+		var login map[string]string
+		_ = json.NewDecoder(r.Body).Decode(&login)
+		if login["role_id"] != "role" || login["secret_id"] != "secret" {
+			http.Error(w, "invalid role or secret", http.StatusBadRequest)
+			return
+		}
+		_, _ = w.Write([]byte(`{"auth":{"client_token":"` + s.token + `"}}`))
+	})
+	mux.HandleFunc("/v1/secret/data/", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Vault-Token") != s.token {
+			http.Error(w, "permission denied", http.StatusForbidden)
+			return
+		}
+		var body map[string]json.RawMessage
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		s.put(strings.TrimPrefix(r.URL.Path, "/v1/secret/data/"), string(body["data"]))
+		_, _ = w.Write([]byte(`{"data":{"version":1}}`))
+	})
+	// etcd
+	mux.HandleFunc("/v3/auth/authenticate", func(w http.ResponseWriter, r *http.Request) {
+		var auth map[string]string
+		_ = json.NewDecoder(r.Body).Decode(&auth)
+		if auth["name"] != "root" || auth["password"] != "pass" {
+			http.Error(w, "authentication failed", http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(`{"token":"` + s.token + `"}`))
+	})
+	mux.HandleFunc("/v3/kv/put", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != s.token {
+			http.Error(w, "user name is empty", http.StatusUnauthorized)
+			return
+		}
+		var req map[string]string
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		key, _ := base64.StdEncoding.DecodeString(req["key"])
+		value, _ := base64.StdEncoding.DecodeString(req["value"])
+		s.put(string(key), string(value))
+		_, _ = w.Write([]byte(`{"header":{}}`))
+	})
+	// consul
+	mux.HandleFunc("/v1/kv/", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.Header.Get("X-Consul-Token") != s.token {
+			http.Error(w, "ACL not found", http.StatusForbidden)
+			return
+		}
+		value, _ := io.ReadAll(r.Body)
+		s.put(strings.TrimPrefix(r.URL.Path, "/v1/kv/")+"?"+r.URL.RawQuery, string(value))
+		_, _ = w.Write([]byte(`true`))
+	})
+	s.Server = httptest.NewServer(mux)
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *fakeKVServer) put(key, value string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.data[key] = value
+}
+
+func (s *fakeKVServer) get(key string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.data[key]
+}
+
+func TestKVWriters(t *testing.T) {
+	r := require.New(t)
+	server := newFakeKVServer(t, "s.token")
+	endpoints := map[string]map[string]interface{}{
+		"vault":        {"address": server.URL, "token": "s.token"},
+		"vault-secret": {"address": server.URL, "authSecretRef": map[string]interface{}{"name": "vault-auth"}},
+		"etcd":         {"endpoints": []interface{}{server.URL}, "authSecretRef": map[string]interface{}{"name": "etcd-auth", "namespace": "vela-system"}},
+		"consul":       {"address": server.URL + "/", "datacenter": "dc1", "authSecretRef": map[string]interface{}{"name": "consul-auth"}},
+	}
+	secrets := map[string]map[string][]byte{
+		"vela-system/vault-auth":  {"roleId": []byte("role"), "secretId": []byte("secret")},
+		"vela-system/etcd-auth":   {"username": []byte("root"), "password": []byte("pass")},
+		"vela-system/consul-auth": {"token": []byte("s.token")},
+	}
+	configReader := func(ctx context.Context, namespace, name string) (map[string]interface{}, error) {
+		if endpoint, ok := endpoints[name]; ok {
+			return endpoint, nil
+		}
+		return nil, errors.New("config not found")
+	}
+	secretReader := func(ctx context.Context, namespace, name string) (map[string][]byte, error) {
+		if secret, ok := secrets[namespace+"/"+name]; ok {
+			return secret, nil
+		}
+		return nil, errors.New("secret not found")
+	}
+	newData := func(endpoint, key string, content string) KVData {
+		return KVData{
+			KVConfig: KVConfig{Endpoint: ConfigRef{Name: endpoint, Namespace: "vela-system"}, Metadata: KVConfigMetadata{Key: key}},
+			Content:  []byte(content),
+		}
+	}
+
+	ewd := &ExpandedWriterData{
+		Vault:  &VaultData{KVData: newData("vault", "app/db", `{"host":"127.0.0.1"}`)},
+		Etcd:   &EtcdData{KVData: newData("etcd", "/app/db", "host: 127.0.0.1\n")},
+		Consul: &ConsulData{KVData: newData("consul", "app/db", "host = 127.0.0.1\n")},
+	}
+	statuses := Sync(context.Background(), ewd, configReader, secretReader)
+	r.Equal([]WriterStatus{
+		{Writer: VaultWriterName, Synced: true},
+		{Writer: EtcdWriterName, Synced: true},
+		{Writer: ConsulWriterName, Synced: true},
+	}, statuses)
+	r.JSONEq(`{"host":"127.0.0.1"}`, server.get("app/db"))
+	r.Equal("host: 127.0.0.1\n", server.get("/app/db"))
+	r.Equal("host = 127.0.0.1\n", server.get("app/db?dc=dc1"))
+
+	// the non-JSON content is written to the content key of the vault secret, with the token from approle login
+	vault := &VaultData{KVData: newData("vault-secret", "app/cert", "cert: xxx\n")}
+	r.NoError(vault.Write(context.Background(), configReader, secretReader))
+	r.JSONEq(`{"content":"cert: xxx\n"}`, server.get("app/cert"))
+
+	// the failures are reported per writer
+	endpoints["vault"]["token"] = "invalid"
+	ewd = &ExpandedWriterData{
+		Vault: &VaultData{KVData: newData("vault", "app/db", `{}`)},
+		Etcd:  &EtcdData{KVData: newData("not-exist", "app/db", "")},
+	}
+	statuses = Sync(context.Background(), ewd, configReader, nil)
+	r.Len(statuses, 2)
+	r.False(statuses[0].Synced)
+	r.Contains(statuses[0].Message, "fail to write the config to the vault server:the server responds 403: permission denied")
+	r.False(statuses[1].Synced)
+	r.Contains(statuses[1].Message, "fail to read the config of the etcd server:config not found")
+	r.Len(Write(context.Background(), ewd, configReader), 2)
+
+	consul := &ConsulData{KVData: newData("consul", "app/db", "")}
+	err := consul.Write(context.Background(), configReader, nil)
+	r.Error(err)
+	r.Contains(err.Error(), "can not read the auth secret vela-system/consul-auth")
+
+	// the auth secret in another namespace is rejected
+	endpoints["consul"]["authSecretRef"] = map[string]interface{}{"name": "consul-auth", "namespace": "default"}
+	secrets["default/consul-auth"] = secrets["vela-system/consul-auth"]
+	err = consul.Write(context.Background(), configReader, secretReader)
+	r.Error(err)
+	r.Contains(err.Error(), "the auth secret default/consul-auth must be in the namespace of the config vela-system/consul")
+}
+
+func TestRenderKVWriters(t *testing.T) {
+	r := require.New(t)
+	template := `
+	template: {
+		vault: {
+			endpoint: name: "vault-server"
+			format: "json"
+			metadata: {
+				key:   parameter.key
+				mount: "kv"
+			}
+			content: parameter.content
+		}
+		etcd: {
+			endpoint: {
+				name:      "etcd-server"
+				namespace: "default"
+			}
+			format:  parameter.format
+			metadata: key: "/config/" + parameter.key
+			content: parameter.content
+		}
+		parameter: {
+			key:    string
+			format: *"yaml" | "properties"
+			content: {...}
+		}
+	}
+	`
+	v := cuecontext.New().CompileString(template)
+	r.NoError(v.Err())
+	ewc := ParseExpandedWriterConfig(v.LookupPath(cue.ParsePath("template")))
+	r.Nil(ewc.Nacos)
+	r.Nil(ewc.Consul)
+	r.Equal("vault-server", ewc.Vault.Endpoint.Name)
+	r.Equal("json", ewc.Vault.Format)
+	r.Equal("default", ewc.Etcd.Endpoint.Namespace)
+
+	ewd, err := RenderForExpandedWriter(ewc, script.CUE(template), configcontext.ConfigRenderContext{Name: "db", Namespace: "vela-system"}, map[string]interface{}{
+		"key":     "db",
+		"format":  "properties",
+		"content": map[string]interface{}{"host": "127.0.0.1"},
+	})
+	r.NoError(err)
+	r.Equal(KVConfigMetadata{Key: "db", Mount: "kv"}, ewd.Vault.Metadata)
+	r.Equal("vela-system", ewd.Vault.Endpoint.Namespace)
+	r.Equal(`{"host":"127.0.0.1"}`, string(ewd.Vault.Content))
+	r.Equal("/config/db", ewd.Etcd.Metadata.Key)
+	r.Equal("properties", ewd.Etcd.Format)
+	r.Equal("host = 127.0.0.1\n", string(ewd.Etcd.Content))
+	r.Len(ewd.Writers(), 2)
+}
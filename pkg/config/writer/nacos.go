@@ -36,6 +36,9 @@ import (
 	"github.com/oam-dev/kubevela/pkg/cue/script"
 )
 
+// NacosWriterName the name of the expanded writer to nacos
+const NacosWriterName = "nacos"
+
 // NacosConfig defines the nacos output
 type NacosConfig struct {
 	Endpoint ConfigRef `json:"endpoint"`
@@ -115,6 +118,16 @@ func renderNacos(config *NacosConfig, template script.CUE, context icontext.Conf
 	return &nacosData, nil
 }
 
+// Name returns the writer name
+func (n *NacosData) Name() string {
+	return NacosWriterName
+}
+
+// Write writes the config to the nacos server
+func (n *NacosData) Write(ctx context.Context, configReader icontext.ReadConfigProvider, _ icontext.ReadSecretProvider) error {
+	return n.write(ctx, configReader)
+}
+
 func (n *NacosData) write(ctx context.Context, configReader icontext.ReadConfigProvider) (err error) {
 	defer func() {
 		if rec := recover(); rec != nil {
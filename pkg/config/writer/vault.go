@@ -0,0 +1,98 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package writer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	icontext "github.com/oam-dev/kubevela/pkg/config/context"
+)
+
+// VaultWriterName the name of the expanded writer to HashiCorp Vault
+const VaultWriterName = "vault"
+
+// defaultVaultMount the default mount path of the KV v2 secrets engine
+const defaultVaultMount = "secret"
+
+// VaultData the rendered data written to the KV v2 secrets engine of HashiCorp Vault
+type VaultData struct {
+	KVData
+}
+
+// Name returns the writer name
+func (v *VaultData) Name() string {
+	return VaultWriterName
+}
+
+// Write writes the config to HashiCorp Vault
+func (v *VaultData) Write(ctx context.Context, configReader icontext.ReadConfigProvider, secretReader icontext.ReadSecretProvider) error {
+	return v.write(ctx, VaultWriterName, configReader, secretReader, newVaultClient)
+}
+
+// vaultClient writes the secrets through the HTTP API of the KV v2 secrets engine
+type vaultClient struct {
+	*httpKVClient
+	mount string
+}
+
+// newVaultClient creates the Vault client. The token is used if provided, otherwise it logins with the roleId and
+// secretId through the AppRole auth method.
+func newVaultClient(ctx context.Context, endpoint *kvEndpoint, data *KVData) (KVClient, error) {
+	c, err := newHTTPKVClient(endpoint.Address)
+	if err != nil {
+		return nil, err
+	}
+	if ns := endpoint.Credentials["namespace"]; ns != "" {
+		c.headers["X-Vault-Namespace"] = ns
+	}
+	token := endpoint.Credentials["token"]
+	if token == "" && endpoint.Credentials["roleId"] != "" {
+		var resp struct {
+			Auth struct {
+				ClientToken string `json:"client_token"`
+			} `json:"auth"`
+		}
+		login := map[string]string{"role_id": endpoint.Credentials["roleId"], "secret_id": endpoint.Credentials["secretId"]}
+		if err := c.do(ctx, http.MethodPost, "/v1/auth/approle/login", login, &resp); err != nil {
+			return nil, fmt.Errorf("fail to login with the approle:%w", err)
+		}
+		token = resp.Auth.ClientToken
+	}
+	if token == "" {
+		return nil, fmt.Errorf("either the token or the roleId is required")
+	}
+	c.headers["X-Vault-Token"] = token
+	mount := strings.Trim(data.Metadata.Mount, "/")
+	if mount == "" {
+		mount = defaultVaultMount
+	}
+	return &vaultClient{httpKVClient: c, mount: mount}, nil
+}
+
+// Put writes the value as a new version of the secret. The JSON object is written as the key-value pairs of the
+// secret, the other formats are written to the content key.
+func (c *vaultClient) Put(ctx context.Context, key string, value []byte) error {
+	var data map[string]interface{}
+	if err := json.Unmarshal(value, &data); err != nil {
+		data = map[string]interface{}{"content": string(value)}
+	}
+	return c.do(ctx, http.MethodPost, fmt.Sprintf("/v1/%s/data/%s", c.mount, strings.TrimPrefix(key, "/")), map[string]interface{}{"data": data}, nil)
+}
@@ -19,6 +19,7 @@ package writer
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"reflect"
 	"strings"
@@ -37,12 +38,51 @@ import (
 
 // ExpandedWriterConfig define the supported output ways.
 type ExpandedWriterConfig struct {
-	Nacos *NacosConfig `json:"nacos"`
+	Nacos  *NacosConfig `json:"nacos"`
+	Vault  *KVConfig    `json:"vault,omitempty"`
+	Etcd   *KVConfig    `json:"etcd,omitempty"`
+	Consul *KVConfig    `json:"consul,omitempty"`
 }
 
 // ExpandedWriterData the data for the expanded writer
 type ExpandedWriterData struct {
-	Nacos *NacosData `json:"nacos"`
+	Nacos  *NacosData  `json:"nacos"`
+	Vault  *VaultData  `json:"vault,omitempty"`
+	Etcd   *EtcdData   `json:"etcd,omitempty"`
+	Consul *ConsulData `json:"consul,omitempty"`
+}
+
+// ExpandedWriter writes the rendered config to an external storage
+type ExpandedWriter interface {
+	// Name returns the name of the writer, such as nacos and vault
+	Name() string
+	// Write writes the config, the server config and credentials are read by the providers
+	Write(ctx context.Context, configReader icontext.ReadConfigProvider, secretReader icontext.ReadSecretProvider) error
+}
+
+// WriterStatus the sync status of an expanded writer
+type WriterStatus struct {
+	Writer  string `json:"writer"`
+	Synced  bool   `json:"synced"`
+	Message string `json:"message,omitempty"`
+}
+
+// Writers returns the rendered expanded writers
+func (e *ExpandedWriterData) Writers() []ExpandedWriter {
+	var writers []ExpandedWriter
+	if e.Nacos != nil {
+		writers = append(writers, e.Nacos)
+	}
+	if e.Vault != nil {
+		writers = append(writers, e.Vault)
+	}
+	if e.Etcd != nil {
+		writers = append(writers, e.Etcd)
+	}
+	if e.Consul != nil {
+		writers = append(writers, e.Consul)
+	}
+	return writers
 }
 
 // ConfigRef reference a config secret, it must be system scope.
@@ -62,7 +102,9 @@ func ParseExpandedWriterConfig(template cue.Value) ExpandedWriterConfig {
 		}
 		ewc.Nacos = nacosConfig
 	}
-	// parse the other writer configs
+	for name, config := range map[string]**KVConfig{VaultWriterName: &ewc.Vault, EtcdWriterName: &ewc.Etcd, ConsulWriterName: &ewc.Consul} {
+		*config = parseKVConfig(template, name)
+	}
 	return ewc
 }
 
@@ -77,21 +119,56 @@ func RenderForExpandedWriter(ewc ExpandedWriterConfig, template script.CUE, cont
 		}
 		klog.Info("the config render to nacos context successfully")
 	}
+	if ewc.Vault != nil {
+		data, err := renderKV(VaultWriterName, ewc.Vault, template, context, properties)
+		if err != nil {
+			return nil, err
+		}
+		ewd.Vault = &VaultData{KVData: *data}
+	}
+	if ewc.Etcd != nil {
+		data, err := renderKV(EtcdWriterName, ewc.Etcd, template, context, properties)
+		if err != nil {
+			return nil, err
+		}
+		ewd.Etcd = &EtcdData{KVData: *data}
+	}
+	if ewc.Consul != nil {
+		data, err := renderKV(ConsulWriterName, ewc.Consul, template, context, properties)
+		if err != nil {
+			return nil, err
+		}
+		ewd.Consul = &ConsulData{KVData: *data}
+	}
 	return &ewd, nil
 }
 
 // Write write the config by the all writers
 func Write(ctx context.Context, ewd *ExpandedWriterData, ri icontext.ReadConfigProvider) (list []error) {
-	if ewd.Nacos != nil {
-		if err := ewd.Nacos.write(ctx, ri); err != nil {
-			list = append(list, err)
-		} else {
-			klog.Info("the config write to the nacos successfully")
+	for _, status := range Sync(ctx, ewd, ri, nil) {
+		if !status.Synced {
+			list = append(list, errors.New(status.Message))
 		}
 	}
 	return
 }
 
+// Sync writes the config by all the writers, and returns the sync status of every writer
+func Sync(ctx context.Context, ewd *ExpandedWriterData, ri icontext.ReadConfigProvider, rs icontext.ReadSecretProvider) []WriterStatus {
+	var statuses []WriterStatus
+	for _, w := range ewd.Writers() {
+		status := WriterStatus{Writer: w.Name(), Synced: true}
+		if err := w.Write(ctx, ri, rs); err != nil {
+			status.Synced = false
+			status.Message = err.Error()
+		} else {
+			klog.Infof("the config write to the %s successfully", w.Name())
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses
+}
+
 // encodingOutput support the json、toml、xml、properties and yaml formats.
 func encodingOutput(input cue.Value, format string) ([]byte, error) {
 	var data = make(map[string]interface{})
@@ -34,6 +34,7 @@ import (
 	"k8s.io/klog/v2"
 	"k8s.io/utils/strings/slices"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	ctrlEvent "sigs.k8s.io/controller-runtime/pkg/event"
@@ -46,6 +47,7 @@ import (
 	wfContext "github.com/kubevela/workflow/pkg/context"
 	"github.com/kubevela/workflow/pkg/executor"
 	wffeatures "github.com/kubevela/workflow/pkg/features"
+	clustercommon "github.com/oam-dev/cluster-gateway/pkg/common"
 
 	ctrlrec "github.com/kubevela/pkg/controller/reconciler"
 
@@ -59,8 +61,10 @@ import (
 	core "github.com/oam-dev/kubevela/pkg/controller/core.oam.dev"
 	"github.com/oam-dev/kubevela/pkg/features"
 	"github.com/oam-dev/kubevela/pkg/monitor/metrics"
+	"github.com/oam-dev/kubevela/pkg/multicluster"
 	"github.com/oam-dev/kubevela/pkg/oam"
 	oamutil "github.com/oam-dev/kubevela/pkg/oam/util"
+	"github.com/oam-dev/kubevela/pkg/policy"
 	"github.com/oam-dev/kubevela/pkg/resourcekeeper"
 	"github.com/oam-dev/kubevela/pkg/resourcetracker"
 	"github.com/oam-dev/kubevela/pkg/workflow"
@@ -68,6 +72,9 @@ import (
 	"github.com/oam-dev/kubevela/version"
 )
 
+// clusterGroupIndex is the index of applications by the cluster groups selected by their topology policies
+const clusterGroupIndex = "spec.policies.clusterGroup"
+
 const (
 	errUpdateApplicationFinalizer = "cannot update application finalizer"
 )
@@ -190,6 +197,27 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	app.Status.SetConditions(condition.ReadyCondition(common.PolicyCondition.String()))
 	r.Recorder.Event(app, event.Normal(velatypes.ReasonPolicyGenerated, velatypes.MessagePolicyGenerated))
 
+	if err := handler.CheckClusterEviction(logCtx, app); err != nil {
+		logCtx.Error(err, "[handle cluster eviction]")
+		r.Recorder.Event(app, event.Warning(velatypes.ReasonFailedWorkflow, err))
+		return r.endWithNegativeCondition(logCtx, app, condition.ErrorCondition(common.WorkflowCondition.String(), err), common.ApplicationRunningWorkflow)
+	}
+	failoverTargets := policy.GetFailoverTargets(app)
+	if err := handler.CheckClusterFailover(logCtx, app); err != nil {
+		logCtx.Error(err, "[handle cluster failover]")
+		r.Recorder.Event(app, event.Warning(velatypes.ReasonFailedWorkflow, err))
+		return r.endWithNegativeCondition(logCtx, app, condition.ErrorCondition(common.WorkflowCondition.String(), err), common.ApplicationRunningWorkflow)
+	}
+	if err := handler.CheckClusterGroupChange(logCtx, app); err != nil {
+		logCtx.Error(err, "[handle cluster group change]")
+		r.Recorder.Event(app, event.Warning(velatypes.ReasonFailedWorkflow, err))
+		return r.endWithNegativeCondition(logCtx, app, condition.ErrorCondition(common.WorkflowCondition.String(), err), common.ApplicationRunningWorkflow)
+	}
+	if err := handler.CheckPlacementRevisions(logCtx, app); err != nil {
+		logCtx.Error(err, "[handle placement revisions]")
+		r.Recorder.Event(app, event.Warning(velatypes.ReasonFailedWorkflow, err))
+		return r.endWithNegativeCondition(logCtx, app, condition.ErrorCondition(common.WorkflowCondition.String(), err), common.ApplicationRunningWorkflow)
+	}
 	handler.CheckWorkflowRestart(logCtx, app)
 
 	workflowInstance, runners, err := handler.GenerateApplicationSteps(logCtx, app, appParser, appFile)
@@ -218,10 +246,12 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	handler.addAppliedResource(true, app.Status.AppliedResources...)
 	app.Status.AppliedResources = handler.appliedResources
 	app.Status.Services = handler.services
+	app.Status.HealthStatus = common.AggregateServicesHealthStatus(handler.services)
 	workflowUpdated := app.Status.Workflow.Message != "" && workflowInstance.Status.Message == ""
 	workflowInstance.Status.Phase = workflowState
 	app.Status.Workflow = workflow.ConvertWorkflowStatus(workflowInstance.Status, app.Status.Workflow.AppRevision)
 	logCtx.Info(fmt.Sprintf("Workflow return state=%s", workflowState))
+	r.recordFailoverEvents(app, failoverTargets)
 	switch workflowState {
 	case workflowv1alpha1.WorkflowStateSuspending:
 		if duration := workflowExecutor.GetSuspendBackoffWaitTime(); duration > 0 {
@@ -314,8 +344,10 @@ func (r *Reconciler) gcResourceTrackers(logCtx monitorContext.Context, handler *
 		statusUpdater = r.updateStatus
 	}
 
+	var nextDeleteTime time.Time
 	options := []resourcekeeper.GCOption{
 		resourcekeeper.AppRevisionLimitGCOption(r.appRevisionLimit),
+		resourcekeeper.NextDeleteTimeGCOption{Time: &nextDeleteTime},
 	}
 	if DisableAllApplicationRevision {
 		options = append(options, resourcekeeper.DisableApplicationRevisionGCOption{})
@@ -350,6 +382,10 @@ func (r *Reconciler) gcResourceTrackers(logCtx monitorContext.Context, handler *
 		return r.result(statusUpdater(logCtx, handler.app, phase)).requeue(baseGCBackoffWaitTime).ret()
 	}
 	logCtx.Info("GarbageCollected resourcetrackers")
+	if !nextDeleteTime.IsZero() {
+		// requeue to recycle the resources kept by the ttl of garbage-collect policy when they are due
+		return r.result(statusUpdater(logCtx, handler.app, phase)).requeue(time.Until(nextDeleteTime) + time.Second).ret()
+	}
 	return r.result(statusUpdater(logCtx, handler.app, phase)).ret()
 }
 
@@ -501,6 +537,17 @@ func (r *Reconciler) doWorkflowFinish(logCtx monitorContext.Context, app *v1beta
 	logCtx.Info("Application manifests has applied by workflow successfully")
 }
 
+// recordFailoverEvents records the moves of the components by the failover of topology policies
+func (r *Reconciler) recordFailoverEvents(app *v1beta1.Application, before map[string]map[string]string) {
+	for _, change := range policy.DiffFailoverTargets(before, policy.GetFailoverTargets(app)) {
+		message := fmt.Sprintf("components of cluster %s in topology %s are moved from cluster %s to cluster %s", change.Cluster, change.Policy, change.From, change.To)
+		if change.To == change.Cluster {
+			message = fmt.Sprintf("components of cluster %s in topology %s are moved back from cluster %s", change.Cluster, change.Policy, change.From)
+		}
+		r.Recorder.Event(app, event.Normal(velatypes.ReasonClusterFailover, message))
+	}
+}
+
 func hasHealthCheckPolicy(policies []*appfile.Component) bool {
 	for _, p := range policies {
 		if p.FullTemplate != nil && p.FullTemplate.PolicyDefinition != nil &&
@@ -527,10 +574,26 @@ func isHealthy(services []common.ApplicationComponentStatus) bool {
 
 // SetupWithManager install to manager
 func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if err := mgr.GetFieldIndexer().IndexField(context.Background(), &v1beta1.Application{}, clusterGroupIndex, func(obj client.Object) []string {
+		return policy.GetTopologyClusterGroups(obj.(*v1beta1.Application).Spec.Policies)
+	}); err != nil {
+		return err
+	}
 	return ctrl.NewControllerManagedBy(mgr).
 		Watches(
 			&v1beta1.ResourceTracker{},
 			ctrlHandler.EnqueueRequestsFromMapFunc(findObjectForResourceTracker)).
+		// only the metadata of configmaps is watched, so that the configmaps in the cluster are not cached even if
+		// the workflow context configmaps are not cached
+		WatchesMetadata(
+			&corev1.ConfigMap{},
+			ctrlHandler.EnqueueRequestsFromMapFunc(r.findApplicationsForClusterGroup),
+			builder.WithPredicates(predicate.NewPredicateFuncs(isClusterGroup))).
+		// the same for secrets, only the labels of the cluster secrets are needed
+		WatchesMetadata(
+			&corev1.Secret{},
+			ctrlHandler.EnqueueRequestsFromMapFunc(r.findApplicationsForClusterSecret),
+			builder.WithPredicates(clusterSecretPredicate)).
 		WithOptions(controller.Options{
 			MaxConcurrentReconciles: r.concurrentReconciles,
 		}).
@@ -642,6 +705,73 @@ func findObjectForResourceTracker(_ context.Context, rt client.Object) []reconci
 	return nil
 }
 
+// isClusterGroup checks if the object is the configmap of cluster group
+func isClusterGroup(obj client.Object) bool {
+	_, ok := obj.GetLabels()[velatypes.LabelClusterGroup]
+	return ok && obj.GetNamespace() == multicluster.ClusterGatewaySecretNamespace
+}
+
+// findApplicationsForClusterGroup finds the applications with topology policies selecting the cluster group
+func (r *Reconciler) findApplicationsForClusterGroup(ctx context.Context, cm client.Object) []reconcile.Request {
+	return r.findApplicationsByClusterGroups(ctx, cm.GetLabels()[velatypes.LabelClusterGroup])
+}
+
+// isClusterSecret checks if the object is the secret of cluster
+func isClusterSecret(obj client.Object) bool {
+	_, ok := obj.GetLabels()[clustercommon.LabelKeyClusterCredentialType]
+	return ok && obj.GetNamespace() == multicluster.ClusterGatewaySecretNamespace
+}
+
+// clusterSecretPredicate filters the events of cluster secrets that may change the members of cluster groups
+// selecting clusters by labels, the updates not changing the labels are skipped
+var clusterSecretPredicate = predicate.Funcs{
+	CreateFunc: func(e ctrlEvent.CreateEvent) bool { return isClusterSecret(e.Object) },
+	DeleteFunc: func(e ctrlEvent.DeleteEvent) bool { return isClusterSecret(e.Object) },
+	UpdateFunc: func(e ctrlEvent.UpdateEvent) bool {
+		return isClusterSecret(e.ObjectNew) && !reflect.DeepEqual(e.ObjectOld.GetLabels(), e.ObjectNew.GetLabels())
+	},
+	GenericFunc: func(ctrlEvent.GenericEvent) bool { return false },
+}
+
+// findApplicationsForClusterSecret finds the applications with topology policies selecting the cluster groups
+// that select clusters by labels
+func (r *Reconciler) findApplicationsForClusterSecret(ctx context.Context, secret client.Object) []reconcile.Request {
+	groups, err := multicluster.ListClusterGroups(ctx, r.Client)
+	if err != nil {
+		klog.ErrorS(err, "Failed to list cluster groups for cluster", "cluster", secret.GetName())
+		return nil
+	}
+	var names []string
+	for _, group := range groups {
+		if len(group.ClusterLabelSelector) > 0 {
+			names = append(names, group.Name)
+		}
+	}
+	return r.findApplicationsByClusterGroups(ctx, names...)
+}
+
+// findApplicationsByClusterGroups finds the applications with topology policies selecting any of the cluster groups
+// through the index of the cluster groups
+func (r *Reconciler) findApplicationsByClusterGroups(ctx context.Context, groups ...string) []reconcile.Request {
+	var requests []reconcile.Request
+	found := map[reconcile.Request]bool{}
+	for _, group := range groups {
+		apps := &v1beta1.ApplicationList{}
+		if err := r.Client.List(ctx, apps, client.MatchingFields{clusterGroupIndex: group}); err != nil {
+			klog.ErrorS(err, "Failed to list applications for cluster group", "group", group)
+			continue
+		}
+		for _, app := range apps.Items {
+			request := reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&app)}
+			if !found[request] {
+				found[request] = true
+				requests = append(requests, request)
+			}
+		}
+	}
+	return requests
+}
+
 func timeReconcile(app *v1beta1.Application) func() {
 	t := time.Now()
 	beginPhase := string(app.Status.Phase)
@@ -707,6 +837,7 @@ func evalStatus(ctx monitorContext.Context, handler *AppHandler, appFile *appfil
 
 		applyComponentHealthToServices(ctx, handler, componentMap, healthCheck)
 		handler.app.Status.Services = handler.services
+		handler.app.Status.HealthStatus = common.AggregateServicesHealthStatus(handler.services)
 		return isHealthy(handler.services)
 	}
 	return true
@@ -723,6 +854,7 @@ func applyComponentHealthToServices(ctx monitorContext.Context, handler *AppHand
 				ctx.Error(err, "Failed to collect health status")
 			} else if status != nil {
 				handler.services[idx].Healthy = status.Healthy
+				handler.services[idx].HealthStatus = status.HealthStatus
 				handler.services[idx].Message = status.Message
 				handler.services[idx].Details = status.Details
 				handler.services[idx].Traits = status.Traits
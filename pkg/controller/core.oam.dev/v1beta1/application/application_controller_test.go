@@ -34,8 +34,10 @@ import (
 	"github.com/google/go-cmp/cmp"
 	testdef "github.com/kubevela/pkg/util/test/definition"
 	wffeatures "github.com/kubevela/workflow/pkg/features"
+	clustercommon "github.com/oam-dev/cluster-gateway/pkg/common"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/require"
 	v1 "k8s.io/api/apps/v1"
 	autoscalingv1 "k8s.io/api/autoscaling/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -46,6 +48,8 @@ import (
 	utilfeature "k8s.io/apiserver/pkg/util/feature"
 	featuregatetesting "k8s.io/component-base/featuregate/testing"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	ctrlEvent "sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 	"sigs.k8s.io/yaml"
 
@@ -56,9 +60,11 @@ import (
 	"github.com/oam-dev/kubevela/apis/core.oam.dev/common"
 	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1beta1"
 	velatypes "github.com/oam-dev/kubevela/apis/types"
+	"github.com/oam-dev/kubevela/pkg/multicluster"
 	"github.com/oam-dev/kubevela/pkg/oam"
 	"github.com/oam-dev/kubevela/pkg/oam/testutil"
 	"github.com/oam-dev/kubevela/pkg/oam/util"
+	"github.com/oam-dev/kubevela/pkg/policy"
 	common2 "github.com/oam-dev/kubevela/pkg/utils/common"
 	"github.com/oam-dev/kubevela/version"
 )
@@ -4836,3 +4842,48 @@ func Test_setVelaVersion(t *testing.T) {
 		})
 	}
 }
+
+func Test_findApplicationsByClusterGroups(t *testing.T) {
+	r := require.New(t)
+	ctx := context.Background()
+	multicluster.ClusterGatewaySecretNamespace = velatypes.DefaultKubeVelaNS
+	newApp := func(name string, properties string) *v1beta1.Application {
+		return &v1beta1.Application{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Spec: v1beta1.ApplicationSpec{Policies: []v1beta1.AppPolicy{{
+				Name:       "topology",
+				Type:       "topology",
+				Properties: &runtime.RawExtension{Raw: []byte(properties)},
+			}}},
+		}
+	}
+	cli := fake.NewClientBuilder().WithScheme(common2.Scheme).
+		WithIndex(&v1beta1.Application{}, clusterGroupIndex, func(obj client.Object) []string {
+			return policy.GetTopologyClusterGroups(obj.(*v1beta1.Application).Spec.Policies)
+		}).
+		WithObjects(
+			newApp("app-prod", `{"clusterGroup":"prod"}`),
+			newApp("app-dev", `{"clusterGroup":"dev"}`),
+			newApp("app-clusters", `{"clusters":["cluster-a"]}`),
+		).Build()
+	r.NoError(multicluster.CreateClusterGroup(ctx, cli, &multicluster.ClusterGroup{Name: "prod", ClusterLabelSelector: map[string]string{"env": "prod"}}))
+	r.NoError(multicluster.CreateClusterGroup(ctx, cli, &multicluster.ClusterGroup{Name: "dev", Clusters: []string{"cluster-a"}}))
+	reconciler := &Reconciler{Client: cli}
+
+	request := func(name string) reconcile.Request {
+		return reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "default", Name: name}}
+	}
+	cm := &corev1.ConfigMap{}
+	cm.SetLabels(map[string]string{velatypes.LabelClusterGroup: "dev"})
+	r.Equal([]reconcile.Request{request("app-dev")}, reconciler.findApplicationsForClusterGroup(ctx, cm))
+	// only the groups selecting clusters by labels are affected by the cluster secrets
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "cluster-b", Namespace: velatypes.DefaultKubeVelaNS}}
+	r.Equal([]reconcile.Request{request("app-prod")}, reconciler.findApplicationsForClusterSecret(ctx, secret))
+	r.Empty(reconciler.findApplicationsByClusterGroups(ctx, "staging"))
+
+	updated := secret.DeepCopy()
+	updated.SetLabels(map[string]string{clustercommon.LabelKeyClusterCredentialType: "X509Certificate"})
+	r.False(clusterSecretPredicate.Update(ctrlEvent.UpdateEvent{ObjectOld: updated, ObjectNew: updated}))
+	r.True(clusterSecretPredicate.Update(ctrlEvent.UpdateEvent{ObjectOld: secret, ObjectNew: updated}))
+	r.False(clusterSecretPredicate.Create(ctrlEvent.CreateEvent{Object: secret}))
+}
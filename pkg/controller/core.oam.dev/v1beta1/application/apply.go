@@ -51,6 +51,8 @@ type AppHandler struct {
 	currentAppRev  *v1beta1.ApplicationRevision
 	latestAppRev   *v1beta1.ApplicationRevision
 	resourceKeeper resourcekeeper.ResourceKeeper
+	// pinnedAppRevs caches the revisions pinned to the clusters to render the components with
+	pinnedAppRevs map[string]*v1beta1.ApplicationRevision
 
 	isNewRevision  bool
 	currentRevHash string
@@ -230,8 +232,9 @@ func (h *AppHandler) collectTraitHealthStatus(comp *appfile.Component, tr *appfi
 		pCtx        = comp.Ctx
 		appName     = appRev.Spec.Application.Name
 		traitStatus = common.ApplicationTraitStatus{
-			Type:    tr.Name,
-			Healthy: true,
+			Type:         tr.Name,
+			Healthy:      true,
+			HealthStatus: common.HealthStatusHealthy,
 		}
 		traitOverrideNamespace = overrideNamespace
 		err                    error
@@ -251,6 +254,7 @@ func (h *AppHandler) collectTraitHealthStatus(comp *appfile.Component, tr *appfi
 	statusResult, err := tr.EvalStatus(templateContext)
 	if err == nil && statusResult != nil {
 		traitStatus.Healthy = statusResult.Healthy
+		traitStatus.HealthStatus = statusResult.HealthStatus
 		traitStatus.Message = statusResult.Message
 		traitStatus.Details = statusResult.Details
 	}
@@ -293,6 +297,7 @@ func (h *AppHandler) collectWorkloadHealthStatus(ctx context.Context, comp *appf
 		}
 		if statusResult != nil {
 			status.Healthy = statusResult.Healthy
+			status.HealthStatus = statusResult.HealthStatus
 			if statusResult.Message != "" {
 				status.Message = statusResult.Message
 			}
@@ -301,6 +306,7 @@ func (h *AppHandler) collectWorkloadHealthStatus(ctx context.Context, comp *appf
 			}
 		} else {
 			status.Healthy = false
+			status.HealthStatus = common.HealthStatusUnknown
 		}
 		output, outputs = extractOutputAndOutputs(templateContext)
 	}
@@ -324,6 +330,8 @@ func (h *AppHandler) collectHealthStatus(ctx context.Context, comp *appfile.Comp
 		}
 		isHealth = true
 		err      error
+		// workload is regarded as healthy if skipped, which is the same as isHealth
+		healthStatus = common.HealthStatusHealthy
 	)
 
 	status = h.getServiceStatus(status)
@@ -332,6 +340,7 @@ func (h *AppHandler) collectHealthStatus(ctx context.Context, comp *appfile.Comp
 		if err != nil {
 			return nil, nil, nil, false, err
 		}
+		healthStatus = status.HealthStatus
 	}
 
 	var traitStatusList []common.ApplicationTraitStatus
@@ -351,6 +360,7 @@ collectNext:
 		outputs = append(outputs, _outputs...)
 
 		isHealth = isHealth && traitStatus.Healthy
+		healthStatus = common.AggregateHealthStatus(healthStatus, traitStatus.HealthStatus)
 		if status.Message == "" && traitStatus.Message != "" {
 			status.Message = traitStatus.Message
 		}
@@ -365,6 +375,7 @@ collectNext:
 		status.Traits = oldStatus
 	}
 	status.Traits = append(status.Traits, traitStatusList...)
+	status.HealthStatus = healthStatus
 	h.addServiceStatus(true, status)
 	return &status, output, outputs, isHealth, nil
 }
@@ -384,11 +395,21 @@ func setStatus(status *common.ApplicationComponentStatus, observedGeneration, ge
 		return true
 	}
 	status.Message = message
-	if !isLatest() || state != terraformtypes.Available {
+	switch {
+	case !isLatest():
 		status.Healthy = false
-		return
+		status.HealthStatus = common.HealthStatusProgressing
+	case state == terraformtypes.Available:
+		status.Healthy = true
+		status.HealthStatus = common.HealthStatusHealthy
+	case state == terraformtypes.ConfigurationApplyFailed || state == terraformtypes.ConfigurationDestroyFailed ||
+		state == terraformtypes.ConfigurationStaticCheckFailed || state == terraformtypes.TerraformInitError:
+		status.Healthy = false
+		status.HealthStatus = common.HealthStatusDegraded
+	default:
+		status.Healthy = false
+		status.HealthStatus = common.HealthStatusProgressing
 	}
-	status.Healthy = true
 }
 
 // ApplyPolicies will render policies into manifests from appfile and dispatch them
@@ -155,6 +155,7 @@ func (h *AppHandler) generateDispatcher(appRev *v1beta1.ApplicationRevision, rea
 				}
 				if options.Stage < DefaultDispatch {
 					status.Healthy = false
+					status.HealthStatus = common.HealthStatusProgressing
 					if status.Message == "" {
 						status.Message = "waiting for previous stage healthy"
 					}
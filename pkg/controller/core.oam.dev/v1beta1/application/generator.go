@@ -41,6 +41,7 @@ import (
 
 	"github.com/oam-dev/kubevela/apis/core.oam.dev/common"
 	"github.com/oam-dev/kubevela/apis/core.oam.dev/condition"
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1alpha1"
 	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1beta1"
 	"github.com/oam-dev/kubevela/apis/types"
 	"github.com/oam-dev/kubevela/pkg/appfile"
@@ -54,6 +55,8 @@ import (
 	"github.com/oam-dev/kubevela/pkg/multicluster"
 	"github.com/oam-dev/kubevela/pkg/oam"
 	"github.com/oam-dev/kubevela/pkg/oam/util"
+	"github.com/oam-dev/kubevela/pkg/policy"
+	"github.com/oam-dev/kubevela/pkg/resourcetracker"
 	"github.com/oam-dev/kubevela/pkg/utils/apply"
 	"github.com/oam-dev/kubevela/pkg/workflow/providers"
 	oamprovidertypes "github.com/oam-dev/kubevela/pkg/workflow/providers/types"
@@ -63,6 +66,9 @@ import (
 var (
 	// DisableResourceApplyDoubleCheck optimize applyComponentFunc by disable post resource existing check after dispatch
 	DisableResourceApplyDoubleCheck = false
+
+	// isClusterConnected checks the connection of the cluster, it is replaced in tests
+	isClusterConnected = multicluster.IsClusterConnected
 )
 
 // GenerateApplicationSteps generate application steps.
@@ -88,7 +94,11 @@ func (h *AppHandler) GenerateApplicationSteps(ctx monitorContext.Context,
 		ComponentRender:      h.renderComponentFunc(appParser, af),
 		ComponentHealthCheck: h.checkComponentHealth(appParser, af),
 		WorkloadRender: func(ctx context.Context, comp common.ApplicationComponent) (*appfile.Component, error) {
-			return appParser.ParseComponentFromRevisionAndClient(ctx, comp, appRev)
+			rev, err := h.appRevisionFor(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return appParser.ParseComponentFromRevisionAndClient(ctx, comp, rev)
 		},
 		App:       app,
 		AppLabels: appLabels,
@@ -129,6 +139,317 @@ func (h *AppHandler) GenerateApplicationSteps(ctx monitorContext.Context,
 	return instance, runners, nil
 }
 
+// CheckClusterEviction moves the workloads off the clusters with NoExecute taints not tolerated by the topology
+// policies placing them. The resources in these clusters are deleted and the finished workflow restarts, so that the
+// topology policies place the components again without these clusters. Only the taints added after the workflow
+// started are handled, since the clusters have already been skipped by the workflow started after them.
+func (h *AppHandler) CheckClusterEviction(ctx monitorContext.Context, app *v1beta1.Application) error {
+	if app.Status.Workflow == nil || !app.Status.Workflow.Finished {
+		return nil
+	}
+	evicting := map[string]bool{}
+	var evicted []string
+	for _, res := range app.Status.AppliedResources {
+		if _, checked := evicting[res.Cluster]; checked || res.Cluster == "" || res.Cluster == multicluster.ClusterLocalName {
+			continue
+		}
+		evicting[res.Cluster] = false
+		vc, err := multicluster.GetVirtualCluster(ctx, h.Client, res.Cluster)
+		if err != nil {
+			ctx.Error(err, "failed to get cluster taints", "cluster", res.Cluster)
+			continue
+		}
+		taint, err := policy.FindEvictingTaint(app, vc)
+		if err != nil {
+			return err
+		}
+		if taint != nil && taint.TimeAdded != nil && taint.TimeAdded.After(app.Status.Workflow.StartTime.Time) {
+			evicting[res.Cluster] = true
+			evicted = append(evicted, res.Cluster)
+		}
+	}
+	if len(evicted) == 0 {
+		return nil
+	}
+	if err := h.deleteAppliedResources(ctx, app, evicting); err != nil {
+		return err
+	}
+	ctx.Info("Restart workflow to move workloads off the tainted clusters", "clusters", evicted)
+	app.Status.Workflow = nil
+	return nil
+}
+
+// CheckClusterFailover places the components of the topology policies with failover again for the finished
+// workflow. If the components need to move, the workflow restarts to deploy them in the new clusters. The clusters
+// the components move away from are recorded, and the resources left in them are deleted only after the restarted
+// workflow succeeds, so that the components are not removed from everywhere if the deployment fails.
+func (h *AppHandler) CheckClusterFailover(ctx monitorContext.Context, app *v1beta1.Application) error {
+	if app.Status.Workflow == nil || !app.Status.Workflow.Finished || !policy.HasTopologyFailover(app.Spec.Policies) {
+		return nil
+	}
+	before := policy.GetFailoverTargets(app)
+	placements, err := policy.GetPlacementsFromTopologyPolicies(ctx, h.Client, app.Namespace, app.Spec.Policies, true, app)
+	if err != nil {
+		ctx.Error(err, "failed to check the failover of topology policies")
+		return nil
+	}
+	placed := func(cluster string) bool {
+		return slices.Any(placements, func(pl v1alpha1.PlacementDecision) bool { return pl.Cluster == cluster })
+	}
+	released := policy.GetReleasedClusters(app)
+	changes := policy.DiffFailoverTargets(before, policy.GetFailoverTargets(app))
+	if len(changes) > 0 {
+		for _, change := range changes {
+			if before[change.Policy][change.Cluster] == change.From && !slices.Contains(released[change.Policy], change.From) {
+				released[change.Policy] = append(released[change.Policy], change.From)
+			}
+		}
+		for policyName, clusters := range released {
+			if err = policy.SetReleasedClusters(app, policyName, slices.Filter(clusters, func(cluster string) bool { return !placed(cluster) })); err != nil {
+				return err
+			}
+		}
+		ctx.Info("Restart workflow to move components of the failed over clusters", "changes", changes)
+		app.Status.Workflow = nil
+		return nil
+	}
+	if len(released) == 0 || app.Status.Workflow.Phase != workflowv1alpha1.WorkflowStateSucceeded {
+		return nil
+	}
+	return h.cleanupReleasedClusters(ctx, app, released, placed)
+}
+
+// cleanupReleasedClusters deletes the resources left in the released clusters which are not placed again. The
+// disconnected clusters are kept released, and the resources left in them are deleted once they are connected.
+func (h *AppHandler) cleanupReleasedClusters(ctx monitorContext.Context, app *v1beta1.Application, released map[string][]string, placed func(cluster string) bool) error {
+	clusters := map[string]bool{}
+	for _, names := range released {
+		for _, cluster := range names {
+			if !placed(cluster) && isClusterConnected(ctx, h.Client, cluster) {
+				clusters[cluster] = true
+			}
+		}
+	}
+	unreachable, err := h.deleteTrackedResources(ctx, app, clusters)
+	if err != nil {
+		return err
+	}
+	for policyName, names := range released {
+		pending := slices.Filter(names, func(cluster string) bool {
+			return !placed(cluster) && (!clusters[cluster] || unreachable[cluster])
+		})
+		if len(pending) > 0 {
+			ctx.Info("Delete the resources left in the released clusters after they are connected", "policy", policyName, "clusters", pending)
+		} else {
+			pending = nil
+		}
+		if err = policy.SetReleasedClusters(app, policyName, pending); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CheckClusterGroupChange places the components of the topology policies selecting cluster groups again for the
+// finished workflow, if the members of the groups are changed since the components are placed. The workflow restarts
+// to deploy the components in the new members, and the resources left in the removed members are deleted.
+func (h *AppHandler) CheckClusterGroupChange(ctx monitorContext.Context, app *v1beta1.Application) error {
+	if app.Status.Workflow == nil || !app.Status.Workflow.Finished || !policy.HasTopologyClusterGroup(app.Spec.Policies, "") {
+		return nil
+	}
+	changes, err := policy.GetClusterGroupChanges(ctx, h.Client, app)
+	if err != nil {
+		ctx.Error(err, "failed to check the changes of cluster groups")
+		return nil
+	}
+	if len(changes) == 0 {
+		return nil
+	}
+	placements, err := policy.GetPlacementsFromTopologyPolicies(ctx, h.Client, app.Namespace, app.Spec.Policies, true, app)
+	if err != nil {
+		return err
+	}
+	released := map[string]bool{}
+	for _, change := range changes {
+		for _, cluster := range change.Removed {
+			if !slices.Any(placements, func(pl v1alpha1.PlacementDecision) bool { return pl.Cluster == cluster }) {
+				released[cluster] = true
+			}
+		}
+	}
+	if err = h.deleteAppliedResources(ctx, app, released); err != nil {
+		return err
+	}
+	ctx.Info("Restart workflow to place components in the changed cluster groups", "changes", changes)
+	app.Status.Workflow = nil
+	return nil
+}
+
+// CheckPlacementRevisions restarts the finished workflow if the clusters are pinned to other revisions, or unpinned,
+// since the components were deployed, so that the deploy steps apply the components of the pinned revisions to these
+// clusters. The resources of the rest components are kept and updated in place by the deploy steps, and the resources
+// of the components not in the revision deployed, which is the pinned revision or the current one for the unpinned
+// clusters, are deleted from the clusters after the workflow succeeds.
+func (h *AppHandler) CheckPlacementRevisions(ctx monitorContext.Context, app *v1beta1.Application) error {
+	if app.Status.Workflow == nil || !app.Status.Workflow.Finished {
+		return nil
+	}
+	if len(app.Status.PlacementCleanups) > 0 && app.Status.Workflow.Phase == workflowv1alpha1.WorkflowStateSucceeded {
+		if err := h.cleanupPlacements(ctx, app); err != nil {
+			return err
+		}
+	}
+	if len(app.Status.PlacementRevisions) == 0 {
+		return nil
+	}
+	pins, err := oam.GetPlacementRevisions(app)
+	if err != nil {
+		return errors.Wrapf(err, "failed to parse the revisions pinned to the clusters")
+	}
+	var changed []string
+	for _, pr := range app.Status.PlacementRevisions {
+		desired := pins[pr.Cluster]
+		pinned := desired != "" && desired != h.currentAppRev.Name
+		if pr.Pinned == pinned && (!pinned || pr.Revision == desired) {
+			continue
+		}
+		changed = append(changed, pr.Cluster)
+		if !slices.Contains(app.Status.PlacementCleanups, pr.Cluster) {
+			app.Status.PlacementCleanups = append(app.Status.PlacementCleanups, pr.Cluster)
+		}
+	}
+	if len(changed) == 0 {
+		return nil
+	}
+	ctx.Info("Restart workflow to deploy the revisions pinned to the clusters", "clusters", changed)
+	app.Status.Workflow = nil
+	return nil
+}
+
+// cleanupPlacements deletes the resources of the components not in the revisions deployed to the clusters recorded
+// for cleanup. The disconnected clusters are kept, and cleaned up once they are connected.
+func (h *AppHandler) cleanupPlacements(ctx monitorContext.Context, app *v1beta1.Application) error {
+	revisions := map[string]string{}
+	pending := map[string]bool{}
+	for _, cluster := range app.Status.PlacementCleanups {
+		idx := slices.Index(app.Status.PlacementRevisions, func(pr common.PlacementRevision) bool { return pr.Cluster == cluster })
+		if idx < 0 {
+			continue
+		}
+		if !isClusterConnected(ctx, h.Client, cluster) {
+			pending[cluster] = true
+			continue
+		}
+		revisions[cluster] = app.Status.PlacementRevisions[idx].Revision
+	}
+	unreachable, err := h.deleteComponentsNotInRevisions(ctx, app, revisions)
+	if err != nil {
+		return err
+	}
+	app.Status.PlacementCleanups = slices.Filter(app.Status.PlacementCleanups, func(cluster string) bool {
+		return pending[cluster] || unreachable[cluster]
+	})
+	if len(app.Status.PlacementCleanups) > 0 {
+		ctx.Info("Delete the resources of the components not in the deployed revisions after the clusters are connected", "clusters", app.Status.PlacementCleanups)
+	} else {
+		app.Status.PlacementCleanups = nil
+	}
+	return nil
+}
+
+// deleteComponentsNotInRevisions deletes the resources of the components not in the revisions from the clusters. The
+// clusters which turn out to be unreachable are returned, the resources in them should be deleted later.
+func (h *AppHandler) deleteComponentsNotInRevisions(ctx monitorContext.Context, app *v1beta1.Application, revisions map[string]string) (map[string]bool, error) {
+	unreachable := map[string]bool{}
+	if len(revisions) == 0 {
+		return unreachable, nil
+	}
+	_, currentRT, _, _, err := resourcetracker.ListApplicationResourceTrackers(ctx, h.Client, app)
+	if err != nil || currentRT == nil {
+		return unreachable, err
+	}
+	components := map[string]map[string]bool{}
+	for _, revision := range revisions {
+		if _, found := components[revision]; found {
+			continue
+		}
+		rev := h.currentAppRev
+		if revision != h.currentAppRev.Name {
+			rev = &v1beta1.ApplicationRevision{}
+			if err = h.Client.Get(ctx, client.ObjectKey{Namespace: app.Namespace, Name: revision}, rev); err != nil {
+				return nil, errors.Wrapf(err, "failed to get the revision %s pinned to the clusters", revision)
+			}
+		}
+		components[revision] = map[string]bool{}
+		for _, comp := range rev.Spec.Application.Spec.Components {
+			components[revision][comp.Name] = true
+		}
+	}
+	for _, mr := range currentRT.Spec.ManagedResources {
+		cluster := mr.Cluster
+		if cluster == "" {
+			cluster = multicluster.ClusterLocalName
+		}
+		revision, found := revisions[cluster]
+		if !found || mr.Deleted || mr.Component == "" || components[revision][mr.Component] || unreachable[cluster] {
+			continue
+		}
+		if err = h.Delete(ctx, h.Client, mr.Cluster, mr.Creator, mr.ToUnstructured()); err != nil {
+			if multicluster.IsClusterDisconnect(err) {
+				unreachable[cluster] = true
+				continue
+			}
+			return nil, errors.Wrapf(err, "failed to delete %s %s/%s from cluster %s", mr.Kind, mr.Namespace, mr.Name, cluster)
+		}
+	}
+	return unreachable, nil
+}
+
+// deleteAppliedResources deletes the resources applied in the clusters
+func (h *AppHandler) deleteAppliedResources(ctx monitorContext.Context, app *v1beta1.Application, clusters map[string]bool) error {
+	for _, res := range app.Status.AppliedResources {
+		if !clusters[res.Cluster] {
+			continue
+		}
+		manifest := &unstructured.Unstructured{}
+		manifest.SetAPIVersion(res.APIVersion)
+		manifest.SetKind(res.Kind)
+		manifest.SetNamespace(res.Namespace)
+		manifest.SetName(res.Name)
+		if err := h.Delete(ctx, h.Client, res.Cluster, res.Creator, manifest); err != nil {
+			return errors.Wrapf(err, "failed to delete %s %s/%s from cluster %s", res.Kind, res.Namespace, res.Name, res.Cluster)
+		}
+	}
+	return nil
+}
+
+// deleteTrackedResources deletes the resources in the clusters recorded in the current resourcetracker of the app.
+// Unlike the applied resources in the status, the records are not cleared by the restart of the workflow. The
+// clusters which turn out to be unreachable are returned, the resources in them should be deleted later.
+func (h *AppHandler) deleteTrackedResources(ctx monitorContext.Context, app *v1beta1.Application, clusters map[string]bool) (map[string]bool, error) {
+	_, currentRT, _, _, err := resourcetracker.ListApplicationResourceTrackers(ctx, h.Client, app)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list resourcetrackers")
+	}
+	unreachable := map[string]bool{}
+	if currentRT == nil {
+		return unreachable, nil
+	}
+	for _, mr := range currentRT.Spec.ManagedResources {
+		if !clusters[mr.Cluster] || mr.Deleted || unreachable[mr.Cluster] {
+			continue
+		}
+		if err := h.Delete(ctx, h.Client, mr.Cluster, "", mr.ToUnstructured()); err != nil {
+			if multicluster.IsClusterDisconnect(err) {
+				unreachable[mr.Cluster] = true
+				continue
+			}
+			return nil, errors.Wrapf(err, "failed to delete %s from cluster %s", mr.DisplayName(), mr.Cluster)
+		}
+	}
+	return unreachable, nil
+}
+
 // CheckWorkflowRestart check if application workflow need restart and return the desired
 // rev to be set in status
 // 1. If workflow status is empty, it means no previous running record, the
@@ -167,7 +488,9 @@ func (h *AppHandler) CheckWorkflowRestart(ctx monitorContext.Context, app *v1bet
 
 	// clean recorded resources info.
 	app.Status.Services = nil
+	app.Status.HealthStatus = ""
 	app.Status.AppliedResources = nil
+	app.Status.PlacementRevisions = nil
 
 	// clean conditions after render
 	var reservedConditions []condition.Condition
@@ -303,29 +626,37 @@ func checkDependsOnValidComponent(dependsOnComponentNames, allComponentNames []s
 
 func (h *AppHandler) renderComponentFunc(appParser *appfile.Parser, af *appfile.Appfile) oamprovidertypes.ComponentRender {
 	return func(baseCtx context.Context, comp common.ApplicationComponent, patcher *cue.Value, clusterName string, overrideNamespace string) (*unstructured.Unstructured, []*unstructured.Unstructured, error) {
+		appRev, err := h.appRevisionFor(baseCtx)
+		if err != nil {
+			return nil, nil, err
+		}
 		ctx := multicluster.ContextWithClusterName(baseCtx, clusterName)
 
-		_, manifest, err := h.prepareWorkloadAndManifests(ctx, appParser, comp, patcher, af)
+		_, manifest, err := h.prepareWorkloadAndManifests(ctx, appParser, comp, patcher, af, appRev)
 		if err != nil {
 			return nil, nil, err
 		}
-		return renderComponentsAndTraits(manifest, h.currentAppRev, clusterName, overrideNamespace)
+		return renderComponentsAndTraits(manifest, appRev, clusterName, overrideNamespace)
 	}
 }
 
 func (h *AppHandler) checkComponentHealth(appParser *appfile.Parser, af *appfile.Appfile) oamprovidertypes.ComponentHealthCheck {
 	return func(baseCtx context.Context, comp common.ApplicationComponent, patcher *cue.Value, clusterName string, overrideNamespace string) (bool, *common.ApplicationComponentStatus, *unstructured.Unstructured, []*unstructured.Unstructured, error) {
+		appRev, err := h.appRevisionFor(baseCtx)
+		if err != nil {
+			return false, nil, nil, nil, err
+		}
 		ctx := multicluster.ContextWithClusterName(baseCtx, clusterName)
 		ctx = contextWithComponentNamespace(ctx, overrideNamespace)
 		ctx = contextWithReplicaKey(ctx, comp.ReplicaKey)
 
-		wl, manifest, err := h.prepareWorkloadAndManifests(ctx, appParser, comp, patcher, af)
+		wl, manifest, err := h.prepareWorkloadAndManifests(ctx, appParser, comp, patcher, af, appRev)
 		if err != nil {
 			return false, nil, nil, nil, err
 		}
 		wl.Ctx.SetCtx(auth.ContextWithUserInfo(ctx, h.app))
 
-		readyWorkload, readyTraits, err := renderComponentsAndTraits(manifest, h.currentAppRev, clusterName, overrideNamespace)
+		readyWorkload, readyTraits, err := renderComponentsAndTraits(manifest, appRev, clusterName, overrideNamespace)
 		if err != nil {
 			return false, nil, nil, nil, err
 		}
@@ -351,14 +682,17 @@ func (h *AppHandler) checkComponentHealth(appParser *appfile.Parser, af *appfile
 func (h *AppHandler) applyComponentFunc(appParser *appfile.Parser, af *appfile.Appfile) oamprovidertypes.ComponentApply {
 	return func(baseCtx context.Context, comp common.ApplicationComponent, patcher *cue.Value, clusterName string, overrideNamespace string) (*unstructured.Unstructured, []*unstructured.Unstructured, bool, error) {
 		t := time.Now()
-		appRev := h.currentAppRev
 		defer func() { metrics.ApplyComponentTimeHistogram.WithLabelValues("-").Observe(time.Since(t).Seconds()) }()
 
+		appRev, err := h.appRevisionFor(baseCtx)
+		if err != nil {
+			return nil, nil, false, err
+		}
 		ctx := multicluster.ContextWithClusterName(baseCtx, clusterName)
 		ctx = contextWithComponentNamespace(ctx, overrideNamespace)
 		ctx = contextWithReplicaKey(ctx, comp.ReplicaKey)
 
-		wl, manifest, err := h.prepareWorkloadAndManifests(ctx, appParser, comp, patcher, af)
+		wl, manifest, err := h.prepareWorkloadAndManifests(ctx, appParser, comp, patcher, af, appRev)
 		if err != nil {
 			return nil, nil, false, err
 		}
@@ -424,8 +758,9 @@ func (h *AppHandler) prepareWorkloadAndManifests(ctx context.Context,
 	appParser *appfile.Parser,
 	comp common.ApplicationComponent,
 	patcher *cue.Value,
-	af *appfile.Appfile) (*appfile.Component, *types.ComponentManifest, error) {
-	wl, err := appParser.ParseComponentFromRevisionAndClient(ctx, comp, h.currentAppRev)
+	af *appfile.Appfile,
+	appRev *v1beta1.ApplicationRevision) (*appfile.Component, *types.ComponentManifest, error) {
+	wl, err := appParser.ParseComponentFromRevisionAndClient(ctx, comp, appRev)
 	if err != nil {
 		return nil, nil, errors.WithMessage(err, "ParseWorkload")
 	}
@@ -454,6 +789,30 @@ func (h *AppHandler) prepareWorkloadAndManifests(ctx context.Context,
 	return wl, manifest, nil
 }
 
+// appRevisionFor returns the application revision to render the components with, which is the revision pinned to the
+// clusters if it's set in the context, otherwise the current revision. The context must not be bound to a cluster
+// since the revision is in the control plane.
+func (h *AppHandler) appRevisionFor(ctx context.Context) (*v1beta1.ApplicationRevision, error) {
+	name := oamprovidertypes.AppRevisionFromContext(ctx)
+	if name == "" || name == h.currentAppRev.Name {
+		return h.currentAppRev, nil
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if rev, found := h.pinnedAppRevs[name]; found {
+		return rev, nil
+	}
+	rev := &v1beta1.ApplicationRevision{}
+	if err := h.Client.Get(ctx, client.ObjectKey{Namespace: h.app.Namespace, Name: name}, rev); err != nil {
+		return nil, errors.Wrapf(err, "failed to get the revision %s pinned to the clusters", name)
+	}
+	if h.pinnedAppRevs == nil {
+		h.pinnedAppRevs = map[string]*v1beta1.ApplicationRevision{}
+	}
+	h.pinnedAppRevs[name] = rev
+	return rev, nil
+}
+
 func renderComponentsAndTraits(manifest *types.ComponentManifest, appRev *v1beta1.ApplicationRevision, clusterName string, overrideNamespace string) (*unstructured.Unstructured, []*unstructured.Unstructured, error) {
 	readyWorkload, readyTraits, err := assemble.PrepareBeforeApply(manifest, appRev, DisableAllComponentRevision)
 	if err != nil {
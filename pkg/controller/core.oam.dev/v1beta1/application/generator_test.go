@@ -18,14 +18,20 @@ package application
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"strconv"
+	"testing"
 	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/yaml"
 
 	monitorContext "github.com/kubevela/pkg/monitor/context"
@@ -33,7 +39,12 @@ import (
 
 	"github.com/oam-dev/kubevela/apis/core.oam.dev/common"
 	oamcore "github.com/oam-dev/kubevela/apis/core.oam.dev/v1beta1"
+	"github.com/oam-dev/kubevela/pkg/oam"
 	"github.com/oam-dev/kubevela/pkg/oam/util"
+	"github.com/oam-dev/kubevela/pkg/policy"
+	"github.com/oam-dev/kubevela/pkg/resourcekeeper"
+	common2 "github.com/oam-dev/kubevela/pkg/utils/common"
+	oamprovidertypes "github.com/oam-dev/kubevela/pkg/workflow/providers/types"
 )
 
 var _ = Describe("Test Application workflow generator", func() {
@@ -317,3 +328,148 @@ var _ = Describe("Test Application workflow generator", func() {
 		Expect(ctxData.AppAnnotations).To(BeNil())
 	})
 })
+
+// fakeResourceKeeper records the deleted resources, and fails the deletion in the unreachable clusters
+type fakeResourceKeeper struct {
+	resourcekeeper.ResourceKeeper
+	unreachable map[string]bool
+	deleted     []string
+}
+
+func (rk *fakeResourceKeeper) Delete(_ context.Context, manifests []*unstructured.Unstructured, _ ...resourcekeeper.DeleteOption) error {
+	for _, manifest := range manifests {
+		cluster := oam.GetCluster(manifest)
+		if rk.unreachable[cluster] {
+			return fmt.Errorf("dial tcp: lookup %s: no such host", cluster)
+		}
+		rk.deleted = append(rk.deleted, cluster+"/"+manifest.GetName())
+	}
+	return nil
+}
+
+func TestCleanupReleasedClusters(t *testing.T) {
+	origin := isClusterConnected
+	isClusterConnected = func(_ context.Context, _ client.Client, cluster string) bool { return cluster != "disconnected" }
+	t.Cleanup(func() { isClusterConnected = origin })
+
+	app := &oamcore.Application{ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "default", Generation: 1}}
+	manifest := func(cluster string) oamcore.ManagedResource {
+		return oamcore.ManagedResource{ClusterObjectReference: common.ClusterObjectReference{
+			Cluster:         cluster,
+			ObjectReference: corev1.ObjectReference{APIVersion: "apps/v1", Kind: "Deployment", Namespace: "default", Name: "web"},
+		}}
+	}
+	rt := &oamcore.ResourceTracker{
+		ObjectMeta: metav1.ObjectMeta{Name: "app-v1", Labels: map[string]string{oam.LabelAppName: "app", oam.LabelAppNamespace: "default"}},
+		Spec: oamcore.ResourceTrackerSpec{
+			Type:                  oamcore.ResourceTrackerTypeVersioned,
+			ApplicationGeneration: 1,
+			ManagedResources:      []oamcore.ManagedResource{manifest("released"), manifest("disconnected"), manifest("unreachable"), manifest("placed")},
+		},
+	}
+	cli := fake.NewClientBuilder().WithScheme(common2.Scheme).WithObjects(rt).Build()
+	rk := &fakeResourceKeeper{unreachable: map[string]bool{"unreachable": true}}
+	h := &AppHandler{Client: cli, app: app, resourceKeeper: rk}
+	released := map[string][]string{"topology": {"released", "disconnected", "unreachable", "placed"}}
+	placed := func(cluster string) bool { return cluster == "placed" }
+
+	ctx := monitorContext.NewTraceContext(context.Background(), "")
+	require.NoError(t, h.cleanupReleasedClusters(ctx, app, released, placed))
+	require.Equal(t, []string{"released/web"}, rk.deleted)
+	require.Equal(t, map[string][]string{"topology": {"disconnected", "unreachable"}}, policy.GetReleasedClusters(app))
+
+	isClusterConnected = func(_ context.Context, _ client.Client, _ string) bool { return true }
+	rk.unreachable = nil
+	require.NoError(t, h.cleanupReleasedClusters(ctx, app, policy.GetReleasedClusters(app), placed))
+	require.Equal(t, []string{"released/web", "disconnected/web", "unreachable/web"}, rk.deleted)
+	require.Empty(t, policy.GetReleasedClusters(app))
+}
+
+func TestCheckPlacementRevisions(t *testing.T) {
+	origin := isClusterConnected
+	connected := map[string]bool{"cluster-1": true}
+	isClusterConnected = func(_ context.Context, _ client.Client, cluster string) bool { return connected[cluster] }
+	t.Cleanup(func() { isClusterConnected = origin })
+
+	revision := func(name string, components ...string) *oamcore.ApplicationRevision {
+		rev := &oamcore.ApplicationRevision{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"}}
+		for _, comp := range components {
+			rev.Spec.Application.Spec.Components = append(rev.Spec.Application.Spec.Components, common.ApplicationComponent{Name: comp})
+		}
+		return rev
+	}
+	manifest := func(cluster, component string) oamcore.ManagedResource {
+		return oamcore.ManagedResource{
+			ClusterObjectReference: common.ClusterObjectReference{
+				Cluster:         cluster,
+				ObjectReference: corev1.ObjectReference{APIVersion: "apps/v1", Kind: "Deployment", Namespace: "default", Name: component},
+			},
+			OAMObjectReference: common.OAMObjectReference{Component: component},
+		}
+	}
+	rt := &oamcore.ResourceTracker{
+		ObjectMeta: metav1.ObjectMeta{Name: "app-v2", Labels: map[string]string{oam.LabelAppName: "app", oam.LabelAppNamespace: "default"}},
+		Spec: oamcore.ResourceTrackerSpec{
+			Type:                  oamcore.ResourceTrackerTypeVersioned,
+			ApplicationGeneration: 2,
+			ManagedResources: []oamcore.ManagedResource{
+				manifest("cluster-1", "web"), manifest("cluster-1", "worker"),
+				manifest("cluster-2", "web"), manifest("cluster-2", "worker"),
+			},
+		},
+	}
+	cli := fake.NewClientBuilder().WithScheme(common2.Scheme).WithObjects(rt, revision("app-v1", "web")).Build()
+	app := &oamcore.Application{ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "default", Generation: 2}}
+	app.Status.Workflow = &common.WorkflowStatus{Finished: true, Phase: workflowv1alpha1.WorkflowStateSucceeded}
+	app.Status.PlacementRevisions = []common.PlacementRevision{{Cluster: "cluster-1", Revision: "app-v2"}, {Cluster: "cluster-2", Revision: "app-v2"}}
+	require.NoError(t, oam.SetPlacementRevisions(app, map[string]string{"cluster-1": "app-v1", "cluster-2": "app-v1"}))
+	rk := &fakeResourceKeeper{}
+	h := &AppHandler{Client: cli, app: app, resourceKeeper: rk, currentAppRev: revision("app-v2", "web", "worker")}
+	ctx := monitorContext.NewTraceContext(context.Background(), "")
+
+	// the workflow restarts to deploy the pinned revision, nothing is deleted before it succeeds
+	require.NoError(t, h.CheckPlacementRevisions(ctx, app))
+	require.Nil(t, app.Status.Workflow)
+	require.Equal(t, []string{"cluster-1", "cluster-2"}, app.Status.PlacementCleanups)
+	require.Empty(t, rk.deleted)
+
+	app.Status.Workflow = &common.WorkflowStatus{Finished: true, Phase: workflowv1alpha1.WorkflowStateExecuting}
+	app.Status.PlacementRevisions = []common.PlacementRevision{{Cluster: "cluster-1", Revision: "app-v1", Pinned: true}, {Cluster: "cluster-2", Revision: "app-v1", Pinned: true}}
+	require.NoError(t, h.CheckPlacementRevisions(ctx, app))
+	require.Empty(t, rk.deleted)
+
+	// the components not in the pinned revision are deleted after the workflow succeeds, except for the
+	// disconnected cluster
+	app.Status.Workflow.Phase = workflowv1alpha1.WorkflowStateSucceeded
+	require.NoError(t, h.CheckPlacementRevisions(ctx, app))
+	require.NotNil(t, app.Status.Workflow)
+	require.Equal(t, []string{"cluster-1/worker"}, rk.deleted)
+	require.Equal(t, []string{"cluster-2"}, app.Status.PlacementCleanups)
+
+	connected["cluster-2"] = true
+	require.NoError(t, h.CheckPlacementRevisions(ctx, app))
+	require.Equal(t, []string{"cluster-1/worker", "cluster-2/worker"}, rk.deleted)
+	require.Nil(t, app.Status.PlacementCleanups)
+}
+
+func TestAppRevisionFor(t *testing.T) {
+	pinned := &oamcore.ApplicationRevision{ObjectMeta: metav1.ObjectMeta{Name: "app-v1", Namespace: "default"}}
+	current := &oamcore.ApplicationRevision{ObjectMeta: metav1.ObjectMeta{Name: "app-v2", Namespace: "default"}}
+	cli := fake.NewClientBuilder().WithScheme(common2.Scheme).WithObjects(pinned).Build()
+	app := &oamcore.Application{ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "default"}}
+	h := &AppHandler{Client: cli, app: app, currentAppRev: current}
+	ctx := context.Background()
+
+	rev, err := h.appRevisionFor(ctx)
+	require.NoError(t, err)
+	require.Equal(t, current, rev)
+	rev, err = h.appRevisionFor(oamprovidertypes.WithAppRevision(ctx, "app-v2"))
+	require.NoError(t, err)
+	require.Equal(t, current, rev)
+	rev, err = h.appRevisionFor(oamprovidertypes.WithAppRevision(ctx, "app-v1"))
+	require.NoError(t, err)
+	require.Equal(t, "app-v1", rev.Name)
+	require.Same(t, rev, h.pinnedAppRevs["app-v1"])
+	_, err = h.appRevisionFor(oamprovidertypes.WithAppRevision(ctx, "app-v0"))
+	require.Error(t, err)
+}
@@ -0,0 +1,323 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package health
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
+
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/common"
+	"github.com/oam-dev/kubevela/pkg/features"
+)
+
+// Assessor assesses the health status of a kubernetes object and returns the message explaining the status
+type Assessor func(obj *unstructured.Unstructured) (common.HealthStatus, string)
+
+var (
+	assessors   = map[schema.GroupVersionKind]Assessor{}
+	assessorsMu sync.RWMutex
+)
+
+// RegisterAssessor registers the built-in health assessor for the given GVK. The assessor registered with an empty
+// version matches all versions of the group kind. Registering the same GVK again overrides the previous one.
+func RegisterAssessor(gvk schema.GroupVersionKind, assessor Assessor) {
+	assessorsMu.Lock()
+	defer assessorsMu.Unlock()
+	assessors[gvk] = assessor
+}
+
+// GetAssessor returns the built-in health assessor for the given GVK. The assessor registered for the exact version
+// takes precedence over the one registered for all versions.
+func GetAssessor(gvk schema.GroupVersionKind) (Assessor, bool) {
+	assessorsMu.RLock()
+	defer assessorsMu.RUnlock()
+	if assessor, found := assessors[gvk]; found {
+		return assessor, true
+	}
+	assessor, found := assessors[gvk.GroupKind().WithVersion("")]
+	return assessor, found
+}
+
+// AssessHealth assesses the health status of the object with built-in assessors. Objects without registered assessor
+// will be assessed by their standard `Ready` condition. Return false if no assessor is applicable.
+func AssessHealth(obj *unstructured.Unstructured) (common.HealthStatus, string, bool) {
+	if assessor, found := GetAssessor(obj.GroupVersionKind()); found {
+		status, message := assessor(obj)
+		return status, message, true
+	}
+	if _, found := getCondition(obj, "Ready"); found {
+		status, message := assessReadyCondition(obj)
+		return status, message, true
+	}
+	return "", "", false
+}
+
+// checkBuiltinHealth assesses the output and outputs resources in the template context with built-in assessors.
+// Return the worst health status and its message, resources without applicable assessor are ignored.
+func checkBuiltinHealth(templateContext map[string]interface{}) (bool, common.HealthStatus, string) {
+	var objs []map[string]interface{}
+	if output, ok := templateContext[outputFieldName].(map[string]interface{}); ok {
+		objs = append(objs, output)
+	}
+	if outputs, ok := templateContext[outputsFieldName].(map[string]interface{}); ok {
+		var names []string
+		for name := range outputs {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			if output, ok := outputs[name].(map[string]interface{}); ok {
+				objs = append(objs, output)
+			}
+		}
+	}
+	status, message := common.HealthStatusHealthy, ""
+	for _, o := range objs {
+		obj := &unstructured.Unstructured{Object: o}
+		_status, _message, found := AssessHealth(obj)
+		if !found || !_status.IsWorseThan(status) {
+			continue
+		}
+		status = _status
+		if message = _message; message != "" {
+			message = fmt.Sprintf("%s %s: %s", obj.GetKind(), obj.GetName(), message)
+		}
+	}
+	return status == common.HealthStatusHealthy, status, message
+}
+
+const (
+	outputFieldName  = "output"
+	outputsFieldName = "outputs"
+)
+
+func init() {
+	RegisterAssessor(appsv1.SchemeGroupVersion.WithKind("Deployment"), assessDeployment)
+	RegisterAssessor(appsv1.SchemeGroupVersion.WithKind("StatefulSet"), assessStatefulSet)
+	RegisterAssessor(appsv1.SchemeGroupVersion.WithKind("DaemonSet"), assessDaemonSet)
+	RegisterAssessor(batchv1.SchemeGroupVersion.WithKind("Job"), assessJob)
+	RegisterAssessor(corev1.SchemeGroupVersion.WithKind("PersistentVolumeClaim"), assessPersistentVolumeClaim)
+	RegisterAssessor(corev1.SchemeGroupVersion.WithKind("Service"), assessService)
+	RegisterAssessor(schema.GroupVersionKind{Group: "networking.k8s.io", Kind: "Ingress"}, assessIngress)
+	RegisterAssessor(schema.GroupVersionKind{Group: "extensions", Kind: "Ingress"}, assessIngress)
+	RegisterAssessor(schema.GroupVersionKind{Group: "autoscaling", Kind: "HorizontalPodAutoscaler"}, assessHorizontalPodAutoscaler)
+}
+
+func fromUnstructured(obj *unstructured.Unstructured, target interface{}) error {
+	return runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, target)
+}
+
+func assessDeployment(obj *unstructured.Unstructured) (common.HealthStatus, string) {
+	deploy := &appsv1.Deployment{}
+	if err := fromUnstructured(obj, deploy); err != nil {
+		return common.HealthStatusUnknown, err.Error()
+	}
+	if deploy.Spec.Paused {
+		return common.HealthStatusSuspended, "deployment is paused"
+	}
+	if deploy.Generation > deploy.Status.ObservedGeneration {
+		return common.HealthStatusProgressing, "waiting for rollout to be observed"
+	}
+	for _, cond := range deploy.Status.Conditions {
+		if cond.Type == appsv1.DeploymentProgressing && cond.Reason == "ProgressDeadlineExceeded" {
+			return common.HealthStatusDegraded, "deployment exceeded its progress deadline"
+		}
+	}
+	replicas := int32(1)
+	if deploy.Spec.Replicas != nil {
+		replicas = *deploy.Spec.Replicas
+	}
+	switch {
+	case deploy.Status.UpdatedReplicas < replicas:
+		return common.HealthStatusProgressing, fmt.Sprintf("%d out of %d new replicas have been updated", deploy.Status.UpdatedReplicas, replicas)
+	case deploy.Status.Replicas > deploy.Status.UpdatedReplicas:
+		return common.HealthStatusProgressing, fmt.Sprintf("%d old replicas are pending termination", deploy.Status.Replicas-deploy.Status.UpdatedReplicas)
+	case deploy.Status.AvailableReplicas < deploy.Status.UpdatedReplicas:
+		return common.HealthStatusProgressing, fmt.Sprintf("%d of %d updated replicas are available", deploy.Status.AvailableReplicas, deploy.Status.UpdatedReplicas)
+	}
+	return common.HealthStatusHealthy, ""
+}
+
+func assessStatefulSet(obj *unstructured.Unstructured) (common.HealthStatus, string) {
+	sts := &appsv1.StatefulSet{}
+	if err := fromUnstructured(obj, sts); err != nil {
+		return common.HealthStatusUnknown, err.Error()
+	}
+	if sts.Generation > sts.Status.ObservedGeneration {
+		return common.HealthStatusProgressing, "waiting for rollout to be observed"
+	}
+	replicas := int32(1)
+	if sts.Spec.Replicas != nil {
+		replicas = *sts.Spec.Replicas
+	}
+	if sts.Status.ReadyReplicas < replicas {
+		return common.HealthStatusProgressing, fmt.Sprintf("%d of %d replicas are ready", sts.Status.ReadyReplicas, replicas)
+	}
+	if sts.Spec.UpdateStrategy.Type == appsv1.OnDeleteStatefulSetStrategyType {
+		return common.HealthStatusHealthy, ""
+	}
+	if ru := sts.Spec.UpdateStrategy.RollingUpdate; ru != nil && ru.Partition != nil && *ru.Partition > 0 {
+		if sts.Status.UpdatedReplicas < replicas-*ru.Partition {
+			return common.HealthStatusProgressing, fmt.Sprintf("waiting for partitioned roll out to finish: %d out of %d new pods have been updated", sts.Status.UpdatedReplicas, replicas-*ru.Partition)
+		}
+		return common.HealthStatusHealthy, ""
+	}
+	if sts.Status.UpdateRevision != sts.Status.CurrentRevision {
+		return common.HealthStatusProgressing, fmt.Sprintf("waiting for rolling update to complete: %d pods at revision %s", sts.Status.UpdatedReplicas, sts.Status.UpdateRevision)
+	}
+	return common.HealthStatusHealthy, ""
+}
+
+func assessDaemonSet(obj *unstructured.Unstructured) (common.HealthStatus, string) {
+	ds := &appsv1.DaemonSet{}
+	if err := fromUnstructured(obj, ds); err != nil {
+		return common.HealthStatusUnknown, err.Error()
+	}
+	if ds.Generation > ds.Status.ObservedGeneration {
+		return common.HealthStatusProgressing, "waiting for rollout to be observed"
+	}
+	if ds.Spec.UpdateStrategy.Type != appsv1.OnDeleteDaemonSetStrategyType && ds.Status.UpdatedNumberScheduled < ds.Status.DesiredNumberScheduled {
+		return common.HealthStatusProgressing, fmt.Sprintf("%d out of %d new pods have been updated", ds.Status.UpdatedNumberScheduled, ds.Status.DesiredNumberScheduled)
+	}
+	if ds.Status.NumberAvailable < ds.Status.DesiredNumberScheduled {
+		return common.HealthStatusProgressing, fmt.Sprintf("%d of %d pods are available", ds.Status.NumberAvailable, ds.Status.DesiredNumberScheduled)
+	}
+	return common.HealthStatusHealthy, ""
+}
+
+func assessJob(obj *unstructured.Unstructured) (common.HealthStatus, string) {
+	job := &batchv1.Job{}
+	if err := fromUnstructured(obj, job); err != nil {
+		return common.HealthStatusUnknown, err.Error()
+	}
+	for _, cond := range job.Status.Conditions {
+		if cond.Status != corev1.ConditionTrue {
+			continue
+		}
+		switch cond.Type {
+		case batchv1.JobFailed:
+			return common.HealthStatusDegraded, cond.Message
+		case batchv1.JobComplete:
+			return common.HealthStatusHealthy, ""
+		default:
+		}
+	}
+	if job.Spec.Suspend != nil && *job.Spec.Suspend {
+		return common.HealthStatusSuspended, "job is suspended"
+	}
+	return common.HealthStatusProgressing, "job is running"
+}
+
+func assessPersistentVolumeClaim(obj *unstructured.Unstructured) (common.HealthStatus, string) {
+	pvc := &corev1.PersistentVolumeClaim{}
+	if err := fromUnstructured(obj, pvc); err != nil {
+		return common.HealthStatusUnknown, err.Error()
+	}
+	switch pvc.Status.Phase {
+	case corev1.ClaimBound:
+		return common.HealthStatusHealthy, ""
+	case corev1.ClaimLost:
+		return common.HealthStatusDegraded, "claim is lost"
+	default:
+		return common.HealthStatusProgressing, "waiting for claim to be bound"
+	}
+}
+
+// assessService waits for the load balancer to be provisioned only if the EnableLoadBalancerHealthCheck feature is
+// enabled, otherwise the service is always healthy
+func assessService(obj *unstructured.Unstructured) (common.HealthStatus, string) {
+	if !utilfeature.DefaultMutableFeatureGate.Enabled(features.EnableLoadBalancerHealthCheck) {
+		return common.HealthStatusHealthy, ""
+	}
+	svc := &corev1.Service{}
+	if err := fromUnstructured(obj, svc); err != nil {
+		return common.HealthStatusUnknown, err.Error()
+	}
+	if svc.Spec.Type == corev1.ServiceTypeLoadBalancer && len(svc.Status.LoadBalancer.Ingress) == 0 {
+		return common.HealthStatusProgressing, "waiting for load balancer to be provisioned"
+	}
+	return common.HealthStatusHealthy, ""
+}
+
+// assessIngress waits for the load balancer to be provisioned only if the EnableLoadBalancerHealthCheck feature is
+// enabled, otherwise the ingress is always healthy
+func assessIngress(obj *unstructured.Unstructured) (common.HealthStatus, string) {
+	if !utilfeature.DefaultMutableFeatureGate.Enabled(features.EnableLoadBalancerHealthCheck) {
+		return common.HealthStatusHealthy, ""
+	}
+	ingress, _, _ := unstructured.NestedSlice(obj.Object, "status", "loadBalancer", "ingress")
+	if len(ingress) == 0 {
+		return common.HealthStatusProgressing, "waiting for load balancer to be provisioned"
+	}
+	return common.HealthStatusHealthy, ""
+}
+
+func assessHorizontalPodAutoscaler(obj *unstructured.Unstructured) (common.HealthStatus, string) {
+	if cond, found := getCondition(obj, "AbleToScale"); found && cond.status == string(corev1.ConditionFalse) {
+		return common.HealthStatusDegraded, cond.message
+	}
+	if cond, found := getCondition(obj, "ScalingActive"); found && cond.status == string(corev1.ConditionFalse) && cond.reason != "ScalingDisabled" {
+		return common.HealthStatusDegraded, cond.message
+	}
+	return common.HealthStatusHealthy, ""
+}
+
+// assessReadyCondition assesses the objects following the convention of the `Ready` condition, and the `Stalled`
+// condition in kstatus
+func assessReadyCondition(obj *unstructured.Unstructured) (common.HealthStatus, string) {
+	if observed, found, _ := unstructured.NestedInt64(obj.Object, "status", "observedGeneration"); found && obj.GetGeneration() > observed {
+		return common.HealthStatusProgressing, "waiting for the latest generation to be observed"
+	}
+	if cond, found := getCondition(obj, "Stalled"); found && cond.status == string(corev1.ConditionTrue) {
+		return common.HealthStatusDegraded, cond.message
+	}
+	cond, _ := getCondition(obj, "Ready")
+	if cond.status == string(corev1.ConditionTrue) {
+		return common.HealthStatusHealthy, ""
+	}
+	return common.HealthStatusProgressing, cond.message
+}
+
+type condition struct {
+	status  string
+	reason  string
+	message string
+}
+
+func getCondition(obj *unstructured.Unstructured, condType string) (condition, bool) {
+	conditions, _, _ := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	for _, c := range conditions {
+		cond, ok := c.(map[string]interface{})
+		if !ok || cond["type"] != condType {
+			continue
+		}
+		status, _ := cond["status"].(string)
+		reason, _ := cond["reason"].(string)
+		message, _ := cond["message"].(string)
+		return condition{status: status, reason: reason, message: message}, true
+	}
+	return condition{}, false
+}
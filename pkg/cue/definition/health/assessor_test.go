@@ -0,0 +1,402 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package health
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
+	featuregatetesting "k8s.io/component-base/featuregate/testing"
+
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/common"
+	"github.com/oam-dev/kubevela/pkg/features"
+)
+
+func TestAssessHealth(t *testing.T) {
+	featuregatetesting.SetFeatureGateDuringTest(t, utilfeature.DefaultFeatureGate, features.EnableLoadBalancerHealthCheck, true)
+	cases := map[string]struct {
+		obj    map[string]interface{}
+		status common.HealthStatus
+		found  bool
+	}{
+		"deployment-available": {
+			obj: map[string]interface{}{
+				"apiVersion": "apps/v1",
+				"kind":       "Deployment",
+				"metadata":   map[string]interface{}{"name": "d", "generation": int64(2)},
+				"spec":       map[string]interface{}{"replicas": int64(2)},
+				"status": map[string]interface{}{
+					"observedGeneration": int64(2),
+					"replicas":           int64(2),
+					"updatedReplicas":    int64(2),
+					"availableReplicas":  int64(2),
+				},
+			},
+			status: common.HealthStatusHealthy,
+			found:  true,
+		},
+		"deployment-rolling": {
+			obj: map[string]interface{}{
+				"apiVersion": "apps/v1",
+				"kind":       "Deployment",
+				"metadata":   map[string]interface{}{"name": "d", "generation": int64(2)},
+				"spec":       map[string]interface{}{"replicas": int64(2)},
+				"status": map[string]interface{}{
+					"observedGeneration": int64(2),
+					"replicas":           int64(3),
+					"updatedReplicas":    int64(2),
+					"availableReplicas":  int64(2),
+				},
+			},
+			status: common.HealthStatusProgressing,
+			found:  true,
+		},
+		"deployment-deadline-exceeded": {
+			obj: map[string]interface{}{
+				"apiVersion": "apps/v1",
+				"kind":       "Deployment",
+				"metadata":   map[string]interface{}{"name": "d"},
+				"status": map[string]interface{}{
+					"conditions": []interface{}{map[string]interface{}{
+						"type":   "Progressing",
+						"status": "False",
+						"reason": "ProgressDeadlineExceeded",
+					}},
+				},
+			},
+			status: common.HealthStatusDegraded,
+			found:  true,
+		},
+		"deployment-paused": {
+			obj: map[string]interface{}{
+				"apiVersion": "apps/v1",
+				"kind":       "Deployment",
+				"metadata":   map[string]interface{}{"name": "d"},
+				"spec":       map[string]interface{}{"paused": true},
+			},
+			status: common.HealthStatusSuspended,
+			found:  true,
+		},
+		"statefulset-not-ready": {
+			obj: map[string]interface{}{
+				"apiVersion": "apps/v1",
+				"kind":       "StatefulSet",
+				"metadata":   map[string]interface{}{"name": "s"},
+				"spec":       map[string]interface{}{"replicas": int64(3)},
+				"status":     map[string]interface{}{"readyReplicas": int64(1)},
+			},
+			status: common.HealthStatusProgressing,
+			found:  true,
+		},
+		"statefulset-ready": {
+			obj: map[string]interface{}{
+				"apiVersion": "apps/v1",
+				"kind":       "StatefulSet",
+				"metadata":   map[string]interface{}{"name": "s"},
+				"spec":       map[string]interface{}{"replicas": int64(1)},
+				"status": map[string]interface{}{
+					"readyReplicas":   int64(1),
+					"currentRevision": "s-1",
+					"updateRevision":  "s-1",
+				},
+			},
+			status: common.HealthStatusHealthy,
+			found:  true,
+		},
+		"daemonset-updating": {
+			obj: map[string]interface{}{
+				"apiVersion": "apps/v1",
+				"kind":       "DaemonSet",
+				"metadata":   map[string]interface{}{"name": "ds"},
+				"status": map[string]interface{}{
+					"desiredNumberScheduled": int64(3),
+					"updatedNumberScheduled": int64(2),
+					"numberAvailable":        int64(3),
+				},
+			},
+			status: common.HealthStatusProgressing,
+			found:  true,
+		},
+		"job-failed": {
+			obj: map[string]interface{}{
+				"apiVersion": "batch/v1",
+				"kind":       "Job",
+				"metadata":   map[string]interface{}{"name": "j"},
+				"status": map[string]interface{}{
+					"conditions": []interface{}{map[string]interface{}{"type": "Failed", "status": "True"}},
+				},
+			},
+			status: common.HealthStatusDegraded,
+			found:  true,
+		},
+		"job-complete": {
+			obj: map[string]interface{}{
+				"apiVersion": "batch/v1",
+				"kind":       "Job",
+				"metadata":   map[string]interface{}{"name": "j"},
+				"status": map[string]interface{}{
+					"conditions": []interface{}{map[string]interface{}{"type": "Complete", "status": "True"}},
+				},
+			},
+			status: common.HealthStatusHealthy,
+			found:  true,
+		},
+		"job-suspended": {
+			obj: map[string]interface{}{
+				"apiVersion": "batch/v1",
+				"kind":       "Job",
+				"metadata":   map[string]interface{}{"name": "j"},
+				"spec":       map[string]interface{}{"suspend": true},
+			},
+			status: common.HealthStatusSuspended,
+			found:  true,
+		},
+		"pvc-pending": {
+			obj: map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "PersistentVolumeClaim",
+				"metadata":   map[string]interface{}{"name": "pvc"},
+				"status":     map[string]interface{}{"phase": "Pending"},
+			},
+			status: common.HealthStatusProgressing,
+			found:  true,
+		},
+		"pvc-lost": {
+			obj: map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "PersistentVolumeClaim",
+				"metadata":   map[string]interface{}{"name": "pvc"},
+				"status":     map[string]interface{}{"phase": "Lost"},
+			},
+			status: common.HealthStatusDegraded,
+			found:  true,
+		},
+		"service-cluster-ip": {
+			obj: map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "Service",
+				"metadata":   map[string]interface{}{"name": "svc"},
+				"spec":       map[string]interface{}{"type": "ClusterIP"},
+			},
+			status: common.HealthStatusHealthy,
+			found:  true,
+		},
+		"service-load-balancer-pending": {
+			obj: map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "Service",
+				"metadata":   map[string]interface{}{"name": "svc"},
+				"spec":       map[string]interface{}{"type": "LoadBalancer"},
+			},
+			status: common.HealthStatusProgressing,
+			found:  true,
+		},
+		"service-load-balancer-provisioned": {
+			obj: map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "Service",
+				"metadata":   map[string]interface{}{"name": "svc"},
+				"spec":       map[string]interface{}{"type": "LoadBalancer"},
+				"status": map[string]interface{}{
+					"loadBalancer": map[string]interface{}{
+						"ingress": []interface{}{map[string]interface{}{"ip": "1.2.3.4"}},
+					},
+				},
+			},
+			status: common.HealthStatusHealthy,
+			found:  true,
+		},
+		"ingress-pending": {
+			obj: map[string]interface{}{
+				"apiVersion": "networking.k8s.io/v1",
+				"kind":       "Ingress",
+				"metadata":   map[string]interface{}{"name": "ing"},
+			},
+			status: common.HealthStatusProgressing,
+			found:  true,
+		},
+		"hpa-unable-to-scale": {
+			obj: map[string]interface{}{
+				"apiVersion": "autoscaling/v2",
+				"kind":       "HorizontalPodAutoscaler",
+				"metadata":   map[string]interface{}{"name": "hpa"},
+				"status": map[string]interface{}{
+					"conditions": []interface{}{map[string]interface{}{"type": "AbleToScale", "status": "False"}},
+				},
+			},
+			status: common.HealthStatusDegraded,
+			found:  true,
+		},
+		"hpa-scaling-disabled": {
+			obj: map[string]interface{}{
+				"apiVersion": "autoscaling/v1",
+				"kind":       "HorizontalPodAutoscaler",
+				"metadata":   map[string]interface{}{"name": "hpa"},
+				"status": map[string]interface{}{
+					"conditions": []interface{}{map[string]interface{}{"type": "ScalingActive", "status": "False", "reason": "ScalingDisabled"}},
+				},
+			},
+			status: common.HealthStatusHealthy,
+			found:  true,
+		},
+		"crd-ready": {
+			obj: map[string]interface{}{
+				"apiVersion": "example.com/v1",
+				"kind":       "Database",
+				"metadata":   map[string]interface{}{"name": "db"},
+				"status": map[string]interface{}{
+					"conditions": []interface{}{map[string]interface{}{"type": "Ready", "status": "True"}},
+				},
+			},
+			status: common.HealthStatusHealthy,
+			found:  true,
+		},
+		"crd-not-ready": {
+			obj: map[string]interface{}{
+				"apiVersion": "example.com/v1",
+				"kind":       "Database",
+				"metadata":   map[string]interface{}{"name": "db"},
+				"status": map[string]interface{}{
+					"conditions": []interface{}{map[string]interface{}{"type": "Ready", "status": "False"}},
+				},
+			},
+			status: common.HealthStatusProgressing,
+			found:  true,
+		},
+		"crd-stalled": {
+			obj: map[string]interface{}{
+				"apiVersion": "example.com/v1",
+				"kind":       "Database",
+				"metadata":   map[string]interface{}{"name": "db"},
+				"status": map[string]interface{}{
+					"conditions": []interface{}{
+						map[string]interface{}{"type": "Ready", "status": "False"},
+						map[string]interface{}{"type": "Stalled", "status": "True"},
+					},
+				},
+			},
+			status: common.HealthStatusDegraded,
+			found:  true,
+		},
+		"crd-without-ready-condition": {
+			obj: map[string]interface{}{
+				"apiVersion": "example.com/v1",
+				"kind":       "Database",
+				"metadata":   map[string]interface{}{"name": "db"},
+			},
+			found: false,
+		},
+	}
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			status, _, found := AssessHealth(&unstructured.Unstructured{Object: c.obj})
+			assert.Equal(t, c.found, found)
+			assert.Equal(t, c.status, status)
+		})
+	}
+}
+
+func TestAssessLoadBalancerDisabled(t *testing.T) {
+	for _, obj := range []map[string]interface{}{{
+		"apiVersion": "v1",
+		"kind":       "Service",
+		"metadata":   map[string]interface{}{"name": "svc"},
+		"spec":       map[string]interface{}{"type": "LoadBalancer"},
+	}, {
+		"apiVersion": "networking.k8s.io/v1",
+		"kind":       "Ingress",
+		"metadata":   map[string]interface{}{"name": "ing"},
+	}} {
+		status, _, found := AssessHealth(&unstructured.Unstructured{Object: obj})
+		require.True(t, found)
+		require.Equal(t, common.HealthStatusHealthy, status)
+	}
+}
+
+func TestRegisterAssessor(t *testing.T) {
+	gvk := schema.GroupVersionKind{Group: "example.com", Version: "v1", Kind: "Widget"}
+	RegisterAssessor(gvk.GroupKind().WithVersion(""), func(*unstructured.Unstructured) (common.HealthStatus, string) {
+		return common.HealthStatusSuspended, "all versions"
+	})
+	RegisterAssessor(gvk, func(*unstructured.Unstructured) (common.HealthStatus, string) {
+		return common.HealthStatusDegraded, "v1"
+	})
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(gvk)
+	status, message, found := AssessHealth(obj)
+	require.True(t, found)
+	require.Equal(t, common.HealthStatusDegraded, status)
+	require.Equal(t, "v1", message)
+
+	obj.SetAPIVersion("example.com/v2")
+	status, message, found = AssessHealth(obj)
+	require.True(t, found)
+	require.Equal(t, common.HealthStatusSuspended, status)
+	require.Equal(t, "all versions", message)
+}
+
+func TestGetStatusWithBuiltinHealth(t *testing.T) {
+	featuregatetesting.SetFeatureGateDuringTest(t, utilfeature.DefaultFeatureGate, features.EnableLoadBalancerHealthCheck, true)
+	templateContext := func() map[string]interface{} {
+		return map[string]interface{}{
+			"output": map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "PersistentVolumeClaim",
+				"metadata":   map[string]interface{}{"name": "data"},
+				"status":     map[string]interface{}{"phase": "Bound"},
+			},
+			"outputs": map[string]interface{}{
+				"service": map[string]interface{}{
+					"apiVersion": "v1",
+					"kind":       "Service",
+					"metadata":   map[string]interface{}{"name": "web"},
+					"spec":       map[string]interface{}{"type": "LoadBalancer"},
+				},
+				"config": map[string]interface{}{
+					"apiVersion": "v1",
+					"kind":       "ConfigMap",
+					"metadata":   map[string]interface{}{"name": "web"},
+				},
+			},
+		}
+	}
+
+	res, err := GetStatus(templateContext(), &StatusRequest{})
+	require.NoError(t, err)
+	require.False(t, res.Healthy)
+	require.Equal(t, common.HealthStatusProgressing, res.HealthStatus)
+	require.Equal(t, "Service web: waiting for load balancer to be provisioned", res.Message)
+
+	res, err = GetStatus(templateContext(), &StatusRequest{Custom: `message: "custom"`})
+	require.NoError(t, err)
+	require.False(t, res.Healthy)
+	require.Equal(t, "custom", res.Message)
+
+	res, err = GetStatus(templateContext(), &StatusRequest{DisableBuiltinHealthCheck: true})
+	require.NoError(t, err)
+	require.True(t, res.Healthy)
+	require.Equal(t, common.HealthStatusHealthy, res.HealthStatus)
+	require.Equal(t, "", res.Message)
+
+	res, err = GetStatus(templateContext(), &StatusRequest{Health: `isHealth: true`})
+	require.NoError(t, err)
+	require.True(t, res.Healthy)
+}
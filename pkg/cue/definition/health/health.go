@@ -28,38 +28,43 @@ import (
 	"github.com/pkg/errors"
 	"k8s.io/klog/v2"
 
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/common"
 	"github.com/oam-dev/kubevela/pkg/oam/util"
 )
 
 const (
 	CustomMessage  = "message"
 	IsHealthPolicy = "isHealth"
+	// HealthStatusPolicy is the field in the health status check to report the health status enum
+	HealthStatusPolicy = "healthStatus"
 )
 
 type StatusRequest struct {
-	Health    string
-	Custom    string
-	Details   string
-	Parameter map[string]interface{}
+	Health string
+	// HealthStatus is the health status check reporting the health status enum
+	HealthStatus string
+	Custom       string
+	Details      string
+	Parameter    map[string]interface{}
+	// DisableBuiltinHealthCheck disables the built-in health assessors used when Health and HealthStatus are empty
+	DisableBuiltinHealthCheck bool
 }
 
 type StatusResult struct {
-	Healthy bool              `json:"healthy"`
-	Message string            `json:"message,omitempty"`
-	Details map[string]string `json:"details,omitempty"`
+	Healthy      bool                `json:"healthy"`
+	HealthStatus common.HealthStatus `json:"healthStatus,omitempty"`
+	Message      string              `json:"message,omitempty"`
+	Details      map[string]string   `json:"details,omitempty"`
 }
 
 func CheckHealth(templateContext map[string]interface{}, healthPolicyTemplate string, parameter interface{}) (bool, error) {
 	if healthPolicyTemplate == "" {
 		return true, nil
 	}
-	runtimeContextBuff, err := formatRuntimeContext(templateContext, parameter)
+	val, err := compileHealthTemplate(templateContext, healthPolicyTemplate, parameter)
 	if err != nil {
 		return false, err
 	}
-	var buff = healthPolicyTemplate + "\n" + runtimeContextBuff
-
-	val := cuecontext.New().CompileString(buff)
 	healthy, err := val.LookupPath(value.FieldPath(IsHealthPolicy)).Bool()
 	if err != nil {
 		return false, errors.WithMessage(err, "evaluate health status")
@@ -67,6 +72,55 @@ func CheckHealth(templateContext map[string]interface{}, healthPolicyTemplate st
 	return healthy, nil
 }
 
+// CheckHealthStatus evaluate the health policy and the health status check, and return both the boolean health result
+// and the health status. If only one of them is set, the other one is derived from it. If both are set, the results
+// must agree with each other, that is, the resource is healthy if and only if the health status is Healthy. The
+// Suspended status agrees with both, as a suspended resource is not unhealthy.
+func CheckHealthStatus(templateContext map[string]interface{}, healthPolicyTemplate, healthStatusTemplate string, parameter interface{}) (bool, common.HealthStatus, error) {
+	healthy, err := CheckHealth(templateContext, healthPolicyTemplate, parameter)
+	if err != nil {
+		return false, common.HealthStatusUnknown, err
+	}
+	if healthStatusTemplate == "" {
+		return healthy, common.HealthStatusFromHealthy(healthy), nil
+	}
+	status, err := evalHealthStatus(templateContext, healthStatusTemplate, parameter)
+	if err != nil {
+		return false, common.HealthStatusUnknown, err
+	}
+	if healthPolicyTemplate == "" {
+		return status == common.HealthStatusHealthy, status, nil
+	}
+	if status != common.HealthStatusSuspended && healthy != (status == common.HealthStatusHealthy) {
+		return false, common.HealthStatusUnknown, errors.Errorf("health status %s contradicts isHealth %t", status, healthy)
+	}
+	return healthy, status, nil
+}
+
+func evalHealthStatus(templateContext map[string]interface{}, healthStatusTemplate string, parameter interface{}) (common.HealthStatus, error) {
+	val, err := compileHealthTemplate(templateContext, healthStatusTemplate, parameter)
+	if err != nil {
+		return "", err
+	}
+	s, err := val.LookupPath(value.FieldPath(HealthStatusPolicy)).String()
+	if err != nil {
+		return "", errors.WithMessage(err, "evaluate health status")
+	}
+	status := common.HealthStatus(s)
+	if !status.IsValid() {
+		return "", errors.Errorf("invalid health status %q", s)
+	}
+	return status, nil
+}
+
+func compileHealthTemplate(templateContext map[string]interface{}, template string, parameter interface{}) (cue.Value, error) {
+	runtimeContextBuff, err := formatRuntimeContext(templateContext, parameter)
+	if err != nil {
+		return cue.Value{}, err
+	}
+	return cuecontext.New().CompileString(template + "\n" + runtimeContextBuff), nil
+}
+
 func GetStatus(templateContext map[string]interface{}, request *StatusRequest) (*StatusResult, error) {
 	if templateContext["status"] == nil {
 		templateContext["status"] = make(map[string]interface{})
@@ -77,13 +131,18 @@ func GetStatus(templateContext map[string]interface{}, request *StatusRequest) (
 		klog.Warningf("failed to get status map: %v", mapErr)
 	}
 
-	healthy, healthErr := CheckHealth(templateContext, request.Health, request.Parameter)
+	healthy, healthStatus, healthErr := CheckHealthStatus(templateContext, request.Health, request.HealthStatus, request.Parameter)
 	if healthErr != nil {
 		klog.Warningf("failed to check health: %v", healthErr)
 	}
+	var builtinMessage string
+	if request.Health == "" && request.HealthStatus == "" && !request.DisableBuiltinHealthCheck {
+		healthy, healthStatus, builtinMessage = checkBuiltinHealth(templateContext)
+	}
 
 	if statusMap, ok := templateContext["status"].(map[string]interface{}); ok {
 		statusMap["healthy"] = healthy
+		statusMap[HealthStatusPolicy] = string(healthStatus)
 	} else {
 		klog.Warningf("templateContext['status'] is not a map[string]interface{}, cannot set healthy field")
 	}
@@ -92,11 +151,15 @@ func GetStatus(templateContext map[string]interface{}, request *StatusRequest) (
 	if msgErr != nil {
 		klog.Warningf("failed to get status message: %v", msgErr)
 	}
+	if request.Custom == "" {
+		message = builtinMessage
+	}
 
 	return &StatusResult{
-		Healthy: healthy,
-		Message: message,
-		Details: statusMap,
+		Healthy:      healthy,
+		HealthStatus: healthStatus,
+		Message:      message,
+		Details:      statusMap,
 	}, nil
 }
 
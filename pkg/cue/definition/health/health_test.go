@@ -22,6 +22,8 @@ import (
 
 	"cuelang.org/go/cue/token"
 	"github.com/stretchr/testify/assert"
+
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/common"
 )
 
 func TestCheckHealth(t *testing.T) {
@@ -100,6 +102,111 @@ func TestCheckHealth(t *testing.T) {
 	}
 }
 
+func TestCheckHealthStatus(t *testing.T) {
+	tpContext := map[string]interface{}{
+		"output": map[string]interface{}{
+			"spec":   map[string]interface{}{"paused": true},
+			"status": map[string]interface{}{"readyReplicas": 1, "replicas": 2},
+		},
+	}
+	cases := map[string]struct {
+		healthTemp string
+		statusTemp string
+		expHealthy bool
+		expStatus  common.HealthStatus
+		expErr     bool
+	}{
+		"empty-policy": {
+			expHealthy: true,
+			expStatus:  common.HealthStatusHealthy,
+		},
+		"bool-only-healthy": {
+			healthTemp: "isHealth: context.output.status.readyReplicas > 0",
+			expHealthy: true,
+			expStatus:  common.HealthStatusHealthy,
+		},
+		"bool-only-unhealthy": {
+			healthTemp: "isHealth: context.output.status.readyReplicas == context.output.status.replicas",
+			expHealthy: false,
+			expStatus:  common.HealthStatusProgressing,
+		},
+		"status-only": {
+			statusTemp: `healthStatus: *"Progressing" | "Suspended"
+if context.output.spec.paused {
+	healthStatus: "Suspended"
+}`,
+			expHealthy: false,
+			expStatus:  common.HealthStatusSuspended,
+		},
+		"status-only-healthy": {
+			statusTemp: `healthStatus: "Healthy"`,
+			expHealthy: true,
+			expStatus:  common.HealthStatusHealthy,
+		},
+		"status-with-bool": {
+			healthTemp: "isHealth: context.output.status.readyReplicas == context.output.status.replicas",
+			statusTemp: `healthStatus: "Degraded"`,
+			expHealthy: false,
+			expStatus:  common.HealthStatusDegraded,
+		},
+		"contradictory-status-with-bool": {
+			healthTemp: "isHealth: false",
+			statusTemp: `healthStatus: "Healthy"`,
+			expStatus:  common.HealthStatusUnknown,
+			expErr:     true,
+		},
+		"healthy-bool-with-unhealthy-status": {
+			healthTemp: "isHealth: true",
+			statusTemp: `healthStatus: "Progressing"`,
+			expStatus:  common.HealthStatusUnknown,
+			expErr:     true,
+		},
+		"healthy-bool-with-suspended-status": {
+			healthTemp: "isHealth: true",
+			statusTemp: `healthStatus: "Suspended"`,
+			expHealthy: true,
+			expStatus:  common.HealthStatusSuspended,
+		},
+		"unhealthy-bool-with-suspended-status": {
+			healthTemp: "isHealth: false",
+			statusTemp: `healthStatus: "Suspended"`,
+			expHealthy: false,
+			expStatus:  common.HealthStatusSuspended,
+		},
+		"status-in-health-policy-ignored": {
+			healthTemp: `isHealth: true
+healthStatus: "Degraded"`,
+			expHealthy: true,
+			expStatus:  common.HealthStatusHealthy,
+		},
+		"invalid-status": {
+			statusTemp: `healthStatus: "Broken"`,
+			expStatus:  common.HealthStatusUnknown,
+			expErr:     true,
+		},
+		"missing-status": {
+			statusTemp: `foo: "bar"`,
+			expStatus:  common.HealthStatusUnknown,
+			expErr:     true,
+		},
+		"missing-bool": {
+			healthTemp: `foo: "bar"`,
+			expStatus:  common.HealthStatusUnknown,
+			expErr:     true,
+		},
+	}
+	for message, ca := range cases {
+		healthy, status, err := CheckHealthStatus(tpContext, ca.healthTemp, ca.statusTemp, nil)
+		if ca.expErr {
+			assert.Error(t, err, message)
+		} else {
+			assert.NoError(t, err, message)
+		}
+		assert.Equal(t, ca.expHealthy, healthy, message)
+		assert.Equal(t, ca.expStatus, status, message)
+	}
+}
+
 func TestGetStatusMessage(t *testing.T) {
 	cases := map[string]struct {
 		tpContext  map[string]interface{}
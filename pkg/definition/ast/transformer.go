@@ -28,6 +28,7 @@ const (
 	// status is the path to the status field in the metadata
 	status       = "attributes.status.details"
 	healthPolicy = "attributes.status.healthPolicy"
+	healthStatus = "attributes.status.healthStatus"
 	customStatus = "attributes.status.customStatus"
 	// localFieldPrefix is the prefix for local fields not output to the status
 	localFieldPrefix = "$"
@@ -38,6 +39,9 @@ func EncodeMetadata(field *ast.Field) error {
 	if err := marshalField[*ast.StructLit](field, healthPolicy, validateHealthPolicyField); err != nil {
 		return err
 	}
+	if err := marshalField[*ast.StructLit](field, healthStatus, validateHealthStatusField); err != nil {
+		return err
+	}
 	if err := marshalField[*ast.StructLit](field, customStatus, validateCustomStatusField); err != nil {
 		return err
 	}
@@ -52,6 +56,9 @@ func DecodeMetadata(field *ast.Field) error {
 	if err := unmarshalField[*ast.StructLit](field, healthPolicy, validateHealthPolicyField); err != nil {
 		return err
 	}
+	if err := unmarshalField[*ast.StructLit](field, healthStatus, validateHealthStatusField); err != nil {
+		return err
+	}
 	if err := unmarshalField[*ast.StructLit](field, customStatus, validateCustomStatusField); err != nil {
 		return err
 	}
@@ -204,3 +211,15 @@ func validateHealthPolicyField(sl *ast.StructLit) error {
 
 	return nil
 }
+
+func validateHealthStatusField(sl *ast.StructLit) error {
+	found, err := FindAndValidateField(sl, "healthStatus", nil)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return fmt.Errorf("healthStatus must contain a 'healthStatus' field")
+	}
+
+	return nil
+}
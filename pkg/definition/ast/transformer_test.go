@@ -493,6 +493,70 @@ func TestMarshalAndUnmarshalHealthPolicy(t *testing.T) {
 	}
 }
 
+func TestMarshalAndUnmarshalHealthStatus(t *testing.T) {
+	tests := []struct {
+		name             string
+		input            string
+		expectMarshalErr string
+	}{
+		{
+			name: "valid healthStatus",
+			input: `
+				attributes: {
+					status: {
+						healthStatus: {
+							healthStatus: *"Progressing" | "Suspended"
+							if context.output.spec.paused {
+								healthStatus: "Suspended"
+							}
+						}
+					}
+				}
+			`,
+		},
+		{
+			name: "healthStatus without healthStatus field",
+			input: `
+				attributes: {
+					status: {
+						healthStatus: {
+							phase: context.output.status.phase
+						}
+					}
+				}
+			`,
+			expectMarshalErr: "healthStatus must contain a 'healthStatus' field",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			file, err := parser.ParseFile("-", tt.input)
+			require.NoError(t, err)
+			rootField, ok := file.Decls[0].(*ast.Field)
+			require.True(t, ok)
+
+			err = EncodeMetadata(rootField)
+			if tt.expectMarshalErr != "" {
+				require.ErrorContains(t, err, tt.expectMarshalErr)
+				return
+			}
+			require.NoError(t, err)
+			healthStatusField, ok := GetFieldByPath(rootField, "attributes.status.healthStatus")
+			require.True(t, ok)
+			lit, ok := healthStatusField.Value.(*ast.BasicLit)
+			require.True(t, ok)
+			require.Contains(t, lit.Value, `healthStatus: "Suspended"`)
+
+			require.NoError(t, DecodeMetadata(rootField))
+			healthStatusField, ok = GetFieldByPath(rootField, "attributes.status.healthStatus")
+			require.True(t, ok)
+			_, ok = healthStatusField.Value.(*ast.StructLit)
+			require.True(t, ok)
+		})
+	}
+}
+
 func TestMarshalAndUnmarshalCustomStatus(t *testing.T) {
 	tests := []struct {
 		name               string
@@ -123,6 +123,10 @@ const (
 	// ValidateResourcesExist enables webhook validation to check if resource types referenced in
 	// ComponentDefinition/TraitDefinition/WorkflowStepDefinition/PolicyDefinition CUE templates exist in the cluster
 	ValidateResourcesExist = "ValidateResourcesExist"
+
+	// EnableLoadBalancerHealthCheck enables the built-in health assessment to wait for the load balancer of Service and
+	// Ingress to be provisioned, which never finishes in clusters without load balancer controller
+	EnableLoadBalancerHealthCheck = "EnableLoadBalancerHealthCheck"
 )
 
 var defaultFeatureGates = map[featuregate.Feature]featuregate.FeatureSpec{
@@ -151,6 +155,7 @@ var defaultFeatureGates = map[featuregate.Feature]featuregate.FeatureSpec{
 	EnableCueValidation:                           {Default: false, PreRelease: featuregate.Beta},
 	EnableApplicationStatusMetrics:                {Default: false, PreRelease: featuregate.Alpha},
 	ValidateResourcesExist:                        {Default: false, PreRelease: featuregate.Alpha},
+	EnableLoadBalancerHealthCheck:                 {Default: false, PreRelease: featuregate.Alpha},
 }
 
 func init() {
@@ -0,0 +1,183 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package multicluster
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apitypes "k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/oam-dev/kubevela/apis/types"
+)
+
+const (
+	// clusterGroupConfigMapPrefix the prefix of the name of the configmap storing the cluster group
+	clusterGroupConfigMapPrefix = "cluster-group-"
+	// clusterGroupKeyClusters the key of the static members in the configmap of cluster group
+	clusterGroupKeyClusters = "clusters"
+	// clusterGroupKeyClusterLabelSelector the key of the label selector in the configmap of cluster group
+	clusterGroupKeyClusterLabelSelector = "clusterLabelSelector"
+)
+
+var (
+	// ErrClusterGroupExists cluster group already exists
+	ErrClusterGroupExists = ClusterManagementError(fmt.Errorf("cluster group already exists"))
+	// ErrClusterGroupNotExists cluster group not exists
+	ErrClusterGroupNotExists = ClusterManagementError(fmt.Errorf("no such cluster group"))
+)
+
+// ClusterGroup is a named set of clusters that topology policies can select. The members are the clusters listed
+// in the group and the clusters matching the label selector of the group.
+type ClusterGroup struct {
+	Name                 string            `json:"name"`
+	Clusters             []string          `json:"clusters,omitempty"`
+	ClusterLabelSelector map[string]string `json:"clusterLabelSelector,omitempty"`
+}
+
+// ClusterGroupConfigMapName returns the name of the configmap storing the cluster group
+func ClusterGroupConfigMapName(name string) string {
+	return clusterGroupConfigMapPrefix + name
+}
+
+// NewClusterGroupFromConfigMap parses the cluster group from the configmap
+func NewClusterGroupFromConfigMap(cm *corev1.ConfigMap) (*ClusterGroup, error) {
+	name, ok := cm.GetLabels()[types.LabelClusterGroup]
+	if !ok {
+		return nil, errors.Errorf("configmap %s is not a cluster group", cm.Name)
+	}
+	group := &ClusterGroup{Name: name}
+	if data := cm.Data[clusterGroupKeyClusters]; data != "" {
+		if err := json.Unmarshal([]byte(data), &group.Clusters); err != nil {
+			return nil, errors.Wrapf(err, "invalid clusters in cluster group %s", name)
+		}
+	}
+	if data := cm.Data[clusterGroupKeyClusterLabelSelector]; data != "" {
+		if err := json.Unmarshal([]byte(data), &group.ClusterLabelSelector); err != nil {
+			return nil, errors.Wrapf(err, "invalid cluster label selector in cluster group %s", name)
+		}
+	}
+	return group, nil
+}
+
+// setClusterGroupData writes the members of the cluster group into the configmap
+func setClusterGroupData(cm *corev1.ConfigMap, group *ClusterGroup) error {
+	cm.Data = map[string]string{}
+	if len(group.Clusters) > 0 {
+		bs, err := json.Marshal(group.Clusters)
+		if err != nil {
+			return err
+		}
+		cm.Data[clusterGroupKeyClusters] = string(bs)
+	}
+	if len(group.ClusterLabelSelector) > 0 {
+		bs, err := json.Marshal(group.ClusterLabelSelector)
+		if err != nil {
+			return err
+		}
+		cm.Data[clusterGroupKeyClusterLabelSelector] = string(bs)
+	}
+	return nil
+}
+
+// GetClusterGroup gets the cluster group by name
+func GetClusterGroup(ctx context.Context, cli client.Client, name string) (*ClusterGroup, error) {
+	cm := &corev1.ConfigMap{}
+	if err := cli.Get(ctx, apitypes.NamespacedName{Namespace: ClusterGatewaySecretNamespace, Name: ClusterGroupConfigMapName(name)}, cm); err != nil {
+		if kerrors.IsNotFound(err) {
+			return nil, ErrClusterGroupNotExists
+		}
+		return nil, errors.Wrapf(err, "failed to get cluster group %s", name)
+	}
+	return NewClusterGroupFromConfigMap(cm)
+}
+
+// ListClusterGroups lists all the cluster groups, sorted by names
+func ListClusterGroups(ctx context.Context, cli client.Client) ([]ClusterGroup, error) {
+	cms := &corev1.ConfigMapList{}
+	if err := cli.List(ctx, cms, client.InNamespace(ClusterGatewaySecretNamespace), client.HasLabels{types.LabelClusterGroup}); err != nil {
+		return nil, errors.Wrapf(err, "failed to list cluster groups")
+	}
+	var groups []ClusterGroup
+	for i := range cms.Items {
+		group, err := NewClusterGroupFromConfigMap(&cms.Items[i])
+		if err != nil {
+			return nil, err
+		}
+		groups = append(groups, *group)
+	}
+	sort.Slice(groups, func(i, j int) bool { return groups[i].Name < groups[j].Name })
+	return groups, nil
+}
+
+// CreateClusterGroup creates the cluster group
+func CreateClusterGroup(ctx context.Context, cli client.Client, group *ClusterGroup) error {
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ClusterGroupConfigMapName(group.Name),
+			Namespace: ClusterGatewaySecretNamespace,
+			Labels:    map[string]string{types.LabelClusterGroup: group.Name},
+		},
+	}
+	if err := setClusterGroupData(cm, group); err != nil {
+		return err
+	}
+	if err := cli.Create(ctx, cm); err != nil {
+		if kerrors.IsAlreadyExists(err) {
+			return ErrClusterGroupExists
+		}
+		return errors.Wrapf(err, "failed to create cluster group %s", group.Name)
+	}
+	return nil
+}
+
+// UpdateClusterGroup updates the members of the existing cluster group
+func UpdateClusterGroup(ctx context.Context, cli client.Client, group *ClusterGroup) error {
+	cm := &corev1.ConfigMap{}
+	if err := cli.Get(ctx, apitypes.NamespacedName{Namespace: ClusterGatewaySecretNamespace, Name: ClusterGroupConfigMapName(group.Name)}, cm); err != nil {
+		if kerrors.IsNotFound(err) {
+			return ErrClusterGroupNotExists
+		}
+		return errors.Wrapf(err, "failed to get cluster group %s", group.Name)
+	}
+	if err := setClusterGroupData(cm, group); err != nil {
+		return err
+	}
+	if err := cli.Update(ctx, cm); err != nil {
+		return errors.Wrapf(err, "failed to update cluster group %s", group.Name)
+	}
+	return nil
+}
+
+// DeleteClusterGroup deletes the cluster group
+func DeleteClusterGroup(ctx context.Context, cli client.Client, name string) error {
+	cm := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: ClusterGatewaySecretNamespace, Name: ClusterGroupConfigMapName(name)}}
+	if err := cli.Delete(ctx, cm); err != nil {
+		if kerrors.IsNotFound(err) {
+			return ErrClusterGroupNotExists
+		}
+		return errors.Wrapf(err, "failed to delete cluster group %s", name)
+	}
+	return nil
+}
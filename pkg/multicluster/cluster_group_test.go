@@ -0,0 +1,71 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package multicluster
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apitypes "k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/oam-dev/kubevela/apis/types"
+	velacommon "github.com/oam-dev/kubevela/pkg/utils/common"
+)
+
+func TestClusterGroup(t *testing.T) {
+	oldClusterGatewaySecretNamespace := ClusterGatewaySecretNamespace
+	ClusterGatewaySecretNamespace = "vela-system"
+	defer func() {
+		ClusterGatewaySecretNamespace = oldClusterGatewaySecretNamespace
+	}()
+	r := require.New(t)
+	ctx := context.Background()
+	cli := fake.NewClientBuilder().WithScheme(velacommon.Scheme).Build()
+
+	prod := &ClusterGroup{Name: "prod", Clusters: []string{"cluster-a"}, ClusterLabelSelector: map[string]string{"env": "prod"}}
+	r.NoError(CreateClusterGroup(ctx, cli, prod))
+	r.NoError(CreateClusterGroup(ctx, cli, &ClusterGroup{Name: "dev", ClusterLabelSelector: map[string]string{"env": "dev"}}))
+	r.ErrorIs(CreateClusterGroup(ctx, cli, prod), ErrClusterGroupExists)
+
+	cm := &corev1.ConfigMap{}
+	r.NoError(cli.Get(ctx, apitypes.NamespacedName{Namespace: "vela-system", Name: "cluster-group-prod"}, cm))
+	r.Equal("prod", cm.Labels[types.LabelClusterGroup])
+	r.Equal(`["cluster-a"]`, cm.Data["clusters"])
+
+	group, err := GetClusterGroup(ctx, cli, "prod")
+	r.NoError(err)
+	r.Equal(prod, group)
+
+	group.Clusters = append(group.Clusters, "cluster-b")
+	group.ClusterLabelSelector = nil
+	r.NoError(UpdateClusterGroup(ctx, cli, group))
+	groups, err := ListClusterGroups(ctx, cli)
+	r.NoError(err)
+	r.Equal([]ClusterGroup{
+		{Name: "dev", ClusterLabelSelector: map[string]string{"env": "dev"}},
+		{Name: "prod", Clusters: []string{"cluster-a", "cluster-b"}},
+	}, groups)
+
+	r.NoError(DeleteClusterGroup(ctx, cli, "prod"))
+	_, err = GetClusterGroup(ctx, cli, "prod")
+	r.ErrorIs(err, ErrClusterGroupNotExists)
+	r.ErrorIs(UpdateClusterGroup(ctx, cli, group), ErrClusterGroupNotExists)
+	r.ErrorIs(DeleteClusterGroup(ctx, cli, "prod"), ErrClusterGroupNotExists)
+}
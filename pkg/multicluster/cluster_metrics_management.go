@@ -18,16 +18,36 @@ package multicluster
 
 import (
 	"context"
+	"fmt"
+	"sync"
 	"time"
 
 	"github.com/oam-dev/kubevela/pkg/monitor/metrics"
 
+	"github.com/pkg/errors"
 	"k8s.io/klog/v2"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-// metricsMap records the metrics of clusters
-var metricsMap map[string]*ClusterMetrics
+var (
+	// metricsMap records the metrics of clusters, it is replaced as a whole by each refresh
+	metricsMap   map[string]*ClusterMetrics
+	metricsMapMu sync.RWMutex
+)
+
+// getCachedClusterMetrics returns the metrics of the cluster cached by the cluster metrics manager, nil if not cached
+func getCachedClusterMetrics(clusterName string) *ClusterMetrics {
+	metricsMapMu.RLock()
+	defer metricsMapMu.RUnlock()
+	return metricsMap[clusterName]
+}
+
+// setCachedClusterMetrics replaces the cached metrics of all clusters
+func setCachedClusterMetrics(m map[string]*ClusterMetrics) {
+	metricsMapMu.Lock()
+	defer metricsMapMu.Unlock()
+	metricsMap = m
+}
 
 // ClusterMetricsMgr manage metrics of clusters
 type ClusterMetricsMgr struct {
@@ -75,10 +95,63 @@ func (cmm *ClusterMetricsMgr) Refresh() ([]VirtualCluster, error) {
 		m[cluster.Name] = cm
 		cluster.Metrics = cm
 	}
-	metricsMap = m
+	setCachedClusterMetrics(m)
 	return clusters, nil
 }
 
+// GetClusterMetrics returns the metrics of the cluster cached by the cluster metrics manager. The metrics are unknown
+// if the cluster has not been refreshed by the manager yet, or the manager is not running.
+func GetClusterMetrics(clusterName string) (*ClusterMetrics, error) {
+	if m := getCachedClusterMetrics(clusterName); m != nil {
+		return m, nil
+	}
+	return nil, fmt.Errorf("metrics of cluster %s are unknown", clusterName)
+}
+
+const (
+	// connectionProbeTimeout limits the time to probe the connection of the cluster
+	connectionProbeTimeout = 5 * time.Second
+	// connectionProbeTTL is the time the probed connection state is reused, so that the cluster is not probed by
+	// each reconcile when the cluster metrics manager is not running
+	connectionProbeTTL = 30 * time.Second
+)
+
+type connectionProbe struct {
+	connected bool
+	probeTime time.Time
+}
+
+var (
+	connectionProbes   = map[string]connectionProbe{}
+	connectionProbesMu sync.Mutex
+)
+
+// IsClusterConnected checks whether the cluster is connected. The connection state cached by the cluster
+// metrics manager is used if available, otherwise the cluster is probed with a short timeout and the probed
+// state is reused for a while.
+func IsClusterConnected(ctx context.Context, kubeClient client.Client, clusterName string) bool {
+	if clusterName == ClusterLocalName {
+		return true
+	}
+	if m := getCachedClusterMetrics(clusterName); m != nil {
+		return m.IsConnected
+	}
+	connectionProbesMu.Lock()
+	probe, found := connectionProbes[clusterName]
+	connectionProbesMu.Unlock()
+	if found && time.Since(probe.probeTime) < connectionProbeTTL {
+		return probe.connected
+	}
+	probeCtx, cancel := context.WithTimeout(ctx, connectionProbeTimeout)
+	defer cancel()
+	_, err := GetClusterInfo(probeCtx, kubeClient, clusterName)
+	connected := err == nil || (!IsClusterDisconnect(err) && !errors.Is(err, context.DeadlineExceeded))
+	connectionProbesMu.Lock()
+	connectionProbes[clusterName] = connectionProbe{connected: connected, probeTime: time.Now()}
+	connectionProbesMu.Unlock()
+	return connected
+}
+
 // Start will start polling cluster api to collect metrics
 func (cmm *ClusterMetricsMgr) Start(ctx context.Context) {
 	for {
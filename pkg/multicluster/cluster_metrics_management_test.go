@@ -90,6 +90,37 @@ func TestRefresh(t *testing.T) {
 	exportMetrics(norCluster.Metrics, norCluster.Name)
 }
 
+func TestIsClusterConnected(t *testing.T) {
+	ClusterGatewaySecretNamespace = "default"
+	fakeClient := NewFakeClient(fake.NewClientBuilder().
+		WithScheme(common.Scheme).
+		WithObjects(FakeSecret(NormalClusterName), FakeSecret(DisconnectedClusterName), FakeSecret("unreachable-cluster")).
+		Build())
+	fakeClient.AddCluster(NormalClusterName, fake.NewClientBuilder().WithScheme(common.Scheme).Build())
+	fakeClient.AddCluster(DisconnectedClusterName, &disconnectedClient{})
+	fakeClient.AddCluster("unreachable-cluster", &unreachableClient{})
+	ctx := context.Background()
+
+	setCachedClusterMetrics(nil)
+	connectionProbes = map[string]connectionProbe{}
+	assert.True(t, IsClusterConnected(ctx, fakeClient, ClusterLocalName))
+	assert.True(t, IsClusterConnected(ctx, fakeClient, NormalClusterName))
+	// errors other than the connection ones do not mean disconnected
+	assert.True(t, IsClusterConnected(ctx, fakeClient, DisconnectedClusterName))
+	assert.False(t, IsClusterConnected(ctx, fakeClient, "unreachable-cluster"))
+
+	// the probed connection state is reused until it expires
+	fakeClient.AddCluster(NormalClusterName, &unreachableClient{})
+	assert.True(t, IsClusterConnected(ctx, fakeClient, NormalClusterName))
+	connectionProbes[NormalClusterName] = connectionProbe{connected: true, probeTime: time.Now().Add(-connectionProbeTTL)}
+	assert.False(t, IsClusterConnected(ctx, fakeClient, NormalClusterName))
+
+	// the cached connection state is used if available
+	setCachedClusterMetrics(map[string]*ClusterMetrics{DisconnectedClusterName: {IsConnected: false}})
+	defer setCachedClusterMetrics(nil)
+	assert.False(t, IsClusterConnected(ctx, fakeClient, DisconnectedClusterName))
+}
+
 func assertClusterMetrics(t *testing.T, cluster *VirtualCluster) {
 	metrics := cluster.Metrics
 	switch cluster.Name {
@@ -159,3 +190,11 @@ type disconnectedClient struct {
 func (cli *disconnectedClient) List(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
 	return errors.New("no such host")
 }
+
+type unreachableClient struct {
+	client.Client
+}
+
+func (cli *unreachableClient) List(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+	return errors.New("dial tcp 10.0.0.1:6443: i/o timeout")
+}
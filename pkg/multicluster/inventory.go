@@ -0,0 +1,198 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package multicluster
+
+import (
+	"context"
+	"sort"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/client-go/rest"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1beta1"
+	"github.com/oam-dev/kubevela/pkg/oam"
+)
+
+// annotationDefaultStorageClass marks the default storage class of the cluster
+const annotationDefaultStorageClass = "storageclass.kubernetes.io/is-default-class"
+
+// ClusterInventory the inventory report of a cluster, including the connectivity, the capacity and the
+// applications placed on the cluster
+type ClusterInventory struct {
+	Name      string            `json:"name"`
+	Alias     string            `json:"alias,omitempty"`
+	Type      string            `json:"type"`
+	Endpoint  string            `json:"endpoint,omitempty"`
+	Labels    map[string]string `json:"labels,omitempty"`
+	Taints    []corev1.Taint    `json:"taints,omitempty"`
+	Connected bool              `json:"connected"`
+	// Message the reason why the cluster is not connected
+	Message        string                `json:"message,omitempty"`
+	Version        string                `json:"version,omitempty"`
+	WorkerNumber   int                   `json:"workerNumber"`
+	MasterNumber   int                   `json:"masterNumber"`
+	Capacity       *ClusterResources     `json:"capacity,omitempty"`
+	Allocatable    *ClusterResources     `json:"allocatable,omitempty"`
+	Usage          *ClusterResources     `json:"usage,omitempty"`
+	StorageClasses []ClusterStorageClass `json:"storageClasses,omitempty"`
+	Applications   []ClusterApplication  `json:"applications,omitempty"`
+}
+
+// ClusterResources the amount of the resources in a cluster
+type ClusterResources struct {
+	CPU    resource.Quantity  `json:"cpu"`
+	Memory resource.Quantity  `json:"memory"`
+	Pods   *resource.Quantity `json:"pods,omitempty"`
+}
+
+// ClusterStorageClass the storage class in a cluster
+type ClusterStorageClass struct {
+	Name        string `json:"name"`
+	Provisioner string `json:"provisioner"`
+	Default     bool   `json:"default,omitempty"`
+}
+
+// ClusterApplication the application placed on a cluster, with the components dispatched to the cluster
+type ClusterApplication struct {
+	Name       string   `json:"name"`
+	Namespace  string   `json:"namespace"`
+	Components []string `json:"components,omitempty"`
+	// Resources the number of the resources dispatched to the cluster
+	Resources int `json:"resources"`
+}
+
+// GetClusterInventory collects the inventory report of the cluster. The cluster is reported as disconnected instead
+// of returning the error if it can not be reached. The version is requested with the rest config if it is given,
+// otherwise the version recorded when joining the cluster is used.
+func GetClusterInventory(ctx context.Context, cli client.Client, cfg *rest.Config, clusterName string) (*ClusterInventory, error) {
+	vc, err := GetVirtualCluster(ctx, cli, clusterName)
+	if err != nil {
+		return nil, err
+	}
+	inventory := &ClusterInventory{
+		Name:     vc.Name,
+		Alias:    vc.Alias,
+		Type:     string(vc.Type),
+		Endpoint: vc.EndPoint,
+		Labels:   vc.Labels,
+		Taints:   vc.Taints,
+	}
+	info, err := GetClusterInfo(ctx, cli, clusterName)
+	if err != nil {
+		inventory.Message = err.Error()
+	} else {
+		inventory.Connected = true
+		inventory.WorkerNumber = info.WorkerNumber
+		inventory.MasterNumber = info.MasterNumber
+		inventory.Capacity = &ClusterResources{CPU: info.CPUCapacity, Memory: info.MemoryCapacity, Pods: &info.PodCapacity}
+		inventory.Allocatable = &ClusterResources{CPU: info.CPUAllocatable, Memory: info.MemoryAllocatable, Pods: &info.PodAllocatable}
+		for _, sc := range info.StorageClasses.Items {
+			inventory.StorageClasses = append(inventory.StorageClasses, ClusterStorageClass{
+				Name:        sc.Name,
+				Provisioner: sc.Provisioner,
+				Default:     sc.Annotations[annotationDefaultStorageClass] == "true",
+			})
+		}
+		if usage, err := GetClusterMetricsFromMetricsAPI(ctx, cli, clusterName); err == nil {
+			inventory.Usage = &ClusterResources{CPU: usage.CPUUsage, Memory: usage.MemoryUsage}
+		} else {
+			klog.V(4).Infof("the usage of the cluster %s is not available: %s", clusterName, err.Error())
+		}
+	}
+	if inventory.Connected && cfg != nil {
+		if version, err := GetVersionInfoFromCluster(ctx, clusterName, cfg); err == nil {
+			inventory.Version = version.GitVersion
+		}
+	}
+	if inventory.Version == "" && vc.Object != nil {
+		if version, err := getClusterVersionFromObject(vc.Object); err == nil {
+			inventory.Version = version.GitVersion
+		}
+	}
+	if inventory.Applications, err = ListClusterApplications(ctx, cli, clusterName); err != nil {
+		return nil, err
+	}
+	return inventory, nil
+}
+
+// ListClusterApplications lists the applications placed on the cluster from the ResourceTrackers. Only the resources
+// tracked by the root and the latest versioned ResourceTracker of each application are counted, the resources of the
+// outdated versions waiting for the garbage collection are not.
+func ListClusterApplications(ctx context.Context, cli client.Client, clusterName string) ([]ClusterApplication, error) {
+	rts := &v1beta1.ResourceTrackerList{}
+	if err := cli.List(ctx, rts); err != nil {
+		return nil, errors.Wrapf(err, "failed to list resource trackers")
+	}
+	type appKey struct{ namespace, name string }
+	tracked := map[appKey][]*v1beta1.ResourceTracker{}
+	latest := map[appKey]*v1beta1.ResourceTracker{}
+	for i := range rts.Items {
+		rt := &rts.Items[i]
+		key := appKey{namespace: rt.GetLabels()[oam.LabelAppNamespace], name: rt.GetLabels()[oam.LabelAppName]}
+		if key.name == "" {
+			continue
+		}
+		switch rt.Spec.Type {
+		case v1beta1.ResourceTrackerTypeRoot:
+			tracked[key] = append(tracked[key], rt)
+		case v1beta1.ResourceTrackerTypeVersioned:
+			if cur, ok := latest[key]; !ok || rt.Spec.ApplicationGeneration > cur.Spec.ApplicationGeneration {
+				latest[key] = rt
+			}
+		default:
+		}
+	}
+	for key, rt := range latest {
+		tracked[key] = append(tracked[key], rt)
+	}
+	var apps []ClusterApplication
+	for key, rts := range tracked {
+		app := ClusterApplication{Name: key.name, Namespace: key.namespace}
+		components := map[string]struct{}{}
+		for _, rt := range rts {
+			for _, mr := range rt.Spec.ManagedResources {
+				cluster := mr.Cluster
+				if cluster == "" {
+					cluster = ClusterLocalName
+				}
+				if mr.Deleted || cluster != clusterName {
+					continue
+				}
+				app.Resources++
+				if _, found := components[mr.Component]; !found && mr.Component != "" {
+					components[mr.Component] = struct{}{}
+					app.Components = append(app.Components, mr.Component)
+				}
+			}
+		}
+		if app.Resources > 0 {
+			sort.Strings(app.Components)
+			apps = append(apps, app)
+		}
+	}
+	sort.Slice(apps, func(i, j int) bool {
+		if apps[i].Namespace != apps[j].Namespace {
+			return apps[i].Namespace < apps[j].Namespace
+		}
+		return apps[i].Name < apps[j].Name
+	})
+	return apps, nil
+}
@@ -0,0 +1,167 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package multicluster
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/oam-dev/cluster-gateway/pkg/apis/cluster/v1alpha1"
+	clustercommon "github.com/oam-dev/cluster-gateway/pkg/common"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/common"
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1beta1"
+	"github.com/oam-dev/kubevela/pkg/oam"
+	velacommon "github.com/oam-dev/kubevela/pkg/utils/common"
+)
+
+func newInventoryResourceTracker(name, app string, rtType v1beta1.ResourceTrackerType, generation int64, resources ...v1beta1.ManagedResource) *v1beta1.ResourceTracker {
+	return &v1beta1.ResourceTracker{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: map[string]string{oam.LabelAppName: app, oam.LabelAppNamespace: "default"},
+		},
+		Spec: v1beta1.ResourceTrackerSpec{Type: rtType, ApplicationGeneration: generation, ManagedResources: resources},
+	}
+}
+
+func newInventoryManagedResource(cluster, component, name string) v1beta1.ManagedResource {
+	return v1beta1.ManagedResource{
+		ClusterObjectReference: common.ClusterObjectReference{
+			Cluster:         cluster,
+			ObjectReference: corev1.ObjectReference{APIVersion: "apps/v1", Kind: "Deployment", Namespace: "default", Name: name},
+		},
+		OAMObjectReference: common.OAMObjectReference{Component: component},
+	}
+}
+
+func TestGetClusterInventory(t *testing.T) {
+	oldClusterGatewaySecretNamespace := ClusterGatewaySecretNamespace
+	ClusterGatewaySecretNamespace = "default"
+	defer func() {
+		ClusterGatewaySecretNamespace = oldClusterGatewaySecretNamespace
+	}()
+	r := require.New(t)
+	ctx := context.Background()
+
+	deleted := newInventoryManagedResource("prod", "web", "old-web")
+	deleted.Deleted = true
+	hub := fake.NewClientBuilder().WithScheme(velacommon.Scheme).WithObjects(
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "prod",
+				Namespace: "default",
+				Labels: map[string]string{
+					clustercommon.LabelKeyClusterCredentialType: string(v1alpha1.CredentialTypeX509Certificate),
+					"region": "us",
+				},
+				Annotations: map[string]string{v1alpha1.AnnotationClusterAlias: "production"},
+			},
+			Data: map[string][]byte{"endpoint": []byte("https://prod:6443")},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "dev",
+				Namespace: "default",
+				Labels: map[string]string{
+					clustercommon.LabelKeyClusterCredentialType: string(v1alpha1.CredentialTypeX509Certificate),
+				},
+			},
+		},
+		newInventoryResourceTracker("app1-default", "app1", v1beta1.ResourceTrackerTypeRoot, 0,
+			newInventoryManagedResource("prod", "db", "db")),
+		newInventoryResourceTracker("app1-v1-default", "app1", v1beta1.ResourceTrackerTypeVersioned, 1,
+			newInventoryManagedResource("prod", "web", "web-v1"), newInventoryManagedResource("prod", "worker", "worker")),
+		newInventoryResourceTracker("app1-v2-default", "app1", v1beta1.ResourceTrackerTypeVersioned, 2,
+			newInventoryManagedResource("prod", "web", "web"), newInventoryManagedResource("", "web", "web"), deleted),
+		newInventoryResourceTracker("app2-v1-default", "app2", v1beta1.ResourceTrackerTypeVersioned, 1,
+			newInventoryManagedResource("local", "api", "api")),
+	).Build()
+
+	node := func(name string, master bool) *corev1.Node {
+		n := &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{}},
+			Status: corev1.NodeStatus{
+				Capacity: corev1.ResourceList{
+					corev1.ResourceCPU: resource.MustParse("4"), corev1.ResourceMemory: resource.MustParse("8Gi"), corev1.ResourcePods: resource.MustParse("110"),
+				},
+				Allocatable: corev1.ResourceList{
+					corev1.ResourceCPU: resource.MustParse("3"), corev1.ResourceMemory: resource.MustParse("7Gi"), corev1.ResourcePods: resource.MustParse("100"),
+				},
+			},
+		}
+		if master {
+			n.Labels["node-role.kubernetes.io/master"] = ""
+		}
+		return n
+	}
+	prod := fake.NewClientBuilder().WithScheme(velacommon.Scheme).WithObjects(
+		node("master", true), node("worker", false),
+		&storagev1.StorageClass{
+			ObjectMeta:  metav1.ObjectMeta{Name: "standard", Annotations: map[string]string{annotationDefaultStorageClass: "true"}},
+			Provisioner: "rancher.io/local-path",
+		},
+	).Build()
+	dev := fake.NewClientBuilder().WithScheme(velacommon.Scheme).WithInterceptorFuncs(interceptor.Funcs{
+		List: func(ctx context.Context, client client.WithWatch, list client.ObjectList, opts ...client.ListOption) error {
+			return errors.New("cluster dev is unreachable")
+		},
+	}).Build()
+	cli := NewFakeClient(hub)
+	cli.AddCluster("prod", prod)
+	cli.AddCluster("dev", dev)
+
+	inventory, err := GetClusterInventory(ctx, cli, nil, "prod")
+	r.NoError(err)
+	r.True(inventory.Connected)
+	r.Equal("production", inventory.Alias)
+	r.Equal("https://prod:6443", inventory.Endpoint)
+	r.Equal(1, inventory.MasterNumber)
+	r.Equal(1, inventory.WorkerNumber)
+	r.Equal("8", inventory.Capacity.CPU.String())
+	r.Equal("14Gi", inventory.Allocatable.Memory.String())
+	r.Equal("200", inventory.Allocatable.Pods.String())
+	r.True(inventory.Usage.CPU.IsZero())
+	r.Equal([]ClusterStorageClass{{Name: "standard", Provisioner: "rancher.io/local-path", Default: true}}, inventory.StorageClasses)
+	r.Equal([]ClusterApplication{{Name: "app1", Namespace: "default", Components: []string{"db", "web"}, Resources: 2}}, inventory.Applications)
+
+	inventory, err = GetClusterInventory(ctx, cli, nil, "dev")
+	r.NoError(err)
+	r.False(inventory.Connected)
+	r.Contains(inventory.Message, "cluster dev is unreachable")
+	r.Nil(inventory.Capacity)
+	r.Empty(inventory.Applications)
+
+	apps, err := ListClusterApplications(ctx, cli, ClusterLocalName)
+	r.NoError(err)
+	r.Equal([]ClusterApplication{
+		{Name: "app1", Namespace: "default", Components: []string{"web"}, Resources: 1},
+		{Name: "app2", Namespace: "default", Components: []string{"api"}, Resources: 1},
+	}, apps)
+
+	_, err = GetClusterInventory(ctx, cli, nil, "not-exist")
+	r.Error(err)
+}
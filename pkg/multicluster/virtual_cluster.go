@@ -82,6 +82,7 @@ type VirtualCluster struct {
 	EndPoint string
 	Accepted bool
 	Labels   map[string]string
+	Taints   []corev1.Taint
 	Metrics  *ClusterMetrics
 	Object   client.Object
 }
@@ -110,6 +111,48 @@ func setClusterAlias(o client.Object, alias string) {
 	o.SetAnnotations(annots)
 }
 
+// GetClusterTaints returns the taints recorded in the annotation of the cluster secret or managed cluster
+func GetClusterTaints(o client.Object) ([]corev1.Taint, error) {
+	raw, found := o.GetAnnotations()[types.AnnotationClusterTaints]
+	if !found || raw == "" {
+		return nil, nil
+	}
+	var taints []corev1.Taint
+	if err := json.Unmarshal([]byte(raw), &taints); err != nil {
+		return nil, errors.Wrapf(err, "invalid taints of cluster %s", o.GetName())
+	}
+	return taints, nil
+}
+
+// SetClusterTaints records the taints in the annotation of the cluster secret or managed cluster,
+// the annotation is removed if there is no taint
+func SetClusterTaints(o client.Object, taints []corev1.Taint) error {
+	annots := o.GetAnnotations()
+	if len(taints) == 0 {
+		delete(annots, types.AnnotationClusterTaints)
+		o.SetAnnotations(annots)
+		return nil
+	}
+	content, err := json.Marshal(taints)
+	if err != nil {
+		return err
+	}
+	if annots == nil {
+		annots = map[string]string{}
+	}
+	annots[types.AnnotationClusterTaints] = string(content)
+	o.SetAnnotations(annots)
+	return nil
+}
+
+func getClusterTaints(o client.Object) []corev1.Taint {
+	taints, err := GetClusterTaints(o)
+	if err != nil {
+		klog.Warningf("ignore the taints of cluster %s: %v", o.GetName(), err)
+	}
+	return taints
+}
+
 // NewVirtualClusterFromLocal return virtual cluster corresponding to local cluster
 func NewVirtualClusterFromLocal() *VirtualCluster {
 	return &VirtualCluster{
@@ -118,7 +161,7 @@ func NewVirtualClusterFromLocal() *VirtualCluster {
 		EndPoint: types.ClusterBlankEndpoint,
 		Accepted: true,
 		Labels:   map[string]string{},
-		Metrics:  metricsMap[ClusterLocalName],
+		Metrics:  getCachedClusterMetrics(ClusterLocalName),
 	}
 }
 
@@ -143,7 +186,8 @@ func NewVirtualClusterFromSecret(secret *corev1.Secret) (*VirtualCluster, error)
 		EndPoint: endpoint,
 		Accepted: true,
 		Labels:   labels,
-		Metrics:  metricsMap[secret.Name],
+		Taints:   getClusterTaints(secret),
+		Metrics:  getCachedClusterMetrics(secret.Name),
 		Object:   secret,
 	}, nil
 }
@@ -160,7 +204,8 @@ func NewVirtualClusterFromManagedCluster(managedCluster *clusterv1.ManagedCluste
 		EndPoint: types.ClusterBlankEndpoint,
 		Accepted: managedCluster.Spec.HubAcceptsClient,
 		Labels:   managedCluster.GetLabels(),
-		Metrics:  metricsMap[managedCluster.Name],
+		Taints:   getClusterTaints(managedCluster),
+		Metrics:  getCachedClusterMetrics(managedCluster.Name),
 		Object:   managedCluster,
 	}, nil
 }
@@ -209,6 +209,26 @@ var _ = Describe("Test Virtual Cluster", func() {
 		secretWithEmptyAnnotation := &v1.Secret{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{}}}
 		_, err = getClusterVersionFromObject(secretWithEmptyAnnotation)
 		Expect(err).ToNot(Succeed())
+
+		By("Test get/set cluster taints")
+		taintedSecret := &v1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "tainted", Labels: map[string]string{
+			clustercommon.LabelKeyClusterCredentialType: string(v1alpha1.CredentialTypeX509Certificate),
+		}}}
+		taints := []v1.Taint{{Key: "maintenance", Value: "true", Effect: v1.TaintEffectNoExecute}}
+		Expect(SetClusterTaints(taintedSecret, taints)).To(Succeed())
+		Expect(GetClusterTaints(taintedSecret)).To(Equal(taints))
+		vc, err = NewVirtualClusterFromSecret(taintedSecret)
+		Expect(err).To(Succeed())
+		Expect(vc.Taints).To(Equal(taints))
+		Expect(SetClusterTaints(taintedSecret, nil)).To(Succeed())
+		Expect(taintedSecret.GetAnnotations()).ToNot(HaveKey(types.AnnotationClusterTaints))
+		Expect(GetClusterTaints(taintedSecret)).To(BeEmpty())
+		taintedSecret.SetAnnotations(map[string]string{types.AnnotationClusterTaints: "invalid"})
+		_, err = GetClusterTaints(taintedSecret)
+		Expect(err).ToNot(Succeed())
+		vc, err = NewVirtualClusterFromSecret(taintedSecret)
+		Expect(err).To(Succeed())
+		Expect(vc.Taints).To(BeEmpty())
 	})
 
 	It("Test GetVersionInfoFromObject", func() {
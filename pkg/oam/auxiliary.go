@@ -17,6 +17,7 @@ limitations under the License.
 package oam
 
 import (
+	"encoding/json"
 	"time"
 
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
@@ -80,6 +81,35 @@ func SetPublishVersion(o client.Object, publishVersion string) {
 	o.SetAnnotations(annotations)
 }
 
+// GetPlacementRevisions get the revisions pinned to the clusters from object
+func GetPlacementRevisions(o client.Object) (map[string]string, error) {
+	revisions := map[string]string{}
+	if data := o.GetAnnotations()[AnnotationPlacementRevisions]; data != "" {
+		if err := json.Unmarshal([]byte(data), &revisions); err != nil {
+			return nil, err
+		}
+	}
+	return revisions, nil
+}
+
+// SetPlacementRevisions set the revisions pinned to the clusters for object, the annotation is removed if empty
+func SetPlacementRevisions(o client.Object, revisions map[string]string) error {
+	annotations := o.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	delete(annotations, AnnotationPlacementRevisions)
+	if len(revisions) > 0 {
+		data, err := json.Marshal(revisions)
+		if err != nil {
+			return err
+		}
+		annotations[AnnotationPlacementRevisions] = string(data)
+	}
+	o.SetAnnotations(annotations)
+	return nil
+}
+
 // GetControllerRequirement get ControllerRequirement from object
 func GetControllerRequirement(o client.Object) string {
 	if annotations := o.GetAnnotations(); annotations != nil {
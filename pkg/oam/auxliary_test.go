@@ -64,6 +64,26 @@ func TestOAMAuxiliary(t *testing.T) {
 		r.Equal(deployVersion, GetDeployVersion(deploy))
 	})
 
+	t.Run("PlacementRevisionsAnnotation", func(t *testing.T) {
+		r := require.New(t)
+		deploy := &appsv1.Deployment{}
+		revisions, err := GetPlacementRevisions(deploy)
+		r.NoError(err)
+		r.Empty(revisions)
+
+		r.NoError(SetPlacementRevisions(deploy, map[string]string{"eu-1": "app-v1"}))
+		r.Equal(`{"eu-1":"app-v1"}`, deploy.GetAnnotations()[AnnotationPlacementRevisions])
+		revisions, err = GetPlacementRevisions(deploy)
+		r.NoError(err)
+		r.Equal(map[string]string{"eu-1": "app-v1"}, revisions)
+
+		r.NoError(SetPlacementRevisions(deploy, nil))
+		r.NotContains(deploy.GetAnnotations(), AnnotationPlacementRevisions)
+		deploy.SetAnnotations(map[string]string{AnnotationPlacementRevisions: "invalid"})
+		_, err = GetPlacementRevisions(deploy)
+		r.Error(err)
+	})
+
 	t.Run("LastAppliedTimeAnnotation", func(t *testing.T) {
 		r := require.New(t)
 		fixedTime := time.Now().Truncate(time.Second)
@@ -197,6 +197,18 @@ const (
 
 	// AnnotationSkipResume annotation indicates that the resource does not need to be resumed.
 	AnnotationSkipResume = "controller.core.oam.dev/skip-resume"
+
+	// AnnotationPlacementRevisions records the application revisions pinned to the clusters rolled back separately,
+	// the value is the json of the map from the cluster to the revision.
+	AnnotationPlacementRevisions = "app.oam.dev/placement-revisions"
+
+	// AnnotationOperationRecord records the latest workflow operation on the application, the value is the json of the
+	// operation record, and the user and groups in it are stamped by the application webhook.
+	AnnotationOperationRecord = "app.oam.dev/operation-record"
+
+	// AnnotationWorkflowParameterSchema declares the parameters of the workflow referenced by applications, the value
+	// is the CUE schema of the parameter fields, such as `cluster: string, timeout: *"10m" | string`.
+	AnnotationWorkflowParameterSchema = "app.oam.dev/workflow-parameter-schema"
 )
 
 const (
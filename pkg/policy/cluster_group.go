@@ -0,0 +1,161 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policy
+
+import (
+	"context"
+	"slices"
+	"sort"
+
+	clusterv1alpha1 "github.com/oam-dev/cluster-gateway/pkg/apis/cluster/v1alpha1"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1alpha1"
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1beta1"
+	"github.com/oam-dev/kubevela/pkg/multicluster"
+	"github.com/oam-dev/kubevela/pkg/utils"
+)
+
+// ClusterGroupChange is the change of the members of the cluster group selected by a topology policy, compared
+// with the members recorded when the components are placed
+type ClusterGroupChange struct {
+	Policy  string
+	Group   string
+	Added   []string
+	Removed []string
+}
+
+// resolveClusterGroup returns the clusters listed in the cluster group and the clusters matching the label selector
+// of the cluster group
+func resolveClusterGroup(ctx context.Context, cli client.Client, name string) (listed []clusterv1alpha1.VirtualCluster, selected []clusterv1alpha1.VirtualCluster, err error) {
+	group, err := multicluster.GetClusterGroup(ctx, cli, name)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to get cluster group %s", name)
+	}
+	for _, cluster := range group.Clusters {
+		vc, err := multicluster.NewClusterClient(cli).Get(ctx, cluster)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "failed to get cluster %s in cluster group %s", cluster, name)
+		}
+		listed = append(listed, *vc)
+	}
+	if len(group.ClusterLabelSelector) > 0 {
+		clusterList, err := multicluster.NewClusterClient(cli).List(ctx, client.MatchingLabels(group.ClusterLabelSelector))
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "failed to find clusters in cluster group %s", name)
+		}
+		selected = clusterList.Items
+	}
+	return listed, selected, nil
+}
+
+// clusterGroupMembers returns the sorted names of the members of the cluster group
+func clusterGroupMembers(listed, selected []clusterv1alpha1.VirtualCluster) []string {
+	members := make([]string, 0, len(listed)+len(selected))
+	for _, clusters := range [][]clusterv1alpha1.VirtualCluster{listed, selected} {
+		for _, cluster := range clusters {
+			if !slices.Contains(members, cluster.Name) {
+				members = append(members, cluster.Name)
+			}
+		}
+	}
+	sort.Strings(members)
+	return members
+}
+
+// recordClusterGroupMembers records the members of the cluster group in the status of the topology policy, so
+// that the changes of the cluster group can be found after the components are placed
+func recordClusterGroupMembers(app *v1beta1.Application, policyName string, members []string) error {
+	status, err := getTopologyPolicyStatus(app, policyName)
+	if err != nil {
+		return err
+	}
+	if status == nil {
+		status = &v1alpha1.TopologyPolicyStatus{}
+	}
+	status.GroupMembers = members
+	return setTopologyPolicyStatus(app, policyName, status)
+}
+
+// HasTopologyClusterGroup checks if any topology policy selects the cluster group. If the group is empty, any
+// cluster group is matched.
+func HasTopologyClusterGroup(policies []v1beta1.AppPolicy, group string) bool {
+	groups := GetTopologyClusterGroups(policies)
+	return len(groups) > 0 && (group == "" || slices.Contains(groups, group))
+}
+
+// GetTopologyClusterGroups returns the cluster groups selected by the topology policies
+func GetTopologyClusterGroups(policies []v1beta1.AppPolicy) []string {
+	var groups []string
+	for _, policy := range policies {
+		if policy.Type != v1alpha1.TopologyPolicyType || policy.Properties == nil {
+			continue
+		}
+		topologySpec := &v1alpha1.TopologyPolicySpec{}
+		if err := utils.StrictUnmarshal(policy.Properties.Raw, topologySpec); err == nil && topologySpec.ClusterGroup != "" &&
+			!slices.Contains(groups, topologySpec.ClusterGroup) {
+			groups = append(groups, topologySpec.ClusterGroup)
+		}
+	}
+	return groups
+}
+
+// GetClusterGroupChanges compares the current members of the cluster groups selected by the topology policies with
+// the members recorded in the status of the application. The topology policies not placed yet are skipped.
+func GetClusterGroupChanges(ctx context.Context, cli client.Client, app *v1beta1.Application) ([]ClusterGroupChange, error) {
+	var changes []ClusterGroupChange
+	for _, policy := range app.Spec.Policies {
+		if policy.Type != v1alpha1.TopologyPolicyType || policy.Properties == nil {
+			continue
+		}
+		topologySpec := &v1alpha1.TopologyPolicySpec{}
+		if err := utils.StrictUnmarshal(policy.Properties.Raw, topologySpec); err != nil {
+			return nil, errors.Wrapf(err, "failed to parse topology policy %s", policy.Name)
+		}
+		if topologySpec.ClusterGroup == "" {
+			continue
+		}
+		status, err := getTopologyPolicyStatus(app, policy.Name)
+		if err != nil {
+			return nil, err
+		}
+		if status == nil {
+			continue
+		}
+		listed, selected, err := resolveClusterGroup(ctx, cli, topologySpec.ClusterGroup)
+		if err != nil {
+			return nil, err
+		}
+		change := ClusterGroupChange{Policy: policy.Name, Group: topologySpec.ClusterGroup}
+		members := clusterGroupMembers(listed, selected)
+		for _, member := range members {
+			if !slices.Contains(status.GroupMembers, member) {
+				change.Added = append(change.Added, member)
+			}
+		}
+		for _, member := range status.GroupMembers {
+			if !slices.Contains(members, member) {
+				change.Removed = append(change.Removed, member)
+			}
+		}
+		if len(change.Added) > 0 || len(change.Removed) > 0 {
+			changes = append(changes, change)
+		}
+	}
+	return changes, nil
+}
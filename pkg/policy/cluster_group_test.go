@@ -0,0 +1,142 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1beta1"
+	"github.com/oam-dev/kubevela/apis/types"
+	"github.com/oam-dev/kubevela/pkg/multicluster"
+	"github.com/oam-dev/kubevela/pkg/utils/common"
+)
+
+func TestTopologyClusterGroup(t *testing.T) {
+	multicluster.ClusterGatewaySecretNamespace = types.DefaultKubeVelaNS
+	noSchedule := corev1.Taint{Key: "gpu", Effect: corev1.TaintEffectNoSchedule}
+	cli := fake.NewClientBuilder().WithScheme(common.Scheme).WithObjects(
+		newTaintedTestCluster(t, "cluster-a", "us"),
+		newTaintedTestCluster(t, "cluster-b", "us", noSchedule),
+		newTaintedTestCluster(t, "cluster-c", "eu", noSchedule),
+		newTaintedTestCluster(t, "cluster-d", "eu"),
+	).Build()
+	ctx := context.Background()
+	require.NoError(t, multicluster.CreateClusterGroup(ctx, cli, &multicluster.ClusterGroup{
+		Name:                 "prod",
+		Clusters:             []string{"cluster-c", "cluster-a"},
+		ClusterLabelSelector: map[string]string{"region": "us"},
+	}))
+	require.NoError(t, multicluster.CreateClusterGroup(ctx, cli, &multicluster.ClusterGroup{
+		Name:                 "ap",
+		ClusterLabelSelector: map[string]string{"region": "ap"},
+	}))
+
+	testCases := map[string]struct {
+		properties  string
+		expected    []string
+		expectedErr string
+	}{
+		"listed and selected members": {
+			properties: `{"clusterGroup":"prod"}`,
+			expected:   []string{"cluster-c", "cluster-a"},
+		},
+		"selected members tolerated": {
+			properties: `{"clusterGroup":"prod","tolerations":[{"key":"gpu","operator":"Exists"}]}`,
+			expected:   []string{"cluster-c", "cluster-a", "cluster-b"},
+		},
+		"members picked by strategy": {
+			properties: `{"clusterGroup":"prod","strategy":{"count":1}}`,
+			expected:   []string{"cluster-a"},
+		},
+		"empty group": {
+			properties:  `{"clusterGroup":"ap"}`,
+			expectedErr: "failed to find any available cluster in cluster group ap",
+		},
+		"empty group with allowEmpty": {
+			properties: `{"clusterGroup":"ap","allowEmpty":true}`,
+			expected:   nil,
+		},
+		"group not exists": {
+			properties:  `{"clusterGroup":"dev"}`,
+			expectedErr: "failed to get cluster group dev",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			r := require.New(t)
+			placements, err := GetPlacementsFromTopologyPolicies(ctx, cli, "default", topologyPolicy(tc.properties), true, nil)
+			if tc.expectedErr != "" {
+				r.ErrorContains(err, tc.expectedErr)
+				return
+			}
+			r.NoError(err)
+			r.Equal(tc.expected, placementClusters(placements))
+		})
+	}
+}
+
+func TestGetClusterGroupChanges(t *testing.T) {
+	multicluster.ClusterGatewaySecretNamespace = types.DefaultKubeVelaNS
+	r := require.New(t)
+	cli := fake.NewClientBuilder().WithScheme(common.Scheme).WithObjects(
+		newPlacementTestCluster("cluster-a", "us"),
+		newPlacementTestCluster("cluster-b", "us"),
+		newPlacementTestCluster("cluster-c", "eu"),
+	).Build()
+	ctx := context.Background()
+	group := &multicluster.ClusterGroup{Name: "prod", Clusters: []string{"cluster-a", "cluster-b"}}
+	r.NoError(multicluster.CreateClusterGroup(ctx, cli, group))
+	app := &v1beta1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "default"},
+		Spec:       v1beta1.ApplicationSpec{Policies: topologyPolicy(`{"clusterGroup":"prod"}`)},
+	}
+	r.True(HasTopologyClusterGroup(app.Spec.Policies, ""))
+	r.True(HasTopologyClusterGroup(app.Spec.Policies, "prod"))
+	r.False(HasTopologyClusterGroup(app.Spec.Policies, "dev"))
+	r.Equal([]string{"prod"}, GetTopologyClusterGroups(app.Spec.Policies))
+
+	// the topology is not placed yet
+	changes, err := GetClusterGroupChanges(ctx, cli, app)
+	r.NoError(err)
+	r.Empty(changes)
+
+	_, err = GetPlacementsFromTopologyPolicies(ctx, cli, app.Namespace, app.Spec.Policies, true, app)
+	r.NoError(err)
+	changes, err = GetClusterGroupChanges(ctx, cli, app)
+	r.NoError(err)
+	r.Empty(changes)
+
+	group.Clusters = []string{"cluster-a"}
+	group.ClusterLabelSelector = map[string]string{"region": "eu"}
+	r.NoError(multicluster.UpdateClusterGroup(ctx, cli, group))
+	changes, err = GetClusterGroupChanges(ctx, cli, app)
+	r.NoError(err)
+	r.Equal([]ClusterGroupChange{{Policy: "topology-policy", Group: "prod", Added: []string{"cluster-c"}, Removed: []string{"cluster-b"}}}, changes)
+
+	placements, err := GetPlacementsFromTopologyPolicies(ctx, cli, app.Namespace, app.Spec.Policies, true, app)
+	r.NoError(err)
+	r.Equal([]string{"cluster-a", "cluster-c"}, placementClusters(placements))
+	changes, err = GetClusterGroupChanges(ctx, cli, app)
+	r.NoError(err)
+	r.Empty(changes)
+}
@@ -31,7 +31,11 @@ type typer[T any] interface {
 	Type() string
 }
 
-// ParsePolicy parse policy for the given type
+type validator interface {
+	Validate() error
+}
+
+// ParsePolicy parse policy for the given type, the policy is validated if it implements Validate
 func ParsePolicy[T any, P typer[T]](app *v1beta1.Application) (*T, error) {
 	base := new(T)
 	policies := slices.Filter(app.Spec.Policies, func(policy v1beta1.AppPolicy) bool {
@@ -49,6 +53,11 @@ func ParsePolicy[T any, P typer[T]](app *v1beta1.Application) (*T, error) {
 	if err := convertType(obj, base); err != nil {
 		return nil, err
 	}
+	if v, ok := any(base).(validator); ok {
+		if err := v.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid %s policy: %w", P(base).Type(), err)
+		}
+	}
 	return base, nil
 }
 
@@ -57,6 +57,13 @@ func TestParseGarbageCollectPolicy(t *testing.T) {
 	spec, err = ParsePolicy[v1alpha1.GarbageCollectPolicySpec](app)
 	r.NoError(err)
 	r.Equal(policySpec, spec)
+
+	policySpec.Rules[1].Selector.ResourceNameRegexps = []string{"redis-("}
+	bs, err = json.Marshal(policySpec)
+	r.NoError(err)
+	app.Spec.Policies[1].Properties.Raw = bs
+	_, err = ParsePolicy[v1alpha1.GarbageCollectPolicySpec](app)
+	r.ErrorContains(err, "invalid garbage-collect policy: invalid selector in rule 1: invalid resource name regexp")
 }
 
 func TestParseApplyOncePolicy(t *testing.T) {
@@ -0,0 +1,247 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policy
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"time"
+
+	clusterv1alpha1 "github.com/oam-dev/cluster-gateway/pkg/apis/cluster/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1alpha1"
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1beta1"
+	"github.com/oam-dev/kubevela/pkg/multicluster"
+	"github.com/oam-dev/kubevela/pkg/utils"
+)
+
+const defaultFailoverGracePeriod = 5 * time.Minute
+
+// isClusterConnected checks the connection of the cluster, it is replaced in tests
+var isClusterConnected = multicluster.IsClusterConnected
+
+// FailoverChange is the move of the components in a placed cluster of topology policy
+type FailoverChange struct {
+	Policy  string
+	Cluster string
+	// From is the cluster the components are moved from
+	From string
+	// To is the cluster the components are moved to
+	To string
+}
+
+// applyFailover replaces the placed clusters disconnected for longer than the grace period with connected candidates.
+// The disconnected clusters and the replacements are recorded in the application status, so that the replacements
+// are kept across reconciles until the original clusters are connected again.
+func applyFailover(ctx context.Context, cli client.Client, app *v1beta1.Application, policyName string, topology *v1alpha1.TopologyPolicySpec, clusters []string, candidates []clusterv1alpha1.VirtualCluster) ([]string, error) {
+	failover := topology.Failover
+	gracePeriod := defaultFailoverGracePeriod
+	if failover.GracePeriod != nil {
+		gracePeriod = failover.GracePeriod.Duration
+	}
+	status, err := getTopologyPolicyStatus(app, policyName)
+	if err != nil {
+		return nil, err
+	}
+	if status == nil {
+		status = &v1alpha1.TopologyPolicyStatus{}
+	}
+	records := map[string]v1alpha1.ClusterFailover{}
+	targets := map[string]bool{}
+	for _, record := range status.Failovers {
+		records[record.Cluster] = record
+		if record.Target != "" {
+			targets[record.Target] = true
+		}
+	}
+	connections := map[string]bool{}
+	connected := func(cluster string) bool {
+		if _, checked := connections[cluster]; !checked {
+			connections[cluster] = isClusterConnected(ctx, cli, cluster)
+		}
+		return connections[cluster]
+	}
+	// pickTarget picks the connected candidate neither placed nor taken by other failovers, the placed clusters are
+	// never picked since the components would be deployed twice in them
+	pickTarget := func() string {
+		var names []string
+		for _, candidate := range candidates {
+			names = append(names, candidate.Name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			if !slices.Contains(clusters, name) && !targets[name] && connected(name) {
+				return name
+			}
+		}
+		return ""
+	}
+
+	now := metav1.Now()
+	var failovers []v1alpha1.ClusterFailover
+	var placed []string
+	for _, cluster := range clusters {
+		record, found := records[cluster]
+		if connected(cluster) {
+			if found && record.Target != "" && !failover.FailBack && connected(record.Target) {
+				// the components stay in the cluster they are moved to
+				failovers = append(failovers, record)
+				placed = append(placed, record.Target)
+			} else {
+				placed = append(placed, cluster)
+			}
+			continue
+		}
+		if !found {
+			record = v1alpha1.ClusterFailover{Cluster: cluster, DisconnectedSince: now}
+		}
+		if (record.Target == "" || !connected(record.Target)) && now.Sub(record.DisconnectedSince.Time) >= gracePeriod {
+			record.Target, record.FailoverTime, record.Message = "", nil, ""
+			if target := pickTarget(); target != "" {
+				klog.Infof("cluster %s of topology %s is disconnected since %s, fail over to cluster %s", cluster, policyName, record.DisconnectedSince, target)
+				record.Target, record.FailoverTime = target, now.DeepCopy()
+				targets[target] = true
+			} else {
+				klog.Warningf("cluster %s of topology %s is disconnected since %s, no cluster available to fail over to", cluster, policyName, record.DisconnectedSince)
+				record.Message = "no connected cluster available to fail over to"
+			}
+		}
+		failovers = append(failovers, record)
+		if record.Target != "" {
+			placed = append(placed, record.Target)
+		} else {
+			placed = append(placed, cluster)
+		}
+	}
+	status.Failovers = failovers
+	if err = setTopologyPolicyStatus(app, policyName, status); err != nil {
+		return nil, err
+	}
+	return placed, nil
+}
+
+// HasTopologyFailover checks whether any topology policy enables failover
+func HasTopologyFailover(policies []v1beta1.AppPolicy) bool {
+	for _, policy := range policies {
+		if policy.Type != v1alpha1.TopologyPolicyType || policy.Properties == nil {
+			continue
+		}
+		topologySpec := &v1alpha1.TopologyPolicySpec{}
+		if err := utils.StrictUnmarshal(policy.Properties.Raw, topologySpec); err == nil && topologySpec.Failover != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// GetFailoverTargets returns the clusters the components are moved to, by the topology policy and the original cluster
+func GetFailoverTargets(app *v1beta1.Application) map[string]map[string]string {
+	targets := map[string]map[string]string{}
+	for _, policyStatus := range app.Status.PolicyStatus {
+		if policyStatus.Type != v1alpha1.TopologyPolicyType {
+			continue
+		}
+		status, err := getTopologyPolicyStatus(app, policyStatus.Name)
+		if err != nil {
+			klog.Warningf("failed to get the status of topology %s: %v", policyStatus.Name, err)
+			continue
+		}
+		if status == nil {
+			continue
+		}
+		for _, record := range status.Failovers {
+			if record.Target == "" {
+				continue
+			}
+			if targets[policyStatus.Name] == nil {
+				targets[policyStatus.Name] = map[string]string{}
+			}
+			targets[policyStatus.Name][record.Cluster] = record.Target
+		}
+	}
+	return targets
+}
+
+// GetReleasedClusters returns the clusters the components moved away from by failover, by the topology policy
+func GetReleasedClusters(app *v1beta1.Application) map[string][]string {
+	released := map[string][]string{}
+	for _, policyStatus := range app.Status.PolicyStatus {
+		if policyStatus.Type != v1alpha1.TopologyPolicyType {
+			continue
+		}
+		status, err := getTopologyPolicyStatus(app, policyStatus.Name)
+		if err != nil {
+			klog.Warningf("failed to get the status of topology %s: %v", policyStatus.Name, err)
+			continue
+		}
+		if status != nil && len(status.Released) > 0 {
+			released[policyStatus.Name] = status.Released
+		}
+	}
+	return released
+}
+
+// SetReleasedClusters records the clusters the components moved away from in the status of the topology policy,
+// the resources left in them are deleted after the components are deployed in the clusters they moved to
+func SetReleasedClusters(app *v1beta1.Application, policyName string, clusters []string) error {
+	status, err := getTopologyPolicyStatus(app, policyName)
+	if err != nil {
+		return err
+	}
+	if status == nil {
+		status = &v1alpha1.TopologyPolicyStatus{}
+	}
+	status.Released = clusters
+	return setTopologyPolicyStatus(app, policyName, status)
+}
+
+// DiffFailoverTargets returns the moves of the components between the failover targets before and after
+func DiffFailoverTargets(before, after map[string]map[string]string) []FailoverChange {
+	var changes []FailoverChange
+	collect := func(policy string, cluster string) {
+		from, to := cluster, cluster
+		if target, found := before[policy][cluster]; found {
+			from = target
+		}
+		if target, found := after[policy][cluster]; found {
+			to = target
+		}
+		if from != to && !slices.ContainsFunc(changes, func(change FailoverChange) bool {
+			return change.Policy == policy && change.Cluster == cluster
+		}) {
+			changes = append(changes, FailoverChange{Policy: policy, Cluster: cluster, From: from, To: to})
+		}
+	}
+	for _, targets := range []map[string]map[string]string{before, after} {
+		for policy, clusters := range targets {
+			for cluster := range clusters {
+				collect(policy, cluster)
+			}
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		if changes[i].Policy != changes[j].Policy {
+			return changes[i].Policy < changes[j].Policy
+		}
+		return changes[i].Cluster < changes[j].Cluster
+	})
+	return changes
+}
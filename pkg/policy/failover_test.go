@@ -0,0 +1,169 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policy
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1alpha1"
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1beta1"
+	"github.com/oam-dev/kubevela/apis/types"
+	"github.com/oam-dev/kubevela/pkg/multicluster"
+	"github.com/oam-dev/kubevela/pkg/utils/common"
+)
+
+func fakeClusterConnections(t *testing.T, disconnected ...string) {
+	origin := isClusterConnected
+	isClusterConnected = func(_ context.Context, _ client.Client, cluster string) bool {
+		for _, name := range disconnected {
+			if name == cluster {
+				return false
+			}
+		}
+		return true
+	}
+	t.Cleanup(func() { isClusterConnected = origin })
+}
+
+// disconnectedSince moves the disconnected time of the failover records back
+func disconnectedSince(t *testing.T, app *v1beta1.Application, duration time.Duration) {
+	status, err := getTopologyPolicyStatus(app, "topology-policy")
+	require.NoError(t, err)
+	for i := range status.Failovers {
+		status.Failovers[i].DisconnectedSince = metav1.NewTime(status.Failovers[i].DisconnectedSince.Add(-duration))
+	}
+	require.NoError(t, setTopologyPolicyStatus(app, "topology-policy", status))
+}
+
+func TestTopologyFailover(t *testing.T) {
+	r := require.New(t)
+	multicluster.ClusterGatewaySecretNamespace = types.DefaultKubeVelaNS
+	cli := fake.NewClientBuilder().WithScheme(common.Scheme).WithObjects(
+		newPlacementTestCluster("cluster-a", "us"),
+		newPlacementTestCluster("cluster-b", "us"),
+		newPlacementTestCluster("cluster-c", "eu"),
+	).Build()
+	ctx := context.Background()
+	app := &v1beta1.Application{}
+	policies := topologyPolicy(`{"clusterLabelSelector":{"env":"prod"},"strategy":{"count":2},"failover":{"gracePeriod":"1m"}}`)
+
+	fakeClusterConnections(t)
+	placements, err := GetPlacementsFromTopologyPolicies(ctx, cli, "default", policies, true, app)
+	r.NoError(err)
+	r.Equal([]string{"cluster-a", "cluster-b"}, placementClusters(placements))
+
+	// the disconnected cluster is kept during the grace period
+	fakeClusterConnections(t, "cluster-b")
+	placements, err = GetPlacementsFromTopologyPolicies(ctx, cli, "default", policies, true, app)
+	r.NoError(err)
+	r.Equal([]string{"cluster-a", "cluster-b"}, placementClusters(placements))
+	status, err := getTopologyPolicyStatus(app, "topology-policy")
+	r.NoError(err)
+	r.Len(status.Failovers, 1)
+	r.Equal("cluster-b", status.Failovers[0].Cluster)
+	r.Empty(status.Failovers[0].Target)
+	r.Empty(GetFailoverTargets(app))
+
+	// the components are moved to the cluster not placed after the grace period
+	disconnectedSince(t, app, 2*time.Minute)
+	placements, err = GetPlacementsFromTopologyPolicies(ctx, cli, "default", policies, true, app)
+	r.NoError(err)
+	r.Equal([]string{"cluster-a", "cluster-c"}, placementClusters(placements))
+	r.Equal(map[string]map[string]string{"topology-policy": {"cluster-b": "cluster-c"}}, GetFailoverTargets(app))
+	status, err = getTopologyPolicyStatus(app, "topology-policy")
+	r.NoError(err)
+	r.NotNil(status.Failovers[0].FailoverTime)
+	// the strategy decisions are kept
+	r.Equal([]string{"cluster-a", "cluster-b"}, placementClusters(status.Decisions))
+
+	// the components stay after the cluster is connected again
+	fakeClusterConnections(t)
+	placements, err = GetPlacementsFromTopologyPolicies(ctx, cli, "default", policies, true, app)
+	r.NoError(err)
+	r.Equal([]string{"cluster-a", "cluster-c"}, placementClusters(placements))
+
+	// the components move back if failBack is enabled
+	policies = topologyPolicy(`{"clusterLabelSelector":{"env":"prod"},"strategy":{"count":2},"failover":{"gracePeriod":"1m","failBack":true}}`)
+	placements, err = GetPlacementsFromTopologyPolicies(ctx, cli, "default", policies, true, app)
+	r.NoError(err)
+	r.Equal([]string{"cluster-a", "cluster-b"}, placementClusters(placements))
+	r.Empty(GetFailoverTargets(app))
+}
+
+func TestTopologyFailoverWithoutTarget(t *testing.T) {
+	r := require.New(t)
+	multicluster.ClusterGatewaySecretNamespace = types.DefaultKubeVelaNS
+	cli := fake.NewClientBuilder().WithScheme(common.Scheme).WithObjects(
+		newPlacementTestCluster("cluster-a", "us"),
+		newPlacementTestCluster("cluster-b", "us"),
+	).Build()
+	ctx := context.Background()
+	app := &v1beta1.Application{}
+	policies := topologyPolicy(`{"clusters":["cluster-a","cluster-b"],"failover":{"gracePeriod":"0s"}}`)
+
+	// no spare cluster, the components are not moved to the other placed cluster
+	fakeClusterConnections(t, "cluster-a")
+	placements, err := GetPlacementsFromTopologyPolicies(ctx, cli, "default", policies, true, app)
+	r.NoError(err)
+	r.Equal([]string{"cluster-a", "cluster-b"}, placementClusters(placements))
+	r.Empty(GetFailoverTargets(app))
+	status, err := getTopologyPolicyStatus(app, "topology-policy")
+	r.NoError(err)
+	r.Len(status.Failovers, 1)
+	r.Equal("no connected cluster available to fail over to", status.Failovers[0].Message)
+
+	// the cluster recovered within the grace period is not recorded anymore
+	fakeClusterConnections(t)
+	placements, err = GetPlacementsFromTopologyPolicies(ctx, cli, "default", policies, true, app)
+	r.NoError(err)
+	r.Equal([]string{"cluster-a", "cluster-b"}, placementClusters(placements))
+	status, err = getTopologyPolicyStatus(app, "topology-policy")
+	r.NoError(err)
+	r.Empty(status.Failovers)
+}
+
+func TestReleasedClusters(t *testing.T) {
+	r := require.New(t)
+	app := &v1beta1.Application{}
+	r.Empty(GetReleasedClusters(app))
+	r.NoError(SetReleasedClusters(app, "topology-policy", []string{"cluster-c"}))
+	r.Equal(map[string][]string{"topology-policy": {"cluster-c"}}, GetReleasedClusters(app))
+	r.NoError(SetReleasedClusters(app, "topology-policy", nil))
+	r.Empty(GetReleasedClusters(app))
+}
+
+func TestDiffFailoverTargets(t *testing.T) {
+	r := require.New(t)
+	before := map[string]map[string]string{"topology": {"cluster-a": "cluster-c", "cluster-b": "cluster-d"}}
+	after := map[string]map[string]string{"topology": {"cluster-b": "cluster-e"}, "other": {"cluster-x": "cluster-y"}}
+	r.Equal([]FailoverChange{
+		{Policy: "other", Cluster: "cluster-x", From: "cluster-x", To: "cluster-y"},
+		{Policy: "topology", Cluster: "cluster-a", From: "cluster-c", To: "cluster-a"},
+		{Policy: "topology", Cluster: "cluster-b", From: "cluster-d", To: "cluster-e"},
+	}, DiffFailoverTargets(before, after))
+	r.Empty(DiffFailoverTargets(before, before))
+
+	r.True(HasTopologyFailover(topologyPolicy(`{"clusters":["cluster-a"],"failover":{}}`)))
+	r.False(HasTopologyFailover(append(topologyPolicy(`{"clusters":["cluster-a"]}`), v1beta1.AppPolicy{Type: v1alpha1.OverridePolicyType})))
+}
@@ -0,0 +1,189 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policy
+
+import (
+	"encoding/json"
+	"reflect"
+	"slices"
+	"sort"
+
+	clusterv1alpha1 "github.com/oam-dev/cluster-gateway/pkg/apis/cluster/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/klog/v2"
+
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/common"
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1alpha1"
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1beta1"
+	"github.com/oam-dev/kubevela/pkg/multicluster"
+)
+
+// scoredCluster is a candidate cluster with its rank score, the higher the better
+type scoredCluster struct {
+	name   string
+	labels map[string]string
+	score  float64
+}
+
+// pickClusters picks the clusters out of the candidates with the placement strategy of the topology.
+// The picked clusters are reused from the application status if the candidates and the strategy are not changed.
+func pickClusters(app *v1beta1.Application, policyName string, topology *v1alpha1.TopologyPolicySpec, candidates []clusterv1alpha1.VirtualCluster) ([]string, error) {
+	var names []string
+	for _, cluster := range candidates {
+		names = append(names, cluster.Name)
+	}
+	sort.Strings(names)
+	status, err := getTopologyPolicyStatus(app, policyName)
+	if err != nil {
+		return nil, err
+	}
+	if status != nil && reflect.DeepEqual(status.Strategy, topology.Strategy) && slices.Equal(status.Candidates, names) {
+		var clusters []string
+		for _, decision := range status.Decisions {
+			clusters = append(clusters, decision.Cluster)
+		}
+		return clusters, nil
+	}
+
+	clusters := rankClusters(topology.Strategy, candidates)
+	if topology.Strategy.SpreadByLabel != "" {
+		clusters = spreadClusters(clusters, topology.Strategy.SpreadByLabel)
+	}
+	if count := topology.Strategy.Count; count > 0 && count < len(clusters) {
+		clusters = clusters[:count]
+	}
+	status = &v1alpha1.TopologyPolicyStatus{Strategy: topology.Strategy, Candidates: names}
+	var picked []string
+	for _, cluster := range clusters {
+		picked = append(picked, cluster.name)
+		status.Decisions = append(status.Decisions, v1alpha1.PlacementDecision{Cluster: cluster.name, Namespace: topology.Namespace})
+	}
+	if err = setTopologyPolicyStatus(app, policyName, status); err != nil {
+		return nil, err
+	}
+	return picked, nil
+}
+
+// rankClusters sorts the clusters by the weighted ratio of free resources, or by names if no weights are set
+func rankClusters(strategy *v1alpha1.PlacementStrategy, candidates []clusterv1alpha1.VirtualCluster) []scoredCluster {
+	var clusters []scoredCluster
+	for _, candidate := range candidates {
+		cluster := scoredCluster{name: candidate.Name, labels: candidate.Labels}
+		if weights := strategy.CapacityWeights; weights != nil {
+			metrics, err := multicluster.GetClusterMetrics(candidate.Name)
+			if err != nil {
+				klog.Warningf("failed to get the metrics of cluster %s, it will be ranked last: %v", candidate.Name, err)
+				cluster.score = -1
+			} else {
+				cluster.score = capacityScore(weights, metrics)
+			}
+		}
+		clusters = append(clusters, cluster)
+	}
+	sort.SliceStable(clusters, func(i, j int) bool {
+		if clusters[i].score != clusters[j].score {
+			return clusters[i].score > clusters[j].score
+		}
+		return clusters[i].name < clusters[j].name
+	})
+	return clusters
+}
+
+// capacityScore is the weighted sum of the free ratios of the allocatable resources
+func capacityScore(weights *v1alpha1.CapacityWeights, metrics *multicluster.ClusterMetrics) float64 {
+	if metrics.ClusterInfo == nil {
+		return -1
+	}
+	var cpuUsage, memoryUsage resource.Quantity
+	if metrics.ClusterUsageMetrics != nil {
+		cpuUsage, memoryUsage = metrics.ClusterUsageMetrics.CPUUsage, metrics.ClusterUsageMetrics.MemoryUsage
+	}
+	return float64(weights.CPU)*freeRatio(metrics.ClusterInfo.CPUAllocatable, cpuUsage) +
+		float64(weights.Memory)*freeRatio(metrics.ClusterInfo.MemoryAllocatable, memoryUsage)
+}
+
+func freeRatio(allocatable resource.Quantity, usage resource.Quantity) float64 {
+	total := allocatable.AsApproximateFloat64()
+	if total <= 0 {
+		return 0
+	}
+	return max(total-usage.AsApproximateFloat64(), 0) / total
+}
+
+// spreadClusters reorders the ranked clusters so that the clusters with different values of the label
+// come in turns. In each turn, the best remaining cluster of each label value is picked.
+func spreadClusters(clusters []scoredCluster, label string) []scoredCluster {
+	var values []string
+	groups := map[string][]scoredCluster{}
+	for _, cluster := range clusters {
+		value := cluster.labels[label]
+		if _, found := groups[value]; !found {
+			values = append(values, value)
+		}
+		groups[value] = append(groups[value], cluster)
+	}
+	var spread []scoredCluster
+	for len(spread) < len(clusters) {
+		// values are ordered by the rank of their best clusters, so the turns keep the order
+		for _, value := range values {
+			if len(groups[value]) > 0 {
+				spread = append(spread, groups[value][0])
+				groups[value] = groups[value][1:]
+			}
+		}
+	}
+	return spread
+}
+
+func getTopologyPolicyStatus(app *v1beta1.Application, policyName string) (*v1alpha1.TopologyPolicyStatus, error) {
+	if app == nil {
+		return nil, nil
+	}
+	for _, policyStatus := range app.Status.PolicyStatus {
+		if policyStatus.Name == policyName && policyStatus.Type == v1alpha1.TopologyPolicyType && policyStatus.Status != nil {
+			status := &v1alpha1.TopologyPolicyStatus{}
+			if err := json.Unmarshal(policyStatus.Status.Raw, status); err != nil {
+				return nil, err
+			}
+			return status, nil
+		}
+	}
+	return nil, nil
+}
+
+func setTopologyPolicyStatus(app *v1beta1.Application, policyName string, status *v1alpha1.TopologyPolicyStatus) error {
+	if app == nil {
+		return nil
+	}
+	bs, err := json.Marshal(status)
+	if err != nil {
+		return err
+	}
+	for idx, policyStatus := range app.Status.PolicyStatus {
+		if policyStatus.Name == policyName && policyStatus.Type == v1alpha1.TopologyPolicyType {
+			app.Status.PolicyStatus[idx].Status = &runtime.RawExtension{Raw: bs}
+			return nil
+		}
+	}
+	app.Status.PolicyStatus = append(app.Status.PolicyStatus, common.PolicyStatus{
+		Name:   policyName,
+		Type:   v1alpha1.TopologyPolicyType,
+		Status: &runtime.RawExtension{Raw: bs},
+	})
+	return nil
+}
@@ -0,0 +1,185 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	clusterv1alpha1 "github.com/oam-dev/cluster-gateway/pkg/apis/cluster/v1alpha1"
+	clustercommon "github.com/oam-dev/cluster-gateway/pkg/common"
+
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1alpha1"
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1beta1"
+	"github.com/oam-dev/kubevela/apis/types"
+	"github.com/oam-dev/kubevela/pkg/multicluster"
+	"github.com/oam-dev/kubevela/pkg/utils/common"
+)
+
+func newPlacementTestCluster(name string, region string) client.Object {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: multicluster.ClusterGatewaySecretNamespace,
+			Labels: map[string]string{
+				clustercommon.LabelKeyClusterEndpointType:   string(clusterv1alpha1.ClusterEndpointTypeConst),
+				clustercommon.LabelKeyClusterCredentialType: string(clusterv1alpha1.CredentialTypeX509Certificate),
+				"env":    "prod",
+				"region": region,
+			},
+		},
+	}
+}
+
+func topologyPolicy(properties string) []v1beta1.AppPolicy {
+	return []v1beta1.AppPolicy{{
+		Name:       "topology-policy",
+		Type:       v1alpha1.TopologyPolicyType,
+		Properties: &runtime.RawExtension{Raw: []byte(properties)},
+	}}
+}
+
+func placementClusters(placements []v1alpha1.PlacementDecision) []string {
+	var clusters []string
+	for _, pl := range placements {
+		clusters = append(clusters, pl.Cluster)
+	}
+	return clusters
+}
+
+func TestPlacementStrategy(t *testing.T) {
+	multicluster.ClusterGatewaySecretNamespace = types.DefaultKubeVelaNS
+	cli := fake.NewClientBuilder().WithScheme(common.Scheme).WithObjects(
+		newPlacementTestCluster("cluster-a", "us"),
+		newPlacementTestCluster("cluster-b", "us"),
+		newPlacementTestCluster("cluster-c", "us"),
+		newPlacementTestCluster("cluster-d", "eu"),
+		newPlacementTestCluster("cluster-e", "ap"),
+	).Build()
+	ctx := context.Background()
+
+	testCases := map[string]struct {
+		properties string
+		expected   []string
+	}{
+		"pick clusters in the order of names": {
+			properties: `{"clusterLabelSelector":{"env":"prod"},"strategy":{"count":2}}`,
+			expected:   []string{"cluster-a", "cluster-b"},
+		},
+		"spread clusters across regions": {
+			properties: `{"clusterLabelSelector":{"env":"prod"},"strategy":{"count":4,"spreadByLabel":"region"}}`,
+			expected:   []string{"cluster-a", "cluster-d", "cluster-e", "cluster-b"},
+		},
+		"pick from the listed clusters": {
+			properties: `{"clusters":["cluster-c","cluster-b","cluster-d"],"strategy":{"count":2,"spreadByLabel":"region"}}`,
+			expected:   []string{"cluster-b", "cluster-d"},
+		},
+		"count larger than the candidates": {
+			properties: `{"clusters":["cluster-c","cluster-b"],"strategy":{"count":5}}`,
+			expected:   []string{"cluster-b", "cluster-c"},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			r := require.New(t)
+			placements, err := GetPlacementsFromTopologyPolicies(ctx, cli, "default", topologyPolicy(tc.properties), true, nil)
+			r.NoError(err)
+			r.Equal(tc.expected, placementClusters(placements))
+		})
+	}
+}
+
+func TestPlacementStrategyDecisionsKept(t *testing.T) {
+	r := require.New(t)
+	multicluster.ClusterGatewaySecretNamespace = types.DefaultKubeVelaNS
+	cli := fake.NewClientBuilder().WithScheme(common.Scheme).WithObjects(
+		newPlacementTestCluster("cluster-a", "us"),
+		newPlacementTestCluster("cluster-b", "us"),
+		newPlacementTestCluster("cluster-c", "eu"),
+	).Build()
+	ctx := context.Background()
+	app := &v1beta1.Application{}
+	policies := topologyPolicy(`{"clusterLabelSelector":{"env":"prod"},"namespace":"prod","strategy":{"count":2}}`)
+
+	placements, err := GetPlacementsFromTopologyPolicies(ctx, cli, "default", policies, true, app)
+	r.NoError(err)
+	r.Equal([]v1alpha1.PlacementDecision{{Cluster: "cluster-a", Namespace: "prod"}, {Cluster: "cluster-b", Namespace: "prod"}}, placements)
+	status, err := getTopologyPolicyStatus(app, "topology-policy")
+	r.NoError(err)
+	r.Equal([]string{"cluster-a", "cluster-b", "cluster-c"}, status.Candidates)
+	r.Equal(placements, status.Decisions)
+
+	// the recorded decisions are kept even if the clusters would be ranked differently
+	status.Decisions = []v1alpha1.PlacementDecision{{Cluster: "cluster-c", Namespace: "prod"}, {Cluster: "cluster-a", Namespace: "prod"}}
+	r.NoError(setTopologyPolicyStatus(app, "topology-policy", status))
+	placements, err = GetPlacementsFromTopologyPolicies(ctx, cli, "default", policies, true, app)
+	r.NoError(err)
+	r.Equal([]string{"cluster-c", "cluster-a"}, placementClusters(placements))
+
+	// the clusters are picked again when the candidates change
+	r.NoError(cli.Create(ctx, newPlacementTestCluster("cluster-0", "eu")))
+	placements, err = GetPlacementsFromTopologyPolicies(ctx, cli, "default", policies, true, app)
+	r.NoError(err)
+	r.Equal([]string{"cluster-0", "cluster-a"}, placementClusters(placements))
+
+	// the clusters are picked again when the strategy changes
+	policies = topologyPolicy(`{"clusterLabelSelector":{"env":"prod"},"namespace":"prod","strategy":{"count":2,"spreadByLabel":"region"}}`)
+	placements, err = GetPlacementsFromTopologyPolicies(ctx, cli, "default", policies, true, app)
+	r.NoError(err)
+	r.Equal([]string{"cluster-0", "cluster-a"}, placementClusters(placements))
+	r.Len(app.Status.PolicyStatus, 1)
+}
+
+func TestCapacityScore(t *testing.T) {
+	r := require.New(t)
+	metrics := &multicluster.ClusterMetrics{
+		ClusterInfo: &multicluster.ClusterInfo{
+			CPUAllocatable:    resource.MustParse("4"),
+			MemoryAllocatable: resource.MustParse("8Gi"),
+		},
+		ClusterUsageMetrics: &multicluster.ClusterUsageMetrics{
+			CPUUsage:    resource.MustParse("1"),
+			MemoryUsage: resource.MustParse("6Gi"),
+		},
+	}
+	r.InDelta(0.75, capacityScore(&v1alpha1.CapacityWeights{CPU: 1}, metrics), 0.001)
+	r.InDelta(0.25, capacityScore(&v1alpha1.CapacityWeights{Memory: 1}, metrics), 0.001)
+	r.InDelta(1.75, capacityScore(&v1alpha1.CapacityWeights{CPU: 2, Memory: 1}, metrics), 0.001)
+	// all the allocatable resources are free if the usage is unknown
+	r.InDelta(3.0, capacityScore(&v1alpha1.CapacityWeights{CPU: 2, Memory: 1}, &multicluster.ClusterMetrics{ClusterInfo: metrics.ClusterInfo}), 0.001)
+	r.Equal(-1.0, capacityScore(&v1alpha1.CapacityWeights{CPU: 1}, &multicluster.ClusterMetrics{}))
+
+	clusters := spreadClusters([]scoredCluster{
+		{name: "a", labels: map[string]string{"zone": "1"}, score: 3},
+		{name: "b", labels: map[string]string{"zone": "1"}, score: 2},
+		{name: "c", labels: map[string]string{"zone": "2"}, score: 1},
+		{name: "d", score: 0},
+	}, "zone")
+	var names []string
+	for _, cluster := range clusters {
+		names = append(names, cluster.name)
+	}
+	r.Equal([]string{"a", "c", "d", "b"}, names)
+}
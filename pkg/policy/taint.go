@@ -0,0 +1,127 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policy
+
+import (
+	"context"
+	"slices"
+
+	clusterv1alpha1 "github.com/oam-dev/cluster-gateway/pkg/apis/cluster/v1alpha1"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1alpha1"
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1beta1"
+	"github.com/oam-dev/kubevela/pkg/multicluster"
+	"github.com/oam-dev/kubevela/pkg/utils"
+)
+
+// findUntoleratedTaint returns the first taint with one of the effects that is not tolerated by the tolerations
+func findUntoleratedTaint(taints []corev1.Taint, tolerations []corev1.Toleration, effects ...corev1.TaintEffect) *corev1.Taint {
+	for i, taint := range taints {
+		if !slices.Contains(effects, taint.Effect) {
+			continue
+		}
+		if !slices.ContainsFunc(tolerations, func(toleration corev1.Toleration) bool {
+			return toleration.ToleratesTaint(&taints[i])
+		}) {
+			return &taints[i]
+		}
+	}
+	return nil
+}
+
+// listClusterTaints returns the taints of all the clusters by name, the clusters are listed once so that the
+// candidates of the topology policies can be filtered without getting each of them
+func listClusterTaints(ctx context.Context, cli client.Client) (map[string][]corev1.Taint, error) {
+	clusters, err := multicluster.ListVirtualClusters(ctx, cli)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list cluster taints")
+	}
+	taints := map[string][]corev1.Taint{}
+	for _, cluster := range clusters {
+		taints[cluster.Name] = cluster.Taints
+	}
+	return taints, nil
+}
+
+// filterTaintedClusters removes the clusters with taints of the effects not tolerated by the tolerations
+func filterTaintedClusters(clusters []clusterv1alpha1.VirtualCluster, taints map[string][]corev1.Taint, tolerations []corev1.Toleration, effects ...corev1.TaintEffect) []clusterv1alpha1.VirtualCluster {
+	var filtered []clusterv1alpha1.VirtualCluster
+	for _, cluster := range clusters {
+		if taint := findUntoleratedTaint(taints[cluster.Name], tolerations, effects...); taint != nil {
+			klog.V(4).Infof("skip cluster %s with taint %s not tolerated", cluster.Name, taint.ToString())
+			continue
+		}
+		filtered = append(filtered, cluster)
+	}
+	return filtered
+}
+
+// FindEvictingTaint returns the NoExecute taint of the cluster that is not tolerated by any topology policy placing
+// the cluster, the workloads in the cluster should be moved off. The tolerations of the topology policies that do not
+// place the cluster are ignored. It returns nil if no topology policy places the cluster.
+func FindEvictingTaint(app *v1beta1.Application, cluster *multicluster.VirtualCluster) (*corev1.Taint, error) {
+	var evicting *corev1.Taint
+	for _, policy := range app.Spec.Policies {
+		if policy.Type != v1alpha1.TopologyPolicyType || policy.Properties == nil {
+			continue
+		}
+		topologySpec := &v1alpha1.TopologyPolicySpec{}
+		if err := utils.StrictUnmarshal(policy.Properties.Raw, topologySpec); err != nil {
+			return nil, errors.Wrapf(err, "failed to parse topology policy %s", policy.Name)
+		}
+		places, err := topologyPlacesCluster(app, policy.Name, topologySpec, cluster)
+		if err != nil {
+			return nil, err
+		}
+		if !places {
+			continue
+		}
+		taint := findUntoleratedTaint(cluster.Taints, topologySpec.Tolerations, corev1.TaintEffectNoExecute)
+		if taint == nil {
+			return nil, nil
+		}
+		evicting = taint
+	}
+	return evicting, nil
+}
+
+// topologyPlacesCluster checks whether the cluster is listed or selected by the topology policy, or is recorded in
+// the status of the topology policy as a member of the cluster group, a picked cluster or a failover target
+func topologyPlacesCluster(app *v1beta1.Application, policyName string, topology *v1alpha1.TopologyPolicySpec, cluster *multicluster.VirtualCluster) (bool, error) {
+	if slices.Contains(topology.Clusters, cluster.Name) {
+		return true, nil
+	}
+	if selector := GetClusterLabelSelectorInTopology(topology); topology.Clusters == nil && topology.ClusterGroup == "" && len(selector) > 0 &&
+		labels.SelectorFromSet(selector).Matches(labels.Set(cluster.Labels)) {
+		return true, nil
+	}
+	status, err := getTopologyPolicyStatus(app, policyName)
+	if err != nil || status == nil {
+		return false, err
+	}
+	if slices.Contains(status.GroupMembers, cluster.Name) ||
+		slices.ContainsFunc(status.Decisions, func(decision v1alpha1.PlacementDecision) bool { return decision.Cluster == cluster.Name }) ||
+		slices.ContainsFunc(status.Failovers, func(record v1alpha1.ClusterFailover) bool { return record.Target == cluster.Name }) {
+		return true, nil
+	}
+	return false, nil
+}
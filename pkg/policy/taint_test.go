@@ -0,0 +1,156 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1beta1"
+	"github.com/oam-dev/kubevela/apis/types"
+	"github.com/oam-dev/kubevela/pkg/multicluster"
+	"github.com/oam-dev/kubevela/pkg/utils/common"
+)
+
+func newTaintedTestCluster(t *testing.T, name string, region string, taints ...corev1.Taint) client.Object {
+	cluster := newPlacementTestCluster(name, region)
+	require.NoError(t, multicluster.SetClusterTaints(cluster, taints))
+	return cluster
+}
+
+func TestTopologyTolerations(t *testing.T) {
+	multicluster.ClusterGatewaySecretNamespace = types.DefaultKubeVelaNS
+	noSchedule := corev1.Taint{Key: "gpu", Value: "true", Effect: corev1.TaintEffectNoSchedule}
+	noExecute := corev1.Taint{Key: "maintenance", Effect: corev1.TaintEffectNoExecute}
+	preferNoSchedule := corev1.Taint{Key: "spot", Effect: corev1.TaintEffectPreferNoSchedule}
+	cli := fake.NewClientBuilder().WithScheme(common.Scheme).WithObjects(
+		newTaintedTestCluster(t, "cluster-a", "us"),
+		newTaintedTestCluster(t, "cluster-b", "us", noSchedule),
+		newTaintedTestCluster(t, "cluster-c", "eu", noExecute),
+		newTaintedTestCluster(t, "cluster-d", "eu", preferNoSchedule),
+		newTaintedTestCluster(t, "cluster-e", "ap", noSchedule),
+	).Build()
+	ctx := context.Background()
+
+	testCases := map[string]struct {
+		properties  string
+		expected    []string
+		expectedErr string
+	}{
+		"selected clusters with taints are skipped": {
+			properties: `{"clusterLabelSelector":{"env":"prod"}}`,
+			expected:   []string{"cluster-a", "cluster-d"},
+		},
+		"listed clusters are skipped only with NoExecute taints": {
+			properties: `{"clusters":["cluster-a","cluster-b","cluster-c"]}`,
+			expected:   []string{"cluster-a", "cluster-b"},
+		},
+		"tolerated taints": {
+			properties: `{"clusterLabelSelector":{"env":"prod"},"tolerations":[{"key":"gpu","operator":"Equal","value":"true"},{"key":"maintenance","operator":"Exists","effect":"NoExecute"}]}`,
+			expected:   []string{"cluster-a", "cluster-b", "cluster-c", "cluster-d", "cluster-e"},
+		},
+		"toleration with different value": {
+			properties: `{"clusterLabelSelector":{"env":"prod"},"tolerations":[{"key":"gpu","value":"false"}]}`,
+			expected:   []string{"cluster-a", "cluster-d"},
+		},
+		"tolerate all taints": {
+			properties: `{"clusterLabelSelector":{"region":"eu"},"tolerations":[{"operator":"Exists"}]}`,
+			expected:   []string{"cluster-c", "cluster-d"},
+		},
+		"all selected clusters are tainted": {
+			properties:  `{"clusterLabelSelector":{"region":"ap"},"tolerations":[{"key":"gpu","value":"false"}]}`,
+			expectedErr: "all the clusters matching given labels are tainted",
+		},
+		"all selected clusters are tainted with allowEmpty": {
+			properties: `{"clusterLabelSelector":{"region":"ap"},"allowEmpty":true}`,
+			expected:   nil,
+		},
+		"tainted clusters are not picked by strategy": {
+			properties: `{"clusterLabelSelector":{"env":"prod"},"strategy":{"count":2,"spreadByLabel":"region"}}`,
+			expected:   []string{"cluster-a", "cluster-d"},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			r := require.New(t)
+			placements, err := GetPlacementsFromTopologyPolicies(ctx, cli, "default", topologyPolicy(tc.properties), true, nil)
+			if tc.expectedErr != "" {
+				r.ErrorContains(err, tc.expectedErr)
+				return
+			}
+			r.NoError(err)
+			r.Equal(tc.expected, placementClusters(placements))
+		})
+	}
+}
+
+func TestFindEvictingTaint(t *testing.T) {
+	r := require.New(t)
+	taints := []corev1.Taint{
+		{Key: "gpu", Effect: corev1.TaintEffectNoSchedule},
+		{Key: "maintenance", Value: "true", Effect: corev1.TaintEffectNoExecute},
+	}
+	cluster := &multicluster.VirtualCluster{Name: "cluster-b", Labels: map[string]string{"env": "prod"}, Taints: taints}
+	namedTopologyPolicy := func(name string, properties string) v1beta1.AppPolicy {
+		policy := topologyPolicy(properties)[0]
+		policy.Name = name
+		return policy
+	}
+	app := &v1beta1.Application{Spec: v1beta1.ApplicationSpec{Policies: []v1beta1.AppPolicy{
+		namedTopologyPolicy("prod", `{"clusterLabelSelector":{"env":"prod"}}`),
+		{Name: "override", Type: "override"},
+	}}}
+
+	taint, err := FindEvictingTaint(app, cluster)
+	r.NoError(err)
+	r.Equal(&taints[1], taint)
+
+	// the tolerations of the topology policy not placing the cluster are ignored
+	app.Spec.Policies = append(app.Spec.Policies, namedTopologyPolicy("other", `{"clusters":["cluster-a"],"tolerations":[{"key":"maintenance","value":"true"}]}`))
+	taint, err = FindEvictingTaint(app, cluster)
+	r.NoError(err)
+	r.Equal(&taints[1], taint)
+
+	// the taint tolerated by any topology policy placing the cluster does not evict
+	app.Spec.Policies = append(app.Spec.Policies, namedTopologyPolicy("tolerating", `{"clusters":["cluster-b"],"tolerations":[{"key":"maintenance","value":"true"}]}`))
+	taint, err = FindEvictingTaint(app, cluster)
+	r.NoError(err)
+	r.Nil(taint)
+
+	// the members of the cluster group are found in the status of the topology policy
+	app.Spec.Policies = []v1beta1.AppPolicy{namedTopologyPolicy("group", `{"clusterGroup":"group"}`)}
+	taint, err = FindEvictingTaint(app, cluster)
+	r.NoError(err)
+	r.Nil(taint)
+	r.NoError(recordClusterGroupMembers(app, "group", []string{"cluster-b"}))
+	taint, err = FindEvictingTaint(app, cluster)
+	r.NoError(err)
+	r.Equal(&taints[1], taint)
+
+	// no eviction without topology policies
+	taint, err = FindEvictingTaint(&v1beta1.Application{}, cluster)
+	r.NoError(err)
+	r.Nil(taint)
+
+	_, err = FindEvictingTaint(&v1beta1.Application{Spec: v1beta1.ApplicationSpec{Policies: topologyPolicy(`{"unknown":true}`)}}, cluster)
+	r.Error(err)
+}
@@ -19,9 +19,12 @@ package policy
 import (
 	"context"
 	"fmt"
+	"slices"
 
 	pkgmulticluster "github.com/kubevela/pkg/multicluster"
+	clusterv1alpha1 "github.com/oam-dev/cluster-gateway/pkg/apis/cluster/v1alpha1"
 	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
 	utilfeature "k8s.io/apiserver/pkg/util/feature"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -43,16 +46,17 @@ func GetClusterLabelSelectorInTopology(topology *v1alpha1.TopologyPolicySpec) ma
 	return nil
 }
 
-// GetPlacementsFromTopologyPolicies get placements from topology policies with provided client
-func GetPlacementsFromTopologyPolicies(ctx context.Context, cli client.Client, appNs string, policies []v1beta1.AppPolicy, allowCrossNamespace bool) ([]v1alpha1.PlacementDecision, error) {
+// GetPlacementsFromTopologyPolicies get placements from topology policies with provided client.
+// If the topology policy has a placement strategy, the picked clusters are recorded in the status
+// of the app and kept across reconciles. The app can be nil if the decisions do not need to be kept.
+// Clusters with taints not tolerated by the topology policy are skipped. If failover is enabled, the placed
+// clusters disconnected for longer than the grace period are replaced and the replacements are recorded in the
+// status of the app as well. The members of the cluster group selected by the topology policy are resolved on each
+// call and recorded in the status of the app, so that the changes of the group can be found later.
+func GetPlacementsFromTopologyPolicies(ctx context.Context, cli client.Client, appNs string, policies []v1beta1.AppPolicy, allowCrossNamespace bool, app *v1beta1.Application) ([]v1alpha1.PlacementDecision, error) {
 	placements := make([]v1alpha1.PlacementDecision, 0)
 	placementMap := map[string]struct{}{}
-	addCluster := func(cluster string, ns string, validateCluster bool) error {
-		if validateCluster {
-			if _, e := multicluster.NewClusterClient(cli).Get(ctx, cluster); e != nil {
-				return errors.Wrapf(e, "failed to get cluster %s", cluster)
-			}
-		}
+	addCluster := func(cluster string, ns string) error {
 		if !allowCrossNamespace && (ns != appNs && ns != "") {
 			return errors.Errorf("cannot cross namespace")
 		}
@@ -65,6 +69,8 @@ func GetPlacementsFromTopologyPolicies(ctx context.Context, cli client.Client, a
 		return nil
 	}
 	hasTopologyPolicy := false
+	// the taints of the clusters are listed at most once for all the topology policies
+	var taints map[string][]corev1.Taint
 	for _, policy := range policies {
 		if policy.Type == v1alpha1.TopologyPolicyType {
 			if policy.Properties == nil {
@@ -76,13 +82,42 @@ func GetPlacementsFromTopologyPolicies(ctx context.Context, cli client.Client, a
 				return nil, errors.Wrapf(err, "failed to parse topology policy %s", policy.Name)
 			}
 			clusterLabelSelector := GetClusterLabelSelectorInTopology(topologySpec)
+			var candidates []clusterv1alpha1.VirtualCluster
+			var groupMembers []string
+			if taints == nil && (topologySpec.Clusters != nil || topologySpec.ClusterGroup != "" || clusterLabelSelector != nil) {
+				var err error
+				if taints, err = listClusterTaints(ctx, cli); err != nil {
+					return nil, err
+				}
+			}
 			switch {
 			case topologySpec.Clusters != nil:
 				for _, cluster := range topologySpec.Clusters {
-					if err := addCluster(cluster, topologySpec.Namespace, true); err != nil {
-						return nil, err
+					vc, err := multicluster.NewClusterClient(cli).Get(ctx, cluster)
+					if err != nil {
+						return nil, errors.Wrapf(err, "failed to get cluster %s", cluster)
+					}
+					candidates = append(candidates, *vc)
+				}
+				// listed clusters are only skipped if they are draining
+				candidates = filterTaintedClusters(candidates, taints, topologySpec.Tolerations, corev1.TaintEffectNoExecute)
+			case topologySpec.ClusterGroup != "":
+				listed, selected, err := resolveClusterGroup(ctx, cli, topologySpec.ClusterGroup)
+				if err != nil {
+					return nil, err
+				}
+				groupMembers = clusterGroupMembers(listed, selected)
+				// clusters are filtered as they are listed or selected by labels in the topology
+				listed = filterTaintedClusters(listed, taints, topologySpec.Tolerations, corev1.TaintEffectNoExecute)
+				selected = filterTaintedClusters(selected, taints, topologySpec.Tolerations, corev1.TaintEffectNoSchedule, corev1.TaintEffectNoExecute)
+				for _, cluster := range append(listed, selected...) {
+					if !slices.ContainsFunc(candidates, func(vc clusterv1alpha1.VirtualCluster) bool { return vc.Name == cluster.Name }) {
+						candidates = append(candidates, cluster)
 					}
 				}
+				if len(candidates) == 0 && !topologySpec.AllowEmpty {
+					return nil, errors.Errorf("failed to find any available cluster in cluster group %s", topologySpec.ClusterGroup)
+				}
 			case clusterLabelSelector != nil:
 				clusterList, err := multicluster.NewClusterClient(cli).List(ctx, client.MatchingLabels(clusterLabelSelector))
 				if err != nil {
@@ -91,13 +126,41 @@ func GetPlacementsFromTopologyPolicies(ctx context.Context, cli client.Client, a
 				if len(clusterList.Items) == 0 && !topologySpec.AllowEmpty {
 					return nil, errors.New("failed to find any cluster matches given labels")
 				}
-				for _, cluster := range clusterList.Items {
-					if err = addCluster(cluster.Name, topologySpec.Namespace, false); err != nil {
-						return nil, err
-					}
+				candidates = filterTaintedClusters(clusterList.Items, taints, topologySpec.Tolerations, corev1.TaintEffectNoSchedule, corev1.TaintEffectNoExecute)
+				if len(candidates) == 0 && !topologySpec.AllowEmpty {
+					return nil, errors.New("all the clusters matching given labels are tainted")
 				}
 			default:
-				if err := addCluster(pkgmulticluster.Local, topologySpec.Namespace, false); err != nil {
+				if err := addCluster(pkgmulticluster.Local, topologySpec.Namespace); err != nil {
+					return nil, err
+				}
+				continue
+			}
+			clusters := make([]string, 0, len(candidates))
+			for _, cluster := range candidates {
+				clusters = append(clusters, cluster.Name)
+			}
+			if topologySpec.Strategy != nil && len(candidates) > 0 {
+				picked, err := pickClusters(app, policy.Name, topologySpec, candidates)
+				if err != nil {
+					return nil, errors.Wrapf(err, "failed to pick clusters in topology %s", policy.Name)
+				}
+				clusters = picked
+			}
+			if topologySpec.Failover != nil && len(clusters) > 0 {
+				placed, err := applyFailover(ctx, cli, app, policy.Name, topologySpec, clusters, candidates)
+				if err != nil {
+					return nil, errors.Wrapf(err, "failed to fail over clusters in topology %s", policy.Name)
+				}
+				clusters = placed
+			}
+			if topologySpec.ClusterGroup != "" {
+				if err := recordClusterGroupMembers(app, policy.Name, groupMembers); err != nil {
+					return nil, errors.Wrapf(err, "failed to record cluster group members in topology %s", policy.Name)
+				}
+			}
+			for _, cluster := range clusters {
+				if err := addCluster(cluster, topologySpec.Namespace); err != nil {
 					return nil, err
 				}
 			}
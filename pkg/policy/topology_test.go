@@ -174,7 +174,7 @@ func TestGetClusterLabelSelectorInTopology(t *testing.T) {
 	for name, tt := range testCases {
 		t.Run(name, func(t *testing.T) {
 			r := require.New(t)
-			pds, err := GetPlacementsFromTopologyPolicies(context.Background(), cli, appNs, tt.Inputs, tt.AllowCrossNamespace)
+			pds, err := GetPlacementsFromTopologyPolicies(context.Background(), cli, appNs, tt.Inputs, tt.AllowCrossNamespace, nil)
 			if tt.Error != "" {
 				r.NotNil(err)
 				r.Contains(err.Error(), tt.Error)
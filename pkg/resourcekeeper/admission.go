@@ -19,12 +19,23 @@ package resourcekeeper
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
+	"sync"
 
 	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/condition"
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1alpha1"
 	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1beta1"
+	"github.com/oam-dev/kubevela/pkg/multicluster"
+	"github.com/oam-dev/kubevela/pkg/oam"
+	"github.com/oam-dev/kubevela/pkg/utils/cel"
 )
 
 var (
@@ -34,19 +45,40 @@ var (
 	AllowResourceTypes = ""
 )
 
+// ResourceAdmissionConditionType is the type of application condition which records the violation of admission rules
+const ResourceAdmissionConditionType = "ResourceAdmission"
+
 // AdmissionCheck check whether resources dispatch/deletion is admitted
-func (h *resourceKeeper) AdmissionCheck(ctx context.Context, manifests []*unstructured.Unstructured) error {
+func (h *resourceKeeper) AdmissionCheck(ctx context.Context, op v1alpha1.ResourceAdmissionOperation, manifests []*unstructured.Unstructured) error {
 	for _, handler := range []ResourceAdmissionHandler{
 		&NamespaceAdmissionHandler{app: h.app},
 		&ResourceTypeAdmissionHandler{},
+		&RuleAdmissionHandler{cli: h.Client, app: h.app, operation: op, policies: &h.admissionPolicies},
 	} {
 		if err := handler.Validate(ctx, manifests); err != nil {
+			h.setAdmissionCondition(err)
 			return err
 		}
 	}
+	h.setAdmissionCondition(nil)
 	return nil
 }
 
+// setAdmissionCondition record the admission violation in the application status. The condition will only be
+// recovered if it has been set before.
+func (h *resourceKeeper) setAdmissionCondition(err error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	var violation ResourceAdmissionRuleViolationError
+	switch {
+	case errors.As(err, &violation):
+		h.app.Status.SetConditions(condition.ErrorCondition(ResourceAdmissionConditionType, err))
+	case err == nil && h.app.Status.GetCondition(ResourceAdmissionConditionType).Status == corev1.ConditionFalse:
+		h.app.Status.SetConditions(condition.ReadyCondition(ResourceAdmissionConditionType))
+	default:
+	}
+}
+
 // ResourceAdmissionHandler defines the handler to validate the admission of resource operation
 type ResourceAdmissionHandler interface {
 	Validate(ctx context.Context, manifests []*unstructured.Unstructured) error
@@ -109,3 +141,110 @@ func (h *ResourceTypeAdmissionHandler) Validate(_ context.Context, manifests []*
 	}
 	return nil
 }
+
+// ResourceAdmissionRuleViolationError identifies the resource operation denied by the rule in ResourceAdmissionPolicy
+type ResourceAdmissionRuleViolationError struct {
+	Policy    string
+	Rule      string
+	Message   string
+	Operation v1alpha1.ResourceAdmissionOperation
+	Resource  *unstructured.Unstructured
+}
+
+// Error implements the error interface
+func (err ResourceAdmissionRuleViolationError) Error() string {
+	msg := fmt.Sprintf("forbidden resource: %s of %s %s/%s is denied by rule %s in ResourceAdmissionPolicy %s",
+		strings.ToLower(string(err.Operation)), err.Resource.GetKind(), err.Resource.GetNamespace(), err.Resource.GetName(), err.Rule, err.Policy)
+	if err.Message != "" {
+		msg += ": " + err.Message
+	}
+	return msg
+}
+
+// RuleAdmissionHandler defines the handler to validate the resource operation with the CEL rules declared in
+// ResourceAdmissionPolicy
+type RuleAdmissionHandler struct {
+	cli       client.Client
+	app       *v1beta1.Application
+	operation v1alpha1.ResourceAdmissionOperation
+	// policies is the snapshot shared by the handlers of the same resource keeper, a new snapshot is used if not set
+	policies *admissionPolicySnapshot
+}
+
+// admissionPolicySnapshot holds the ResourceAdmissionPolicy listed once for all the dispatches and deletions of one
+// reconcile, the list is served by the informer cache of the controller client
+type admissionPolicySnapshot struct {
+	mu       sync.Mutex
+	loaded   bool
+	policies []v1alpha1.ResourceAdmissionPolicy
+}
+
+// get returns the policies sorted by name, the policies are listed at the first call
+func (s *admissionPolicySnapshot) get(ctx context.Context, cli client.Client) ([]v1alpha1.ResourceAdmissionPolicy, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.loaded {
+		return s.policies, nil
+	}
+	policies := &v1alpha1.ResourceAdmissionPolicyList{}
+	if err := cli.List(multicluster.ContextInLocalCluster(ctx), policies); err != nil {
+		// skip the validation if the ResourceAdmissionPolicy CRD is not installed
+		if !meta.IsNoMatchError(err) && !runtime.IsNotRegisteredError(err) {
+			return nil, errors.Wrapf(err, "failed to list ResourceAdmissionPolicy")
+		}
+	}
+	sort.Slice(policies.Items, func(i, j int) bool { return policies.Items[i].Name < policies.Items[j].Name })
+	s.policies, s.loaded = policies.Items, true
+	return s.policies, nil
+}
+
+// Validate check if the resource operation violates any rule in ResourceAdmissionPolicy
+func (h *RuleAdmissionHandler) Validate(ctx context.Context, manifests []*unstructured.Unstructured) error {
+	snapshot := h.policies
+	if snapshot == nil {
+		snapshot = &admissionPolicySnapshot{}
+	}
+	policies, err := snapshot.get(ctx, h.cli)
+	if err != nil {
+		return err
+	}
+	app := map[string]interface{}{
+		"name":        h.app.GetName(),
+		"namespace":   h.app.GetNamespace(),
+		"labels":      h.app.GetLabels(),
+		"annotations": h.app.GetAnnotations(),
+	}
+	for _, manifest := range manifests {
+		if manifest == nil {
+			continue
+		}
+		cluster := oam.GetCluster(manifest)
+		if cluster == "" {
+			cluster = multicluster.ClusterLocalName
+		}
+		vars := map[string]interface{}{
+			"object":      manifest.Object,
+			"operation":   string(h.operation),
+			"cluster":     cluster,
+			"application": app,
+		}
+		for _, policy := range policies {
+			for _, rule := range policy.Spec.Rules {
+				if !rule.Match(h.operation, manifest) {
+					continue
+				}
+				admitted, err := cel.EvalBool(rule.Expression, vars)
+				if err != nil {
+					if rule.FailurePolicy == v1alpha1.ResourceAdmissionFailurePolicyIgnore {
+						continue
+					}
+					return ResourceAdmissionRuleViolationError{Policy: policy.Name, Rule: rule.Name, Message: err.Error(), Operation: h.operation, Resource: manifest}
+				}
+				if !admitted {
+					return ResourceAdmissionRuleViolationError{Policy: policy.Name, Rule: rule.Name, Message: rule.Message, Operation: h.operation, Resource: manifest}
+				}
+			}
+		}
+	}
+	return nil
+}
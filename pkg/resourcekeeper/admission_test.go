@@ -20,11 +20,18 @@ import (
 	"context"
 	"testing"
 
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1alpha1"
 	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1beta1"
+	"github.com/oam-dev/kubevela/pkg/utils/common"
 )
 
 func TestNamespaceAdmissionHandler_Validate(t *testing.T) {
@@ -79,3 +86,75 @@ func TestResourceTypeAdmissionHandler_Validate(t *testing.T) {
 	AllowResourceTypes = "whitelist:Service.v1,Secret.v1"
 	r.NoError((&ResourceTypeAdmissionHandler{}).Validate(context.Background(), objs))
 }
+
+func TestRuleAdmissionHandler_Validate(t *testing.T) {
+	r := require.New(t)
+	policy := &v1alpha1.ResourceAdmissionPolicy{
+		ObjectMeta: v1.ObjectMeta{Name: "security"},
+		Spec: v1alpha1.ResourceAdmissionPolicySpec{Rules: []v1alpha1.ResourceAdmissionRule{{
+			Name:       "deny-privileged",
+			Operations: []v1alpha1.ResourceAdmissionOperation{v1alpha1.ResourceAdmissionOperationDispatch},
+			Selector:   &v1alpha1.ResourcePolicyRuleSelector{ResourceTypes: []string{"Pod"}},
+			Expression: `!object.spec.containers.exists(c, has(c.securityContext) && has(c.securityContext.privileged) && c.securityContext.privileged)`,
+			Message:    "privileged container is not allowed",
+		}, {
+			Name:       "no-loadbalancer",
+			Selector:   &v1alpha1.ResourcePolicyRuleSelector{ResourceTypes: []string{"Service"}, Namespaces: []string{"prod"}},
+			Expression: `!has(object.spec.type) || object.spec.type != "LoadBalancer"`,
+		}, {
+			Name:          "ignore-error",
+			Expression:    `object.spec.notExists == "x"`,
+			FailurePolicy: v1alpha1.ResourceAdmissionFailurePolicyIgnore,
+		}}},
+	}
+	listed := 0
+	cli := fake.NewClientBuilder().WithScheme(common.Scheme).WithObjects(policy).WithInterceptorFuncs(interceptor.Funcs{
+		List: func(ctx context.Context, cli client.WithWatch, list client.ObjectList, opts ...client.ListOption) error {
+			listed++
+			return cli.List(ctx, list, opts...)
+		},
+	}).Build()
+	app := &v1beta1.Application{ObjectMeta: v1.ObjectMeta{Name: "app", Namespace: "prod"}}
+	newPod := func(privileged bool) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "Pod",
+			"metadata":   map[string]interface{}{"name": "pod", "namespace": "prod"},
+			"spec": map[string]interface{}{"containers": []interface{}{map[string]interface{}{
+				"name":            "main",
+				"securityContext": map[string]interface{}{"privileged": privileged},
+			}}},
+		}}
+	}
+	svc := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Service",
+		"metadata":   map[string]interface{}{"name": "svc", "namespace": "prod"},
+		"spec":       map[string]interface{}{"type": "LoadBalancer"},
+	}}
+
+	dispatch := &RuleAdmissionHandler{cli: cli, app: app, operation: v1alpha1.ResourceAdmissionOperationDispatch}
+	r.NoError(dispatch.Validate(context.Background(), []*unstructured.Unstructured{newPod(false)}))
+	err := dispatch.Validate(context.Background(), []*unstructured.Unstructured{newPod(true)})
+	var violation ResourceAdmissionRuleViolationError
+	r.True(errors.As(err, &violation))
+	r.Equal("deny-privileged", violation.Rule)
+	r.Contains(err.Error(), "privileged container is not allowed")
+	err = dispatch.Validate(context.Background(), []*unstructured.Unstructured{svc})
+	r.ErrorAs(err, &violation)
+	r.Equal("no-loadbalancer", violation.Rule)
+
+	del := &RuleAdmissionHandler{cli: cli, app: app, operation: v1alpha1.ResourceAdmissionOperationDelete}
+	r.NoError(del.Validate(context.Background(), []*unstructured.Unstructured{newPod(true)}))
+
+	listed = 0
+	rk := &resourceKeeper{Client: cli, app: app}
+	r.Error(rk.AdmissionCheck(context.Background(), v1alpha1.ResourceAdmissionOperationDispatch, []*unstructured.Unstructured{newPod(true)}))
+	cond := app.Status.GetCondition(ResourceAdmissionConditionType)
+	r.Equal(corev1.ConditionFalse, cond.Status)
+	r.Contains(cond.Message, "deny-privileged")
+	r.NoError(rk.AdmissionCheck(context.Background(), v1alpha1.ResourceAdmissionOperationDispatch, []*unstructured.Unstructured{newPod(false)}))
+	r.Equal(corev1.ConditionTrue, app.Status.GetCondition(ResourceAdmissionConditionType).Status)
+	// the policies are listed once by the resource keeper
+	r.Equal(1, listed)
+}
@@ -23,6 +23,7 @@ import (
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1alpha1"
 	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1beta1"
 	"github.com/oam-dev/kubevela/pkg/auth"
 	"github.com/oam-dev/kubevela/pkg/multicluster"
@@ -50,7 +51,7 @@ func newDeleteConfig(options ...DeleteOption) *deleteConfig {
 // Delete delete resources
 func (h *resourceKeeper) Delete(ctx context.Context, manifests []*unstructured.Unstructured, options ...DeleteOption) (err error) {
 	h.ClearNamespaceForClusterScopedResources(manifests)
-	if err = h.AdmissionCheck(ctx, manifests); err != nil {
+	if err = h.AdmissionCheck(ctx, v1alpha1.ResourceAdmissionOperationDelete, manifests); err != nil {
 		return err
 	}
 	for _, manifest := range manifests {
@@ -45,8 +45,9 @@ type DispatchOption interface {
 
 type dispatchConfig struct {
 	rtConfig
-	metaOnly bool
-	creator  string
+	metaOnly     bool
+	creator      string
+	dryRunReport *DispatchDryRunReport
 }
 
 func newDispatchConfig(options ...DispatchOption) *dispatchConfig {
@@ -65,7 +66,7 @@ func (h *resourceKeeper) Dispatch(ctx context.Context, manifests []*unstructured
 	}
 	h.ClearNamespaceForClusterScopedResources(manifests)
 	// 0. check admission
-	if err = h.AdmissionCheck(ctx, manifests); err != nil {
+	if err = h.AdmissionCheck(ctx, v1alpha1.ResourceAdmissionOperationDispatch, manifests); err != nil {
 		return err
 	}
 	// 1. pre-dispatch check
@@ -73,6 +74,10 @@ func (h *resourceKeeper) Dispatch(ctx context.Context, manifests []*unstructured
 	if len(applyOpts) > 0 {
 		opts = append(opts, applyOpts...)
 	}
+	if cfg := newDispatchConfig(options...); cfg.dryRunReport != nil {
+		cfg.dryRunReport.Resources = append(cfg.dryRunReport.Resources, h.dryRunDispatch(ctx, manifests, opts)...)
+		return nil
+	}
 	if utilfeature.DefaultMutableFeatureGate.Enabled(features.PreDispatchDryRun) {
 		if err = h.dispatch(ctx,
 			velaslices.Map(manifests, func(manifest *unstructured.Unstructured) *unstructured.Unstructured { return manifest.DeepCopy() }),
@@ -144,19 +149,7 @@ func (h *resourceKeeper) dispatch(ctx context.Context, manifests []*unstructured
 	errs := velaslices.ParMap(manifests, func(manifest *unstructured.Unstructured) error {
 		applyCtx := multicluster.ContextWithClusterName(ctx, oam.GetCluster(manifest))
 		applyCtx = auth.ContextWithUserInfo(applyCtx, h.app)
-		ao := applyOpts
-		if h.isShared(manifest) {
-			ao = append([]apply.ApplyOption{apply.SharedByApp(h.app)}, ao...)
-		}
-		if h.isReadOnly(manifest) {
-			ao = append([]apply.ApplyOption{apply.ReadOnly()}, ao...)
-		}
-		if h.canTakeOver(manifest) {
-			ao = append([]apply.ApplyOption{apply.TakeOver()}, ao...)
-		}
-		if strategy := h.getUpdateStrategy(manifest); strategy != nil {
-			ao = append([]apply.ApplyOption{apply.WithUpdateStrategy(*strategy)}, ao...)
-		}
+		ao := h.getApplyOptions(manifest, applyOpts)
 		manifest, err := ApplyStrategies(applyCtx, h, manifest, v1alpha1.ApplyOnceStrategyOnAppUpdate)
 		if err != nil {
 			return errors.Wrapf(err, "failed to apply once policy for application %s,%s", h.app.Name, err.Error())
@@ -165,3 +158,21 @@ func (h *resourceKeeper) dispatch(ctx context.Context, manifests []*unstructured
 	}, velaslices.Parallelism(MaxDispatchConcurrent))
 	return velaerrors.AggregateErrors(errs)
 }
+
+// getApplyOptions prepends the apply options decided by the resource policies of the application to the manifest
+func (h *resourceKeeper) getApplyOptions(manifest *unstructured.Unstructured, applyOpts []apply.ApplyOption) []apply.ApplyOption {
+	ao := applyOpts
+	if h.isShared(manifest) {
+		ao = append([]apply.ApplyOption{apply.SharedByApp(h.app)}, ao...)
+	}
+	if h.isReadOnly(manifest) {
+		ao = append([]apply.ApplyOption{apply.ReadOnly()}, ao...)
+	}
+	if h.canTakeOver(manifest) {
+		ao = append([]apply.ApplyOption{apply.TakeOver()}, ao...)
+	}
+	if strategy := h.getUpdateStrategy(manifest); strategy != nil {
+		ao = append([]apply.ApplyOption{apply.WithUpdateStrategy(*strategy)}, ao...)
+	}
+	return ao
+}
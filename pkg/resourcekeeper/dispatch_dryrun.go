@@ -0,0 +1,89 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcekeeper
+
+import (
+	"context"
+
+	velaslices "github.com/kubevela/pkg/util/slices"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1alpha1"
+	"github.com/oam-dev/kubevela/pkg/auth"
+	"github.com/oam-dev/kubevela/pkg/multicluster"
+	"github.com/oam-dev/kubevela/pkg/oam"
+	"github.com/oam-dev/kubevela/pkg/utils/apply"
+)
+
+// DispatchDryRunResource the simulated dispatch result for one manifest
+type DispatchDryRunResource struct {
+	// Cluster the cluster the manifest is dispatched to
+	Cluster string `json:"cluster"`
+	// Manifest the manifest to dispatch
+	Manifest *unstructured.Unstructured `json:"manifest"`
+	// Live the object in the cluster before the dispatch, nil if the object does not exist
+	Live *unstructured.Unstructured `json:"live,omitempty"`
+	// Result the object returned by the server-side dry-run, it equals to the live object if the update would be skipped
+	Result *unstructured.Unstructured `json:"result,omitempty"`
+	// ReadOnly the object would not be updated as it is matched by the read-only policy
+	ReadOnly bool `json:"readOnly,omitempty"`
+	// Error the reason why the manifest cannot be dispatched, such as the object is managed by other application
+	Error string `json:"error,omitempty"`
+}
+
+// DispatchDryRunReport the report of the dispatch dry-run
+type DispatchDryRunReport struct {
+	Resources []DispatchDryRunResource `json:"resources,omitempty"`
+}
+
+// dryRunDispatch applies the manifests with the same policies as dispatch through server-side dry-run. The failure of
+// one manifest is recorded in its result instead of interrupting the others.
+func (h *resourceKeeper) dryRunDispatch(ctx context.Context, manifests []*unstructured.Unstructured, applyOpts []apply.ApplyOption) []DispatchDryRunResource {
+	return velaslices.ParMap(manifests, func(manifest *unstructured.Unstructured) DispatchDryRunResource {
+		res := DispatchDryRunResource{Cluster: oam.GetCluster(manifest), Manifest: manifest, ReadOnly: h.isReadOnly(manifest)}
+		if res.Cluster == "" {
+			res.Cluster = multicluster.ClusterLocalName
+		}
+		applyCtx := multicluster.ContextWithClusterName(ctx, oam.GetCluster(manifest))
+		applyCtx = auth.ContextWithUserInfo(applyCtx, h.app)
+		live := &unstructured.Unstructured{}
+		live.SetGroupVersionKind(manifest.GroupVersionKind())
+		if err := h.Get(applyCtx, client.ObjectKeyFromObject(manifest), live); err == nil {
+			res.Live = live
+		} else if !kerrors.IsNotFound(err) {
+			res.Error = err.Error()
+			return res
+		}
+		desired, err := ApplyStrategies(applyCtx, h, manifest.DeepCopy(), v1alpha1.ApplyOnceStrategyOnAppUpdate)
+		if err != nil {
+			res.Error = "failed to apply once policy: " + err.Error()
+			return res
+		}
+		if err = h.applicator.Apply(applyCtx, desired, append(h.getApplyOptions(manifest, applyOpts), apply.DryRunAll())...); err != nil {
+			res.Error = err.Error()
+			return res
+		}
+		res.Result = desired
+		// the update is skipped without requesting the server, the object would be kept as it is
+		if res.Live != nil && desired.GetResourceVersion() == "" {
+			res.Result = res.Live.DeepCopy()
+		}
+		return res
+	}, velaslices.Parallelism(MaxDispatchConcurrent))
+}
@@ -0,0 +1,123 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcekeeper
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1alpha1"
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1beta1"
+	"github.com/oam-dev/kubevela/pkg/oam"
+	"github.com/oam-dev/kubevela/pkg/utils/common"
+)
+
+// newDryRunFakeClient emulates the server-side dry-run, which is not supported by the fake client, by executing the
+// dry-run requests in a shadow client holding the same objects
+func newDryRunFakeClient(objs ...client.Object) client.Client {
+	var shadowObjs []client.Object
+	for _, obj := range objs {
+		shadowObjs = append(shadowObjs, obj.DeepCopyObject().(client.Object))
+	}
+	shadow := fake.NewClientBuilder().WithScheme(common.Scheme).WithObjects(shadowObjs...).Build()
+	return fake.NewClientBuilder().WithScheme(common.Scheme).WithObjects(objs...).WithInterceptorFuncs(interceptor.Funcs{
+		Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+			if createOpts := (&client.CreateOptions{}).ApplyOptions(opts); len(createOpts.DryRun) > 0 {
+				return shadow.Create(ctx, obj)
+			}
+			return c.Create(ctx, obj, opts...)
+		},
+		Patch: func(ctx context.Context, c client.WithWatch, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+			if patchOpts := (&client.PatchOptions{}).ApplyOptions(opts); len(patchOpts.DryRun) > 0 {
+				return shadow.Patch(ctx, obj, patch)
+			}
+			return c.Patch(ctx, obj, patch, opts...)
+		},
+	}).Build()
+}
+
+func TestResourceKeeperDispatchDryRun(t *testing.T) {
+	r := require.New(t)
+	ctx := context.Background()
+	newConfigMap := func(name string, app string, value string) *unstructured.Unstructured {
+		cm := &unstructured.Unstructured{}
+		cm.SetGroupVersionKind(corev1.SchemeGroupVersion.WithKind("ConfigMap"))
+		cm.SetName(name)
+		cm.SetNamespace("default")
+		if app != "" {
+			cm.SetLabels(map[string]string{oam.LabelAppName: app, oam.LabelAppNamespace: "default"})
+		}
+		cm.Object["data"] = map[string]interface{}{"key": value}
+		return cm
+	}
+	cli := newDryRunFakeClient(
+		newConfigMap("managed", "app", "old"),
+		newConfigMap("other", "other-app", "old"),
+		newConfigMap("read-only", "", "old"),
+	)
+	_rk, err := NewResourceKeeper(ctx, cli, &v1beta1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "default", Generation: 1},
+	})
+	r.NoError(err)
+	rk := _rk.(*resourceKeeper)
+	rk.readOnlyPolicy = &v1alpha1.ReadOnlyPolicySpec{Rules: []v1alpha1.ReadOnlyPolicyRule{{
+		Selector: v1alpha1.ResourcePolicyRuleSelector{ResourceNames: []string{"read-only"}},
+	}}}
+
+	report := &DispatchDryRunReport{}
+	manifests := []*unstructured.Unstructured{
+		newConfigMap("created", "app", "new"),
+		newConfigMap("managed", "app", "new"),
+		newConfigMap("other", "app", "new"),
+		newConfigMap("read-only", "app", "new"),
+	}
+	r.NoError(rk.Dispatch(ctx, manifests, nil, DryRunDispatchOption{Report: report}))
+	r.Len(report.Resources, 4)
+
+	created := report.Resources[0]
+	r.Equal("local", created.Cluster)
+	r.Nil(created.Live)
+	r.Empty(created.Error)
+	r.Equal("new", created.Result.Object["data"].(map[string]interface{})["key"])
+
+	managed := report.Resources[1]
+	r.Equal("old", managed.Live.Object["data"].(map[string]interface{})["key"])
+	r.Equal("new", managed.Result.Object["data"].(map[string]interface{})["key"])
+
+	r.Contains(report.Resources[2].Error, "managed by other application")
+	r.Nil(report.Resources[2].Result)
+
+	readOnly := report.Resources[3]
+	r.True(readOnly.ReadOnly)
+	r.Equal(readOnly.Live, readOnly.Result)
+
+	// nothing is persisted
+	r.Nil(rk._currentRT)
+	r.True(kerrors.IsNotFound(cli.Get(ctx, client.ObjectKey{Name: "created", Namespace: "default"}, &corev1.ConfigMap{})))
+	cm := &corev1.ConfigMap{}
+	r.NoError(cli.Get(ctx, client.ObjectKey{Name: "managed", Namespace: "default"}, cm))
+	r.Equal("old", cm.Data["key"])
+}
@@ -73,6 +73,10 @@ type gcConfig struct {
 	order v1alpha1.GarbageCollectOrder
 
 	appRevisionLimit int
+
+	dryRunReport *GCDryRunReport
+
+	nextDeleteTime *time.Time
 }
 
 func newGCConfig(options ...GCOption) *gcConfig {
@@ -139,6 +143,9 @@ func (h *resourceKeeper) garbageCollect(ctx context.Context, cfg *gcConfig) (fin
 		cfg:            cfg,
 	}
 	gc.Init()
+	if cfg.dryRunReport != nil {
+		return gc.DryRun(ctx, cfg.dryRunReport)
+	}
 	// Mark Stage
 	if !cfg.disableMark {
 		if err = gc.Mark(ctx); err != nil {
@@ -214,14 +221,16 @@ func (h *gcHandler) Init() {
 	h.cache.registerResourceTrackers(rts...)
 }
 
-func (h *gcHandler) scan(ctx context.Context) (inactiveRTs []*v1beta1.ResourceTracker) {
+// scan find the resourcetrackers to be marked as deleted. If sampling is enabled, the check for legacy resources in
+// passive mode will only be executed with the probability of MarkWithProbability.
+func (h *gcHandler) scan(ctx context.Context, sampling bool) (inactiveRTs []*v1beta1.ResourceTracker) {
 	if h.app.GetDeletionTimestamp() != nil {
 		inactiveRTs = append(inactiveRTs, h._historyRTs...)
 		inactiveRTs = append(inactiveRTs, h._currentRT, h._rootRT, h._crRT)
 	} else {
 		if h.cfg.passive {
 			inactiveRTs = []*v1beta1.ResourceTracker{}
-			if rand.Float64() > MarkWithProbability { //nolint
+			if sampling && rand.Float64() > MarkWithProbability { //nolint
 				return inactiveRTs
 			}
 			for _, rt := range h._historyRTs {
@@ -249,7 +258,7 @@ func (h *gcHandler) scan(ctx context.Context) (inactiveRTs []*v1beta1.ResourceTr
 func (h *gcHandler) Mark(ctx context.Context) error {
 	cb := h.monitor("mark")
 	defer cb()
-	inactiveRTs := h.scan(ctx)
+	inactiveRTs := h.scan(ctx, true)
 	for _, rt := range inactiveRTs {
 		if rt != nil && rt.GetDeletionTimestamp() == nil {
 			if err := h.Client.Delete(ctx, rt); err != nil && !kerrors.IsNotFound(err) {
@@ -269,20 +278,59 @@ func (h *gcHandler) Mark(ctx context.Context) error {
 }
 
 // checkAndRemoveResourceTrackerFinalizer return (all resource recycled, error)
+// Resources kept by the ttl of garbage-collect policy are treated as recycled until they are due, the finalizer will
+// not be removed and the due time will be recorded in the resourcetracker.
 func (h *gcHandler) checkAndRemoveResourceTrackerFinalizer(ctx context.Context, rt *v1beta1.ResourceTracker) (bool, v1beta1.ManagedResource, error) {
-	for _, mr := range rt.Spec.ManagedResources {
+	pending, updated := false, false
+	for i, mr := range rt.Spec.ManagedResources {
 		entry := h.cache.get(auth.ContextWithUserInfo(ctx, h.app), mr)
 		if entry.err != nil {
 			return false, entry.mr, entry.err
 		}
 		if entry.exists && entry.gcExecutorRT == rt {
-			return false, entry.mr, nil
+			deleteAfter := h.findDeleteAfter(entry, rt)
+			if deleteAfter == nil || !time.Now().Before(deleteAfter.Time) {
+				return false, entry.mr, nil
+			}
+			pending = true
+			h.recordNextDeleteTime(deleteAfter.Time)
+			if !deleteAfter.Equal(mr.DeleteAfter) {
+				rt.Spec.ManagedResources[i].DeleteAfter = deleteAfter
+				updated = true
+			}
 		}
 	}
+	if pending {
+		if updated {
+			return true, v1beta1.ManagedResource{}, h.Client.Update(ctx, rt)
+		}
+		return true, v1beta1.ManagedResource{}, nil
+	}
 	meta.RemoveFinalizer(rt, resourcetracker.Finalizer)
 	return true, v1beta1.ManagedResource{}, h.Client.Update(ctx, rt)
 }
 
+// findDeleteAfter find the time when the outdated resource should be recycled according to the ttl in garbage-collect
+// policy. The resource is regarded as out of use since the resourcetracker is marked as deleted. Return nil if the
+// resource is not kept by ttl.
+func (h *gcHandler) findDeleteAfter(entry *resourceCacheEntry, rt *v1beta1.ResourceTracker) *metav1.Time {
+	if h.garbageCollectPolicy == nil || h.app.GetDeletionTimestamp() != nil || rt.GetDeletionTimestamp() == nil {
+		return nil
+	}
+	ttl, found := h.garbageCollectPolicy.FindTTL(entry.obj)
+	if !found {
+		return nil
+	}
+	return &metav1.Time{Time: rt.GetDeletionTimestamp().Add(ttl)}
+}
+
+// recordNextDeleteTime records the due time of the resource kept by ttl if it is earlier than the recorded one
+func (h *gcHandler) recordNextDeleteTime(t time.Time) {
+	if h.cfg.nextDeleteTime != nil && (h.cfg.nextDeleteTime.IsZero() || t.Before(*h.cfg.nextDeleteTime)) {
+		*h.cfg.nextDeleteTime = t
+	}
+}
+
 func (h *gcHandler) Sweep(ctx context.Context) (finished bool, waiting []v1beta1.ManagedResource, err error) {
 	cb := h.monitor("sweep")
 	defer cb()
@@ -379,10 +427,13 @@ func (h *gcHandler) deleteManagedResource(ctx context.Context, mr v1beta1.Manage
 	if entry.err != nil {
 		return entry.err
 	}
-	if entry.exists {
-		return DeleteManagedResourceInApplication(ctx, h.Client, mr, entry.obj, h.app)
+	if !entry.exists {
+		return nil
 	}
-	return nil
+	if deleteAfter := h.findDeleteAfter(entry, rt); deleteAfter != nil && time.Now().Before(deleteAfter.Time) {
+		return nil
+	}
+	return DeleteManagedResourceInApplication(ctx, h.Client, mr, entry.obj, h.app)
 }
 
 // DeleteManagedResourceInApplication delete managed resource in application
@@ -0,0 +1,205 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcekeeper
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1alpha1"
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1beta1"
+	"github.com/oam-dev/kubevela/pkg/auth"
+	"github.com/oam-dev/kubevela/pkg/multicluster"
+	"github.com/oam-dev/kubevela/pkg/oam"
+	"github.com/oam-dev/kubevela/pkg/resourcetracker"
+	"github.com/oam-dev/kubevela/pkg/utils/apply"
+)
+
+// GCDryRunAction the action that garbage collection would take on the managed resource
+type GCDryRunAction string
+
+const (
+	// GCDryRunActionDelete the managed resource would be deleted
+	GCDryRunActionDelete GCDryRunAction = "delete"
+	// GCDryRunActionOrphan the managed resource would be left in the cluster and detached from the application
+	GCDryRunActionOrphan GCDryRunAction = "orphan"
+	// GCDryRunActionReleaseShared the application would be removed from the sharers of the managed resource
+	GCDryRunActionReleaseShared GCDryRunAction = "release-shared"
+	// GCDryRunActionKeep the managed resource is outdated but would be kept until the ttl expires
+	GCDryRunActionKeep GCDryRunAction = "keep"
+)
+
+// GCDryRunResource the simulated garbage collection result for one managed resource
+type GCDryRunResource struct {
+	v1beta1.ManagedResource `json:",inline"`
+	// Action the action that would be taken on the resource
+	Action GCDryRunAction `json:"action"`
+	// Reason the human-readable reason of the action
+	Reason string `json:"reason"`
+	// ResourceTracker the name of the outdated resourcetracker that recycles the resource
+	ResourceTracker string `json:"resourceTracker"`
+	// Rule the garbage-collect policy rule that decides the action, empty if no rule is matched
+	Rule string `json:"rule,omitempty"`
+}
+
+// GCDryRunReport the report of the garbage collection dry-run
+type GCDryRunReport struct {
+	// InactiveResourceTrackers the resourcetrackers that would be marked as deleted or are already being deleted
+	InactiveResourceTrackers []string `json:"inactiveResourceTrackers,omitempty"`
+	// Resources the managed resources that would be recycled
+	Resources []GCDryRunResource `json:"resources,omitempty"`
+}
+
+// GroupByCluster group the resources in the report by cluster, resources in the local cluster are grouped into
+// multicluster.ClusterLocalName
+func (in *GCDryRunReport) GroupByCluster() map[string][]GCDryRunResource {
+	groups := map[string][]GCDryRunResource{}
+	for _, res := range in.Resources {
+		cluster := res.Cluster
+		if cluster == "" {
+			cluster = multicluster.ClusterLocalName
+		}
+		groups[cluster] = append(groups[cluster], res)
+	}
+	return groups
+}
+
+// DryRun simulate the Mark, Sweep and Finalize stages and record the managed resources to be recycled into the report.
+// Resourcetrackers to be marked are deep copied and marked in memory, so neither the resourcetrackers nor the managed
+// resources will be modified. Unlike the Mark stage, the check for legacy resources in passive mode is always executed.
+func (h *gcHandler) DryRun(ctx context.Context, report *GCDryRunReport) (finished bool, waiting []v1beta1.ManagedResource, err error) {
+	cb := h.monitor("dry-run")
+	defer cb()
+	inactive := map[*v1beta1.ResourceTracker]bool{}
+	if !h.cfg.disableMark {
+		for _, rt := range h.scan(ctx, false) {
+			inactive[rt] = true
+		}
+	}
+	now := metav1.Now()
+	var rts []*v1beta1.ResourceTracker
+	for _, rt := range append(h._historyRTs, h._currentRT, h._rootRT) {
+		if rt == nil {
+			continue
+		}
+		_rt := rt.DeepCopy()
+		if inactive[rt] && _rt.GetDeletionTimestamp() == nil {
+			_rt.SetDeletionTimestamp(&now)
+		}
+		rts = append(rts, _rt)
+	}
+	cache := newResourceCache(h.Client, h.app)
+	cache.registerResourceTrackers(rts...)
+
+	_ctx := auth.ContextWithUserInfo(ctx, h.app)
+	for _, rt := range rts {
+		if rt.GetDeletionTimestamp() == nil || !meta.FinalizerExists(rt, resourcetracker.Finalizer) {
+			continue
+		}
+		report.InactiveResourceTrackers = append(report.InactiveResourceTrackers, rt.Name)
+		for _, mr := range rt.Spec.ManagedResources {
+			entry := cache.get(_ctx, mr)
+			if entry.gcExecutorRT != rt {
+				continue
+			}
+			if entry.err != nil {
+				return false, waiting, entry.err
+			}
+			if !entry.exists {
+				continue
+			}
+			res := h.dryRunManagedResource(entry, rt)
+			if res.Action != GCDryRunActionKeep {
+				waiting = append(waiting, mr)
+			}
+			report.Resources = append(report.Resources, res)
+		}
+	}
+	sort.SliceStable(report.Resources, func(i, j int) bool {
+		return report.Resources[i].ResourceKey() < report.Resources[j].ResourceKey()
+	})
+	return len(waiting) == 0, waiting, nil
+}
+
+// dryRunManagedResource decide the action on the existing managed resource recycled by the given resourcetracker,
+// following the same rules as deleteManagedResource and DeleteManagedResourceInApplication
+func (h *gcHandler) dryRunManagedResource(entry *resourceCacheEntry, rt *v1beta1.ResourceTracker) GCDryRunResource {
+	res := GCDryRunResource{ManagedResource: entry.mr, ResourceTracker: rt.Name}
+	outdated := fmt.Sprintf("resourcetracker %s is outdated", rt.Name)
+	if h.app.GetDeletionTimestamp() != nil {
+		outdated = "application is being deleted"
+	}
+	if deleteAfter := h.findDeleteAfter(entry, rt); deleteAfter != nil && time.Now().Before(deleteAfter.Time) {
+		res.Action = GCDryRunActionKeep
+		res.Reason = fmt.Sprintf("%s, kept by ttl until %s", outdated, deleteAfter.Format(time.RFC3339))
+		res.Rule = h.findGarbageCollectRule(entry.obj, func(v1alpha1.GarbageCollectPolicyRule) bool { return true })
+		return res
+	}
+	if annotations := entry.obj.GetAnnotations(); annotations != nil && annotations[oam.AnnotationAppSharedBy] != "" {
+		if sharedBy := apply.RemoveSharer(annotations[oam.AnnotationAppSharedBy], h.app); sharedBy != "" {
+			res.Action = GCDryRunActionReleaseShared
+			res.Reason = fmt.Sprintf("%s, resource is still shared by %s", outdated, sharedBy)
+			return res
+		}
+	}
+	// the first matched rule setting the propagation decides it, the same as FindDeleteOption
+	isPropagationRule := func(rule v1alpha1.GarbageCollectPolicyRule) bool {
+		return rule.Propagation != nil && (*rule.Propagation == v1alpha1.GarbageCollectPropagationOrphan ||
+			*rule.Propagation == v1alpha1.GarbageCollectPropagationCascading)
+	}
+	isOrphan := false
+	if h.garbageCollectPolicy != nil {
+		isOrphan, _ = h.garbageCollectPolicy.FindDeleteOption(entry.obj)
+	}
+	switch {
+	case entry.mr.SkipGC:
+		res.Action = GCDryRunActionOrphan
+		res.Reason = fmt.Sprintf("%s, resource is marked to skip garbage collection", outdated)
+	case hasOrphanFinalizer(h.app):
+		res.Action = GCDryRunActionOrphan
+		res.Reason = fmt.Sprintf("%s, application is deleted with orphan finalizer", outdated)
+	case isOrphan:
+		res.Action = GCDryRunActionOrphan
+		res.Reason = fmt.Sprintf("%s, propagation is orphan", outdated)
+		res.Rule = h.findGarbageCollectRule(entry.obj, isPropagationRule)
+	default:
+		res.Action = GCDryRunActionDelete
+		res.Reason = outdated
+		res.Rule = h.findGarbageCollectRule(entry.obj, func(v1alpha1.GarbageCollectPolicyRule) bool { return true })
+	}
+	return res
+}
+
+// findGarbageCollectRule find the first rule in the garbage-collect policy that matches the manifest and the filter,
+// return the description of the rule or empty string if not found
+func (h *gcHandler) findGarbageCollectRule(manifest *unstructured.Unstructured, filter func(v1alpha1.GarbageCollectPolicyRule) bool) string {
+	if h.garbageCollectPolicy == nil {
+		return ""
+	}
+	for i, rule := range h.garbageCollectPolicy.Rules {
+		if rule.Selector.Match(manifest) && filter(rule) {
+			return fmt.Sprintf("%s.rules[%d] (strategy: %s)", v1alpha1.GarbageCollectPolicyType, i, rule.Strategy)
+		}
+	}
+	return ""
+}
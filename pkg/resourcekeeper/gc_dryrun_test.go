@@ -0,0 +1,137 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcekeeper
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1alpha1"
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1beta1"
+	"github.com/oam-dev/kubevela/pkg/multicluster"
+	"github.com/oam-dev/kubevela/pkg/oam"
+	"github.com/oam-dev/kubevela/pkg/resourcetracker"
+	"github.com/oam-dev/kubevela/pkg/utils/common"
+)
+
+func TestResourceKeeperGarbageCollectDryRun(t *testing.T) {
+	r := require.New(t)
+	cli := fake.NewClientBuilder().WithScheme(common.Scheme).Build()
+	ctx := context.Background()
+
+	labels := map[string]string{
+		oam.LabelAppName:      "app",
+		oam.LabelAppNamespace: "default",
+		oam.LabelAppUID:       "uid",
+	}
+	createRT := func(gen int64, comps ...string) *v1beta1.ResourceTracker {
+		rt := &v1beta1.ResourceTracker{
+			ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("app-v%d", gen), Labels: labels, Finalizers: []string{resourcetracker.Finalizer}},
+			Spec: v1beta1.ResourceTrackerSpec{
+				Type:                  v1beta1.ResourceTrackerTypeVersioned,
+				ApplicationGeneration: gen,
+			},
+		}
+		r.NoError(cli.Create(ctx, rt))
+		for _, comp := range comps {
+			cm := &unstructured.Unstructured{}
+			cm.SetName(comp)
+			cm.SetNamespace("default")
+			cm.SetGroupVersionKind(corev1.SchemeGroupVersion.WithKind("ConfigMap"))
+			cm.SetLabels(map[string]string{
+				oam.LabelAppComponent: comp,
+				oam.LabelAppNamespace: "default",
+				oam.LabelAppName:      "app",
+			})
+			if comp == "shared" {
+				cm.SetAnnotations(map[string]string{oam.AnnotationAppSharedBy: "default/app,default/another"})
+			}
+			r.NoError(cli.Create(ctx, cm))
+			r.NoError(resourcetracker.RecordManifestsInResourceTracker(ctx, cli, rt, []*unstructured.Unstructured{cm}, true, false, ""))
+		}
+		return rt
+	}
+
+	rt := createRT(1, "blue", "legacy", "orphan", "cascade", "shared")
+	createRT(2, "green")
+	_rk, err := NewResourceKeeper(ctx, cli, &v1beta1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "default", UID: "uid", Generation: 2},
+	})
+	r.NoError(err)
+	rk := _rk.(*resourceKeeper)
+	rk.garbageCollectPolicy = &v1alpha1.GarbageCollectPolicySpec{Rules: []v1alpha1.GarbageCollectPolicyRule{{
+		Selector: v1alpha1.ResourcePolicyRuleSelector{CompNames: []string{"blue"}},
+		Strategy: v1alpha1.GarbageCollectStrategyAfterTTL,
+		TTL:      &metav1.Duration{Duration: time.Hour},
+	}, {
+		// the cascading rule matched first decides the propagation, the same as the real garbage collection
+		Selector:    v1alpha1.ResourcePolicyRuleSelector{CompNames: []string{"cascade"}},
+		Strategy:    v1alpha1.GarbageCollectStrategyOnAppUpdate,
+		Propagation: ptr.To[v1alpha1.GarbageCollectPropagation](v1alpha1.GarbageCollectPropagationCascading),
+	}, {
+		Selector:    v1alpha1.ResourcePolicyRuleSelector{CompNames: []string{"orphan", "cascade"}},
+		Strategy:    v1alpha1.GarbageCollectStrategyOnAppUpdate,
+		Propagation: ptr.To[v1alpha1.GarbageCollectPropagation](v1alpha1.GarbageCollectPropagationOrphan),
+	}}}
+
+	report := &GCDryRunReport{}
+	finished, waiting, err := rk.GarbageCollect(ctx, DryRunGCOption{Report: report})
+	r.NoError(err)
+	r.False(finished)
+	r.Equal(4, len(waiting))
+	r.Equal([]string{"app-v1"}, report.InactiveResourceTrackers)
+	actions := map[string]GCDryRunAction{}
+	rules := map[string]string{}
+	for _, res := range report.Resources {
+		r.Equal("app-v1", res.ResourceTracker)
+		actions[res.Name] = res.Action
+		rules[res.Name] = res.Rule
+	}
+	r.Equal(map[string]GCDryRunAction{
+		"blue":    GCDryRunActionKeep,
+		"legacy":  GCDryRunActionDelete,
+		"orphan":  GCDryRunActionOrphan,
+		"cascade": GCDryRunActionDelete,
+		"shared":  GCDryRunActionReleaseShared,
+	}, actions)
+	r.Equal("garbage-collect.rules[0] (strategy: afterTTL)", rules["blue"])
+	r.Equal("garbage-collect.rules[2] (strategy: onAppUpdate)", rules["orphan"])
+	r.Equal("garbage-collect.rules[1] (strategy: onAppUpdate)", rules["cascade"])
+	r.Equal("", rules["legacy"])
+	r.Equal(5, len(report.GroupByCluster()[multicluster.ClusterLocalName]))
+
+	// nothing is changed by dry-run
+	_rt := &v1beta1.ResourceTracker{}
+	r.NoError(cli.Get(ctx, client.ObjectKeyFromObject(rt), _rt))
+	r.Nil(_rt.GetDeletionTimestamp())
+	r.Equal(rt.ResourceVersion, _rt.ResourceVersion)
+	for _, name := range []string{"blue", "legacy", "orphan", "cascade", "shared", "green"} {
+		cm := &corev1.ConfigMap{}
+		r.NoError(cli.Get(ctx, client.ObjectKey{Namespace: "default", Name: name}, cm))
+		r.Equal("app", cm.GetLabels()[oam.LabelAppName])
+	}
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/pkg/errors"
 	appsv1 "k8s.io/api/apps/v1"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	ktypes "k8s.io/apimachinery/pkg/types"
 	"k8s.io/klog/v2"
@@ -69,11 +70,14 @@ func cleanUpApplicationRevision(ctx context.Context, h *gcHandler) error {
 		return err
 	}
 	appRevisionInUse := gatherUsingAppRevision(h.app)
-	appRevisionLimit := getApplicationRevisionLimitForApp(h.app, h.cfg.appRevisionLimit)
+	gcPolicy := getGarbageCollectPolicyForApp(h.app)
+	appRevisionLimit := getApplicationRevisionLimit(gcPolicy, h.cfg.appRevisionLimit)
 	needKill := len(sortedRevision) - appRevisionLimit - len(appRevisionInUse)
 	if h._rootRT == nil && h._currentRT == nil && len(h._historyRTs) == 0 && h._crRT == nil && h.app.DeletionTimestamp != nil {
 		needKill = len(sortedRevision)
 		appRevisionInUse = nil
+	} else if retention := getApplicationRevisionRetention(gcPolicy); retention != nil {
+		return cleanUpApplicationRevisionByRetention(ctx, h, sortedRevision, appRevisionInUse, retention.Duration, getApplicationRevisionLimit(gcPolicy, 0))
 	}
 	if needKill <= 0 {
 		return nil
@@ -97,6 +101,27 @@ func cleanUpApplicationRevision(ctx context.Context, h *gcHandler) error {
 	return nil
 }
 
+// cleanUpApplicationRevisionByRetention remove the appRevisions created before the retention. If the revision limit
+// is explicitly set in the garbage-collect policy, the latest revisions within the limit will be kept as well.
+func cleanUpApplicationRevisionByRetention(ctx context.Context, h *gcHandler, sortedRevision []v1beta1.ApplicationRevision, appRevisionInUse map[string]bool, retention time.Duration, keepLatest int) error {
+	expireTime := time.Now().Add(-retention)
+	var killed int
+	for i, rev := range sortedRevision {
+		if appRevisionInUse[rev.Name] || i >= len(sortedRevision)-keepLatest || rev.CreationTimestamp.Time.After(expireTime) {
+			continue
+		}
+		if err := h.Client.Delete(ctx, rev.DeepCopy()); err != nil && !kerrors.IsNotFound(err) {
+			return err
+		}
+		killed++
+	}
+	if killed > 0 {
+		klog.InfoS("Garbage collected app revisions by retention", "retention", retention.String(),
+			"total", len(sortedRevision), "using", len(appRevisionInUse), "kill", killed)
+	}
+	return nil
+}
+
 func cleanUpComponentRevision(ctx context.Context, h *gcHandler) error {
 	if h.cfg.disableComponentRevisionGC {
 		return nil
@@ -170,19 +195,42 @@ func gatherUsingAppRevision(app *v1beta1.Application) map[string]bool {
 	if app.Status.LatestRevision != nil && len(app.Status.LatestRevision.Name) != 0 {
 		usingRevision[app.Status.LatestRevision.Name] = true
 	}
+	// the revisions pinned to the clusters are still used by the resources in these clusters
+	if pins, err := oam.GetPlacementRevisions(app); err == nil {
+		for _, revision := range pins {
+			usingRevision[revision] = true
+		}
+	}
 	return usingRevision
 }
 
-func getApplicationRevisionLimitForApp(app *v1beta1.Application, fallback int) int {
+// getApplicationRevisionLimit get the revision limit set in the garbage-collect policy, or the fallback if not set
+func getApplicationRevisionLimit(spec *v1alpha1.GarbageCollectPolicySpec, fallback int) int {
+	if spec != nil && spec.ApplicationRevisionLimit != nil && *spec.ApplicationRevisionLimit >= 0 {
+		return *spec.ApplicationRevisionLimit
+	}
+	return fallback
+}
+
+// getApplicationRevisionRetention get the revision retention set in the garbage-collect policy
+func getApplicationRevisionRetention(spec *v1alpha1.GarbageCollectPolicySpec) *metav1.Duration {
+	if spec != nil && spec.ApplicationRevisionRetention != nil && spec.ApplicationRevisionRetention.Duration > 0 {
+		return spec.ApplicationRevisionRetention
+	}
+	return nil
+}
+
+// getGarbageCollectPolicyForApp get the first valid garbage-collect policy of the application
+func getGarbageCollectPolicyForApp(app *v1beta1.Application) *v1alpha1.GarbageCollectPolicySpec {
 	for _, p := range app.Spec.Policies {
 		if p.Type == v1alpha1.GarbageCollectPolicyType && p.Properties != nil && p.Properties.Raw != nil {
 			prop := &v1alpha1.GarbageCollectPolicySpec{}
-			if err := json.Unmarshal(p.Properties.Raw, prop); err == nil && prop.ApplicationRevisionLimit != nil && *prop.ApplicationRevisionLimit >= 0 {
-				return *prop.ApplicationRevisionLimit
+			if err := json.Unmarshal(p.Properties.Raw, prop); err == nil {
+				return prop
 			}
 		}
 	}
-	return fallback
+	return nil
 }
 
 // getSortedAppRevisions get application revisions by revision number
@@ -18,19 +18,24 @@ package resourcekeeper
 
 import (
 	"context"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/crossplane/crossplane-runtime/pkg/test"
 	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	apicommon "github.com/oam-dev/kubevela/apis/core.oam.dev/common"
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1alpha1"
 	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1beta1"
 	"github.com/oam-dev/kubevela/pkg/oam"
 )
@@ -230,6 +235,63 @@ func Test_cleanUpApplicationRevision(t *testing.T) {
 	}
 }
 
+func Test_cleanUpApplicationRevisionByRetention(t *testing.T) {
+	now := time.Now()
+	newRevs := func() []v1beta1.ApplicationRevision {
+		var revs []v1beta1.ApplicationRevision
+		for i, age := range []time.Duration{72 * time.Hour, 50 * time.Hour, 30 * time.Hour, 10 * time.Hour, time.Hour} {
+			revs = append(revs, v1beta1.ApplicationRevision{ObjectMeta: metav1.ObjectMeta{
+				Name:              fmt.Sprintf("app-v%d", i+1),
+				CreationTimestamp: metav1.NewTime(now.Add(-age)),
+			}})
+		}
+		return revs
+	}
+	testCases := map[string]struct {
+		policy  string
+		deleted []string
+	}{
+		"retention only": {
+			policy:  `{"applicationRevisionRetention":"24h"}`,
+			deleted: []string{"app-v2", "app-v3"},
+		},
+		"retention with explicit limit": {
+			policy:  `{"applicationRevisionRetention":"24h","applicationRevisionLimit":3}`,
+			deleted: []string{"app-v2"},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			r := require.New(t)
+			var deleted []string
+			h := &gcHandler{
+				resourceKeeper: &resourceKeeper{
+					Client: &test.MockClient{
+						MockList: func(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+							list.(*v1beta1.ApplicationRevisionList).Items = newRevs()
+							return nil
+						},
+						MockDelete: func(ctx context.Context, obj client.Object, opts ...client.DeleteOption) error {
+							deleted = append(deleted, obj.GetName())
+							return nil
+						},
+					},
+					app: &v1beta1.Application{
+						Spec: v1beta1.ApplicationSpec{Policies: []v1beta1.AppPolicy{{
+							Type:       v1alpha1.GarbageCollectPolicyType,
+							Properties: &runtime.RawExtension{Raw: []byte(tc.policy)},
+						}}},
+						Status: apicommon.AppStatus{LatestRevision: &apicommon.Revision{Name: "app-v1"}},
+					},
+				},
+				cfg: &gcConfig{appRevisionLimit: 10},
+			}
+			r.NoError(cleanUpApplicationRevision(context.Background(), h))
+			r.Equal(tc.deleted, deleted)
+		})
+	}
+}
+
 func Test_cleanUpWorkflowComponentRevision(t *testing.T) {
 	type args struct {
 		h *gcHandler
@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/crossplane/crossplane-runtime/pkg/test"
 	workflowv1alpha1 "github.com/kubevela/workflow/api/v1alpha1"
@@ -257,6 +258,99 @@ func TestResourceKeeperGarbageCollect(t *testing.T) {
 	r.True(finished)
 }
 
+func TestResourceKeeperGarbageCollectWithTTL(t *testing.T) {
+	r := require.New(t)
+	cli := fake.NewClientBuilder().WithScheme(common.Scheme).Build()
+	ctx := context.Background()
+
+	labels := map[string]string{
+		oam.LabelAppName:      "app",
+		oam.LabelAppNamespace: "default",
+		oam.LabelAppUID:       "uid",
+	}
+	createRT := func(gen int64, comps ...string) *v1beta1.ResourceTracker {
+		rt := &v1beta1.ResourceTracker{
+			ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("app-v%d", gen), Labels: labels, Finalizers: []string{resourcetracker.Finalizer}},
+			Spec: v1beta1.ResourceTrackerSpec{
+				Type:                  v1beta1.ResourceTrackerTypeVersioned,
+				ApplicationGeneration: gen,
+			},
+		}
+		r.NoError(cli.Create(ctx, rt))
+		for _, comp := range comps {
+			cm := &unstructured.Unstructured{}
+			cm.SetName(comp)
+			cm.SetNamespace("default")
+			cm.SetGroupVersionKind(corev1.SchemeGroupVersion.WithKind("ConfigMap"))
+			cm.SetLabels(map[string]string{
+				oam.LabelAppComponent: comp,
+				oam.LabelAppNamespace: "default",
+				oam.LabelAppName:      "app",
+			})
+			r.NoError(cli.Create(ctx, cm))
+			r.NoError(resourcetracker.RecordManifestsInResourceTracker(ctx, cli, rt, []*unstructured.Unstructured{cm}, true, false, ""))
+		}
+		return rt
+	}
+	exists := func(name string) bool {
+		cm := &corev1.ConfigMap{}
+		return cli.Get(ctx, client.ObjectKey{Namespace: "default", Name: name}, cm) == nil
+	}
+	createRK := func(ttl time.Duration) *resourceKeeper {
+		_rk, err := NewResourceKeeper(ctx, cli, &v1beta1.Application{
+			ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "default", UID: "uid", Generation: 2},
+		})
+		r.NoError(err)
+		rk := _rk.(*resourceKeeper)
+		rk.garbageCollectPolicy = &v1alpha1.GarbageCollectPolicySpec{Rules: []v1alpha1.GarbageCollectPolicyRule{{
+			Selector: v1alpha1.ResourcePolicyRuleSelector{CompNames: []string{"blue"}},
+			Strategy: v1alpha1.GarbageCollectStrategyAfterTTL,
+			TTL:      &metav1.Duration{Duration: ttl},
+		}}}
+		return rk
+	}
+
+	rt := createRT(1, "blue", "legacy")
+	createRT(2, "green")
+	opts := []GCOption{DisableLegacyGCOption{}, DisableGCComponentRevisionOption{}, DisableApplicationRevisionGCOption{}}
+
+	// outdated resource without ttl is recycled while the one with ttl is kept
+	finished, _, err := createRK(time.Hour).GarbageCollect(ctx, opts...)
+	r.NoError(err)
+	r.False(finished)
+	var nextDeleteTime time.Time
+	finished, _, err = createRK(time.Hour).GarbageCollect(ctx, append(opts, NextDeleteTimeGCOption{Time: &nextDeleteTime})...)
+	r.NoError(err)
+	r.True(finished)
+	r.True(exists("blue"))
+	r.False(exists("legacy"))
+	r.True(exists("green"))
+	r.NoError(cli.Get(ctx, client.ObjectKeyFromObject(rt), rt))
+	r.NotNil(rt.GetDeletionTimestamp())
+	var deleteAfter *metav1.Time
+	for _, mr := range rt.Spec.ManagedResources {
+		if mr.Name == "blue" {
+			deleteAfter = mr.DeleteAfter
+		}
+	}
+	r.NotNil(deleteAfter)
+	r.True(deleteAfter.After(time.Now()))
+	// the due time of the kept resource is reported for requeue
+	r.True(deleteAfter.Time.Equal(nextDeleteTime))
+
+	// resource is recycled after ttl expires
+	finished, _, err = createRK(time.Nanosecond).GarbageCollect(ctx, opts...)
+	r.NoError(err)
+	r.False(finished)
+	r.False(exists("blue"))
+	finished, _, err = createRK(time.Nanosecond).GarbageCollect(ctx, opts...)
+	r.NoError(err)
+	r.True(finished)
+	_rts := &v1beta1.ResourceTrackerList{}
+	r.NoError(cli.List(ctx, _rts))
+	r.Equal(1, len(_rts.Items))
+}
+
 func TestCheckDependentComponent(t *testing.T) {
 	rk := &resourceKeeper{
 		app: &v1beta1.Application{
@@ -17,6 +17,8 @@ limitations under the License.
 package resourcekeeper
 
 import (
+	"time"
+
 	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1alpha1"
 )
 
@@ -114,12 +116,45 @@ func (option AppRevisionLimitGCOption) ApplyToGCConfig(cfg *gcConfig) {
 	cfg.appRevisionLimit = int(option)
 }
 
+// DryRunGCOption simulate the mark, sweep and finalize stages in gc process without modifying any resourcetracker or
+// resource. The managed resources to be recycled will be recorded in the report.
+type DryRunGCOption struct {
+	Report *GCDryRunReport
+}
+
+// ApplyToGCConfig apply change to gc config
+func (option DryRunGCOption) ApplyToGCConfig(cfg *gcConfig) {
+	cfg.dryRunReport = option.Report
+}
+
+// NextDeleteTimeGCOption records the earliest time when the resources kept by the ttl of garbage-collect policy are
+// due to be recycled, it is left zero if no resource is kept by ttl
+type NextDeleteTimeGCOption struct {
+	Time *time.Time
+}
+
+// ApplyToGCConfig apply change to gc config
+func (option NextDeleteTimeGCOption) ApplyToGCConfig(cfg *gcConfig) {
+	cfg.nextDeleteTime = option.Time
+}
+
+// DryRunDispatchOption simulate the dispatch through server-side dry-run without recording the manifests in
+// resourcetracker or modifying any resource. The results of the manifests will be recorded in the report.
+type DryRunDispatchOption struct {
+	Report *DispatchDryRunReport
+}
+
+// ApplyToDispatchConfig apply change to dispatch config
+func (option DryRunDispatchOption) ApplyToDispatchConfig(cfg *dispatchConfig) {
+	cfg.dryRunReport = option.Report
+}
+
 // GarbageCollectStrategyOption apply garbage collect strategy to resourcetracker recording
 type GarbageCollectStrategyOption v1alpha1.GarbageCollectStrategy
 
 func (option GarbageCollectStrategyOption) applyToRTConfig(cfg *rtConfig) {
 	switch v1alpha1.GarbageCollectStrategy(option) {
-	case v1alpha1.GarbageCollectStrategyOnAppUpdate:
+	case v1alpha1.GarbageCollectStrategyOnAppUpdate, v1alpha1.GarbageCollectStrategyAfterTTL:
 		cfg.skipGC = false
 		cfg.useRoot = false
 	case v1alpha1.GarbageCollectStrategyOnAppDelete:
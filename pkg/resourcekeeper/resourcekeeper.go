@@ -65,7 +65,8 @@ type resourceKeeper struct {
 	readOnlyPolicy       *v1alpha1.ReadOnlyPolicySpec
 	resourceUpdatePolicy *v1alpha1.ResourceUpdatePolicySpec
 
-	cache *resourceCache
+	cache             *resourceCache
+	admissionPolicies admissionPolicySnapshot
 }
 
 func (h *resourceKeeper) getRootRT(ctx context.Context) (rootRT *v1beta1.ResourceTracker, err error) {
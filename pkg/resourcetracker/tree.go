@@ -33,6 +33,7 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/rest"
+	"k8s.io/klog/v2"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/yaml"
 
@@ -48,9 +49,14 @@ import (
 // ResourceDetailRetriever retriever to get details for resource
 type ResourceDetailRetriever func(*resourceRow, string) error
 
+// ResourceHealthRetriever retriever to get the health status for resource
+type ResourceHealthRetriever func(*v1beta1.ManagedResource) (string, error)
+
 // ResourceTreePrintOptions print options for resource tree
 type ResourceTreePrintOptions struct {
 	DetailRetriever ResourceDetailRetriever
+	// HealthRetriever if set, the health status of the resources will be displayed
+	HealthRetriever ResourceHealthRetriever
 	multicluster.ClusterNameMapper
 	// MaxWidth if set, the detail part will auto wrap
 	MaxWidth *int
@@ -76,6 +82,7 @@ type resourceRow struct {
 	connectNamespaceDown bool
 	applyTime            string
 	details              string
+	health               string
 }
 
 func (options *ResourceTreePrintOptions) loadResourceRows(currentRT *v1beta1.ResourceTracker, historyRT []*v1beta1.ResourceTracker) []*resourceRow {
@@ -117,7 +124,7 @@ func (options *ResourceTreePrintOptions) sortRows(rows []*resourceRow) {
 }
 
 func (options *ResourceTreePrintOptions) fillResourceRows(rows []*resourceRow, colsWidth []int) {
-	for i := 0; i < 4; i++ {
+	for i := range colsWidth {
 		colsWidth[i] = 10
 	}
 	connectLastRow := func(rowIdx int, cluster bool, namespace bool) {
@@ -145,7 +152,7 @@ func (options *ResourceTreePrintOptions) fillResourceRows(rows []*resourceRow, c
 				row.namespace = ""
 			}
 		}
-		for i, val := range []string{row.cluster, row.namespace, row.resourceName, row.status} {
+		for i, val := range options.rowColumns(row) {
 			if size := len(val) + 1; size > colsWidth[i] {
 				colsWidth[i] = size
 			}
@@ -242,7 +249,11 @@ func (options *ResourceTreePrintOptions) writeResourceTree(writer io.Writer, row
 	}
 
 	var headerWriter strings.Builder
-	for colIdx, colName := range []string{"CLUSTER", "NAMESPACE", "RESOURCE", "STATUS"} {
+	colNames := []string{"CLUSTER", "NAMESPACE", "RESOURCE", "STATUS"}
+	if options.HealthRetriever != nil {
+		colNames = append(colNames, "HEALTH")
+	}
+	for colIdx, colName := range colNames {
 		writePaddedString(&headerWriter, colName, "", colsWidth[colIdx])
 	}
 	if options.DetailRetriever != nil {
@@ -264,20 +275,24 @@ func (options *ResourceTreePrintOptions) writeResourceTree(writer io.Writer, row
 		}
 		for lineIdx, line := range options._wrapDetails(row.details, detailWidth) {
 			var sb strings.Builder
-			rscName, rscStatus, applyTime := row.resourceName, row.status, row.applyTime
+			rscName, rscStatus, rscHealth, applyTime := row.resourceName, row.status, row.health, row.applyTime
 			if row.status != resourceRowStatusUpdated {
-				rscName, rscStatus, applyTime, line = outdatedColorizer(row.resourceName), outdatedColorizer(row.status), outdatedColorizer(applyTime), outdatedColorizer(line)
+				rscName, rscStatus, rscHealth, applyTime, line = outdatedColorizer(row.resourceName), outdatedColorizer(row.status), outdatedColorizer(row.health), outdatedColorizer(applyTime), outdatedColorizer(line)
 			}
 			if lineIdx == 0 {
 				writePaddedString(&sb, row.cluster, connectorColorizer(utils.GetBoxDrawingString(row.connectClusterUp, row.connectClusterDown, row.cluster != "", row.namespace != "", 1, 1))+" ", colsWidth[0])
 				writePaddedString(&sb, row.namespace, connectorColorizer(utils.GetBoxDrawingString(row.connectNamespaceUp, row.connectNamespaceDown, row.namespace != "", true, 1, 1))+" ", colsWidth[1])
 				writePaddedString(&sb, rscName, "", colsWidth[2])
 				writePaddedString(&sb, rscStatus, "", colsWidth[3])
+				if options.HealthRetriever != nil {
+					writePaddedString(&sb, rscHealth, "", colsWidth[4])
+				}
 			} else {
 				writePaddedString(&sb, "", connectorColorizer(utils.GetBoxDrawingString(row.connectClusterDown, row.connectClusterDown, false, false, 1, 1))+" ", colsWidth[0])
 				writePaddedString(&sb, "", connectorColorizer(utils.GetBoxDrawingString(row.connectNamespaceDown, row.connectNamespaceDown, false, false, 1, 1))+" ", colsWidth[1])
-				writePaddedString(&sb, "", "", colsWidth[2])
-				writePaddedString(&sb, "", "", colsWidth[3])
+				for colIdx := 2; colIdx < len(colsWidth); colIdx++ {
+					writePaddedString(&sb, "", "", colsWidth[colIdx])
+				}
 			}
 
 			if options.DetailRetriever != nil {
@@ -314,13 +329,40 @@ func (options *ResourceTreePrintOptions) PrintResourceTree(writer io.Writer, cur
 	rows := options.loadResourceRows(currentRT, historyRT)
 	rows = options.addNonExistingPlacementToRows(currentPlacements, rows)
 	options.sortRows(rows)
+	options.retrieveHealth(rows)
 
-	colsWidth := make([]int, 4)
+	colsWidth := make([]int, len(options.rowColumns(&resourceRow{})))
 	options.fillResourceRows(rows, colsWidth)
 
 	options.writeResourceTree(writer, rows, colsWidth)
 }
 
+// rowColumns returns the columns of the row displayed before the details
+func (options *ResourceTreePrintOptions) rowColumns(row *resourceRow) []string {
+	cols := []string{row.cluster, row.namespace, row.resourceName, row.status}
+	if options.HealthRetriever != nil {
+		cols = append(cols, row.health)
+	}
+	return cols
+}
+
+func (options *ResourceTreePrintOptions) retrieveHealth(rows []*resourceRow) {
+	if options.HealthRetriever == nil {
+		return
+	}
+	for _, row := range rows {
+		if row.status == resourceRowStatusNotDeployed {
+			continue
+		}
+		health, err := options.HealthRetriever(row.mr)
+		if err != nil {
+			health = "<unknown>"
+			klog.Warningf("failed to retrieve the health of %s %s: %v", row.mr.Kind, row.mr.Name, err)
+		}
+		row.health = health
+	}
+}
+
 type tableRoundTripper struct {
 	rt http.RoundTripper
 }
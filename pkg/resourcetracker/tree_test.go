@@ -326,6 +326,21 @@ func TestPrintResourceTree(t *testing.T) {
 			currentRT:          &v1beta1.ResourceTracker{Spec: v1beta1.ResourceTrackerSpec{ManagedResources: []v1beta1.ManagedResource{mr1}}},
 			expectedSubstrings: []string{"Error: mock error"},
 		},
+		{
+			name: "with health retriever",
+			options: &ResourceTreePrintOptions{
+				ClusterNameMapper: MockClusterNameMapper{},
+				HealthRetriever: func(mr *v1beta1.ManagedResource) (string, error) {
+					if mr.Kind == "Service" {
+						return "", fmt.Errorf("mock error")
+					}
+					return "Progressing", nil
+				},
+			},
+			currentRT:          &v1beta1.ResourceTracker{Spec: v1beta1.ResourceTrackerSpec{ManagedResources: []v1beta1.ManagedResource{mr1, mr2}}},
+			placements:         []v1alpha1.PlacementDecision{{Cluster: "c2"}},
+			expectedSubstrings: []string{"HEALTH", "Progressing", "<unknown>", "not-deployed"},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -119,7 +119,8 @@ func RollbackApplicationWithRevision(ctx context.Context, cli client.Client, app
 			return err
 		}
 		app.Status = apicommon.AppStatus{
-			LatestRevision: &apicommon.Revision{Name: revName, Revision: revisionNum, RevisionHash: matchedRev.GetLabels()[oam.LabelAppRevisionHash]},
+			LatestRevision:   &apicommon.Revision{Name: revName, Revision: revisionNum, RevisionHash: matchedRev.GetLabels()[oam.LabelAppRevisionHash]},
+			OperationHistory: app.Status.OperationHistory,
 		}
 		return cli.Status().Update(ctx, app)
 	}); err != nil {
@@ -131,6 +132,49 @@ func RollbackApplicationWithRevision(ctx context.Context, cli client.Client, app
 	return matchedRev, app, nil
 }
 
+// RollbackPlacementsWithRevision pins the clusters of the application to the specified revision, the components of
+// the revision are deployed to these clusters while the other clusters keep using the latest revision. Pinning the
+// clusters to the latest revision releases the pins.
+func RollbackPlacementsWithRevision(ctx context.Context, cli client.Client, appName, appNamespace string, clusters []string, revisionName string) (*v1beta1.Application, error) {
+	revs, err := application.GetSortedAppRevisions(ctx, cli, appName, appNamespace)
+	if err != nil {
+		return nil, err
+	}
+	matched := false
+	for _, rev := range revs {
+		if rev.Name == revisionName {
+			matched = true
+		}
+	}
+	if !matched {
+		return nil, ErrNotMatchRevision
+	}
+	app := &v1beta1.Application{}
+	if err = retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		if err := cli.Get(ctx, k8stypes.NamespacedName{Name: appName, Namespace: appNamespace}, app); err != nil {
+			return err
+		}
+		pins, err := oam.GetPlacementRevisions(app)
+		if err != nil {
+			return err
+		}
+		for _, cluster := range clusters {
+			if app.Status.LatestRevision != nil && app.Status.LatestRevision.Name == revisionName {
+				delete(pins, cluster)
+			} else {
+				pins[cluster] = revisionName
+			}
+		}
+		if err = oam.SetPlacementRevisions(app, pins); err != nil {
+			return err
+		}
+		return cli.Update(ctx, app)
+	}); err != nil {
+		return nil, errors.Wrapf(err, "failed to pin the clusters of application %s/%s to revision %s", appNamespace, appName, revisionName)
+	}
+	return app, nil
+}
+
 // FreezeApplication freeze application to disable the reconciling process for it
 func FreezeApplication(ctx context.Context, cli client.Client, app *v1beta1.Application, mutate func()) (string, error) {
 	return oam.GetControllerRequirement(app), _updateApplicationWithControllerRequirement(ctx, cli, app, mutate, "Disabled")
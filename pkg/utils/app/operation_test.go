@@ -0,0 +1,68 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	apicommon "github.com/oam-dev/kubevela/apis/core.oam.dev/common"
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1beta1"
+	"github.com/oam-dev/kubevela/pkg/oam"
+	apputil "github.com/oam-dev/kubevela/pkg/utils/app"
+	"github.com/oam-dev/kubevela/pkg/utils/common"
+)
+
+func TestRollbackPlacementsWithRevision(t *testing.T) {
+	r := require.New(t)
+	ctx := context.Background()
+	app := &v1beta1.Application{}
+	app.SetName("app")
+	app.SetNamespace("default")
+	app.Status.LatestRevision = &apicommon.Revision{Name: "app-v2", Revision: 2}
+	objs := []client.Object{app}
+	for _, name := range []string{"app-v1", "app-v2"} {
+		rev := &v1beta1.ApplicationRevision{}
+		rev.SetName(name)
+		rev.SetNamespace("default")
+		rev.SetLabels(map[string]string{oam.LabelAppName: "app", oam.LabelAppNamespace: "default"})
+		objs = append(objs, rev)
+	}
+	cli := fake.NewClientBuilder().WithScheme(common.Scheme).WithObjects(objs...).Build()
+
+	_, err := apputil.RollbackPlacementsWithRevision(ctx, cli, "app", "default", []string{"eu-1"}, "app-v0")
+	r.ErrorIs(err, apputil.ErrNotMatchRevision)
+
+	_, err = apputil.RollbackPlacementsWithRevision(ctx, cli, "app", "default", []string{"eu-1", "eu-2"}, "app-v1")
+	r.NoError(err)
+	r.NoError(cli.Get(ctx, client.ObjectKeyFromObject(app), app))
+	pins, err := oam.GetPlacementRevisions(app)
+	r.NoError(err)
+	r.Equal(map[string]string{"eu-1": "app-v1", "eu-2": "app-v1"}, pins)
+
+	// rolling back to the latest revision releases the pins
+	_, err = apputil.RollbackPlacementsWithRevision(ctx, cli, "app", "default", []string{"eu-1"}, "app-v2")
+	r.NoError(err)
+	r.NoError(cli.Get(ctx, client.ObjectKeyFromObject(app), app))
+	pins, err = oam.GetPlacementRevisions(app)
+	r.NoError(err)
+	r.Equal(map[string]string{"eu-2": "app-v1"}, pins)
+}
@@ -0,0 +1,144 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cel
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/common/types/ref"
+	"github.com/google/cel-go/common/types/traits"
+	"github.com/google/cel-go/ext"
+	"github.com/pkg/errors"
+	"k8s.io/utils/lru"
+)
+
+var (
+	// ProgramCacheSize is the max number of compiled programs to be cached, it takes effect only if set before the
+	// first compilation
+	ProgramCacheSize = 256
+	// CostLimit is the max runtime cost of one evaluation, the evaluation exceeding the limit is aborted
+	CostLimit uint64 = 1000000
+	// EvalTimeout is the max duration of one evaluation, the evaluation is interrupted when it times out
+	EvalTimeout = 100 * time.Millisecond
+)
+
+// interruptCheckFrequency is the number of comprehension iterations between the checks of the evaluation interruption
+const interruptCheckFrequency = 100
+
+var (
+	programCache     *lru.Cache
+	programCacheOnce sync.Once
+)
+
+// getProgramCache returns the cache of compiled programs, which is created with ProgramCacheSize on first use
+func getProgramCache() *lru.Cache {
+	programCacheOnce.Do(func() {
+		programCache = lru.New(ProgramCacheSize)
+	})
+	return programCache
+}
+
+// Compile compiles the CEL expression with the given variables declared as dynamic types. Compiled programs are cached
+// by the expression and variables.
+func Compile(expression string, variables ...string) (cel.Program, error) {
+	vars := append([]string{}, variables...)
+	sort.Strings(vars)
+	key := strings.Join(vars, ",") + "|" + expression
+	cache := getProgramCache()
+	if prg, found := cache.Get(key); found {
+		return prg.(cel.Program), nil
+	}
+	opts := []cel.EnvOption{ext.Strings(), ext.Encoders(), ext.Math(), ext.Lists(), ext.Sets()}
+	for _, v := range vars {
+		opts = append(opts, cel.Variable(v, cel.DynType))
+	}
+	env, err := cel.NewEnv(opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create cel environment")
+	}
+	ast, iss := env.Compile(expression)
+	if iss.Err() != nil {
+		return nil, errors.Wrapf(iss.Err(), "failed to compile cel expression %q", expression)
+	}
+	prg, err := env.Program(ast, cel.CostLimit(CostLimit), cel.InterruptCheckFrequency(interruptCheckFrequency))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to build cel program for expression %q", expression)
+	}
+	cache.Add(key, prg)
+	return prg, nil
+}
+
+// Eval evaluates the CEL expression with the given variables and returns the result as native go value
+func Eval(expression string, variables map[string]interface{}) (interface{}, error) {
+	val, err := eval(expression, variables)
+	if err != nil {
+		return nil, err
+	}
+	return toNative(val)
+}
+
+// EvalBool evaluates the CEL expression which should return a bool value
+func EvalBool(expression string, variables map[string]interface{}) (bool, error) {
+	val, err := eval(expression, variables)
+	if err != nil {
+		return false, err
+	}
+	b, ok := val.(types.Bool)
+	if !ok {
+		return false, fmt.Errorf("cel expression %q returns %s instead of bool", expression, val.Type().TypeName())
+	}
+	return bool(b), nil
+}
+
+func eval(expression string, variables map[string]interface{}) (ref.Val, error) {
+	var names []string
+	for name := range variables {
+		names = append(names, name)
+	}
+	prg, err := Compile(expression, names...)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), EvalTimeout)
+	defer cancel()
+	val, _, err := prg.ContextEval(ctx, variables)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to evaluate cel expression %q", expression)
+	}
+	return val, nil
+}
+
+func toNative(val ref.Val) (interface{}, error) {
+	switch v := val.(type) {
+	case types.Null:
+		return nil, nil
+	case traits.Lister:
+		return v.ConvertToNative(reflect.TypeOf([]interface{}{}))
+	case traits.Mapper:
+		return v.ConvertToNative(reflect.TypeOf(map[string]interface{}{}))
+	default:
+		return val.Value(), nil
+	}
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cel
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEval(t *testing.T) {
+	r := require.New(t)
+	vars := map[string]interface{}{
+		"object": map[string]interface{}{
+			"kind": "Service",
+			"spec": map[string]interface{}{
+				"type":  "LoadBalancer",
+				"ports": []interface{}{map[string]interface{}{"port": 80}, map[string]interface{}{"port": 443}},
+			},
+		},
+	}
+	admitted, err := EvalBool(`object.spec.type != "LoadBalancer"`, vars)
+	r.NoError(err)
+	r.False(admitted)
+
+	_, err = EvalBool(`object.kind`, vars)
+	r.Error(err)
+	_, err = EvalBool(`object.spec.`, vars)
+	r.Error(err)
+	_, err = EvalBool(`object.status.ready`, vars)
+	r.Error(err)
+
+	val, err := Eval(`object.spec.ports.map(p, p.port)`, vars)
+	r.NoError(err)
+	r.Equal([]interface{}{int64(80), int64(443)}, val)
+	val, err = Eval(`{"type": object.spec.type}`, vars)
+	r.NoError(err)
+	r.Equal(map[string]interface{}{"type": "LoadBalancer"}, val)
+	val, err = Eval(`object.kind.lowerAscii()`, vars)
+	r.NoError(err)
+	r.Equal("service", val)
+
+	// expensive expressions are aborted by the cost limit
+	_, err = Eval(`object.spec.ports.map(a, object.spec.ports.map(b, object.spec.ports.map(c, a.port + b.port + c.port)))`, vars)
+	r.NoError(err)
+	vars["items"] = make([]interface{}, 1000)
+	_, err = EvalBool(`items.all(a, items.all(b, items.all(c, true)))`, vars)
+	r.ErrorContains(err, "cost limit exceeded")
+}
+
+func TestProgramCacheSize(t *testing.T) {
+	r := require.New(t)
+	size := ProgramCacheSize
+	defer func() {
+		ProgramCacheSize = size
+		programCacheOnce = sync.Once{}
+	}()
+	// the cache is sized when the first program is compiled
+	programCacheOnce = sync.Once{}
+	ProgramCacheSize = 1
+	_, err := Compile(`a + 1`, "a")
+	r.NoError(err)
+	_, err = Compile(`a + 2`, "a")
+	r.NoError(err)
+	r.Equal(1, getProgramCache().Len())
+}
@@ -37,9 +37,10 @@ import (
 	"github.com/oam-dev/kubevela/pkg/features"
 	"github.com/oam-dev/kubevela/pkg/oam"
 	"github.com/oam-dev/kubevela/pkg/utils"
+	"github.com/oam-dev/kubevela/pkg/workflow/operation"
 )
 
-// MutatingHandler adding user info to application annotations
+// MutatingHandler adding user info to application annotations and the operator to the workflow operation records
 type MutatingHandler struct {
 	skipUsers []string
 	Decoder   admission.Decoder
@@ -98,6 +99,10 @@ func (h *MutatingHandler) handleSharding(_ context.Context, _ admission.Request,
 	return false, nil
 }
 
+func (h *MutatingHandler) handleOperationRecord(_ context.Context, req admission.Request, oldApp *v1beta1.Application, newApp *v1beta1.Application) (bool, error) {
+	return operation.StampOperationRecord(oldApp, newApp, req.UserInfo)
+}
+
 // Handle mutate application
 func (h *MutatingHandler) Handle(ctx context.Context, req admission.Request) admission.Response {
 	oldApp, newApp := &v1beta1.Application{}, &v1beta1.Application{}
@@ -111,7 +116,7 @@ func (h *MutatingHandler) Handle(ctx context.Context, req admission.Request) adm
 	}
 
 	modified := false
-	for _, handler := range []appMutator{h.handleIdentity, h.handleSharding, h.handleWorkflow} {
+	for _, handler := range []appMutator{h.handleIdentity, h.handleSharding, h.handleWorkflow, h.handleOperationRecord} {
 		m, err := handler(ctx, req, oldApp, newApp)
 		if err != nil {
 			return admission.Errored(http.StatusBadRequest, err)
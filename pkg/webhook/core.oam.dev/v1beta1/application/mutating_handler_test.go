@@ -124,4 +124,25 @@ var _ = Describe("Test Application Mutator", func() {
 			Value:     "step-0",
 		}))
 	})
+	It("Test Application Mutator [stamp operation record]", func() {
+		req := admission.Request{
+			AdmissionRequest: admissionv1.AdmissionRequest{
+				Operation: admissionv1.Update,
+				Resource:  metav1.GroupVersionResource{Group: v1beta1.Group, Version: v1beta1.Version, Resource: "applications"},
+				Object:    runtime.RawExtension{Raw: []byte(`{"apiVersion":"core.oam.dev/v1beta1","kind":"Application","metadata":{"name":"example","annotations":{"app.oam.dev/operation-record":"{\"operation\":\"resume\",\"user\":\"alice\",\"time\":\"2026-01-02T04:04:05Z\"}"}}}`)},
+				OldObject: runtime.RawExtension{Raw: []byte(`{"apiVersion":"core.oam.dev/v1beta1","kind":"Application","metadata":{"name":"example","annotations":{"app.oam.dev/operation-record":"{\"operation\":\"suspend\",\"user\":\"bob\",\"time\":\"2026-01-02T03:04:05Z\"}"}}}`)},
+				UserInfo: authv1.UserInfo{
+					Username: "example-user",
+					Groups:   []string{"kubevela:example-group1"},
+				},
+			},
+		}
+		resp := mutatingHandler.Handle(ctx, req)
+		Expect(resp.Allowed).Should(BeTrue())
+		Expect(resp.Patches).Should(ContainElement(jsonpatch.JsonPatchOperation{
+			Operation: "replace",
+			Path:      "/metadata/annotations/app.oam.dev~1operation-record",
+			Value:     `{"operation":"resume","user":"example-user","groups":["kubevela:example-group1"],"time":"2026-01-02T04:04:05Z"}`,
+		}))
+	})
 })
@@ -83,6 +83,27 @@ var _ = Describe("Test Application Validator", func() {
 		Expect(resp.Allowed).Should(BeFalse())
 	})
 
+	It("Test Application Validator component dependency cycle [error]", func() {
+		req := admission.Request{
+			AdmissionRequest: admissionv1.AdmissionRequest{
+				Operation: admissionv1.Create,
+				Resource:  metav1.GroupVersionResource{Group: "core.oam.dev", Version: "v1alpha2", Resource: "applications"},
+				Object: runtime.RawExtension{
+					Raw: []byte(`{"apiVersion":"core.oam.dev/v1beta1",
+"kind":"Application",
+"metadata":{"name":"application-sample"},
+"spec":{"components":[{"name":"web","type":"worker","properties":{"image":"busybox"},
+"inputs":[{"from":"db-host","parameterKey":"env[0].value"}],"outputs":[{"name":"web-host","valueFrom":"output.metadata.name"}]},
+{"name":"db","type":"worker","properties":{"image":"busybox"},"dependsOn":["web"],
+"outputs":[{"name":"db-host","valueFrom":"output.metadata.name"}]}]}}`),
+				},
+			},
+		}
+		resp := handler.Handle(ctx, req)
+		Expect(resp.Allowed).Should(BeFalse())
+		Expect(resp.Result.Message).Should(ContainSubstring("dependency cycle found in components"))
+	})
+
 	It("Test Application Validator Forbid rollout annotation", func() {
 		req := admission.Request{
 			AdmissionRequest: admissionv1.AdmissionRequest{
@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"slices"
 	"time"
 
 	"github.com/kubevela/pkg/controller/sharding"
@@ -32,10 +33,12 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/common"
 	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1beta1"
 	"github.com/oam-dev/kubevela/pkg/appfile"
 	"github.com/oam-dev/kubevela/pkg/features"
 	"github.com/oam-dev/kubevela/pkg/oam"
+	"github.com/oam-dev/kubevela/pkg/workflow/step"
 )
 
 // ValidateWorkflow validates the Application workflow
@@ -88,12 +91,16 @@ func (in *appRevBypassCacheClient) Get(ctx context.Context, key client.ObjectKey
 	return in.Client.Get(ctx, key, obj)
 }
 
-// ValidateComponents validates the Application components
-func (h *ValidatingHandler) ValidateComponents(ctx context.Context, app *v1beta1.Application) field.ErrorList {
+// ValidateComponents validates the Application components. The dangling inputs of the components, which are not the
+// outputs of any component or workflow step, are rejected on creation or if the inputs of the component are changed.
+// Otherwise, they are returned as warnings, since the applications created before may contain them. The oldApp is nil
+// on creation.
+func (h *ValidatingHandler) ValidateComponents(ctx context.Context, app *v1beta1.Application, oldApp *v1beta1.Application) (field.ErrorList, admission.Warnings) {
 	if sharding.EnableSharding && !utilfeature.DefaultMutableFeatureGate.Enabled(features.ValidateComponentWhenSharding) {
-		return nil
+		return nil, nil
 	}
 	var componentErrs field.ErrorList
+	var warnings admission.Warnings
 	// try to generate an app file
 	cli := &appRevBypassCacheClient{Client: h.Client}
 	appParser := appfile.NewApplicationParser(cli)
@@ -102,7 +109,7 @@ func (h *ValidatingHandler) ValidateComponents(ctx context.Context, app *v1beta1
 	if err != nil {
 		componentErrs = append(componentErrs, field.Invalid(field.NewPath("spec"), app, err.Error()))
 		// cannot generate appfile, no need to validate further
-		return componentErrs
+		return componentErrs, nil
 	}
 	if i, err := appParser.ValidateComponentNames(app); err != nil {
 		componentErrs = append(componentErrs, field.Invalid(field.NewPath(fmt.Sprintf("components[%d].name", i)), app, err.Error()))
@@ -110,7 +117,48 @@ func (h *ValidatingHandler) ValidateComponents(ctx context.Context, app *v1beta1
 	if err := appParser.ValidateCUESchematicAppfile(af); err != nil {
 		componentErrs = append(componentErrs, field.Invalid(field.NewPath("schematic"), app, err.Error()))
 	}
-	return componentErrs
+	if graph, err := step.BuildComponentGraph(app.Spec.Components, af.WorkflowSteps); err != nil {
+		componentErrs = append(componentErrs, field.Invalid(field.NewPath("spec", "components"), app, err.Error()))
+	} else {
+		inputErrs, inputWarnings := validateDanglingInputs(app, oldApp, graph.DanglingInputs)
+		componentErrs = append(componentErrs, inputErrs...)
+		warnings = append(warnings, inputWarnings...)
+	}
+	return componentErrs, warnings
+}
+
+// validateDanglingInputs rejects the dangling inputs of the components created or with inputs changed, the others are
+// returned as warnings
+func validateDanglingInputs(app *v1beta1.Application, oldApp *v1beta1.Application, inputs []step.DanglingInput) (field.ErrorList, admission.Warnings) {
+	var errs field.ErrorList
+	var warnings admission.Warnings
+	for _, input := range inputs {
+		if oldApp != nil && !componentInputsChanged(oldApp, app, input.Component) {
+			warnings = append(warnings, input.String())
+			continue
+		}
+		idx := slices.IndexFunc(app.Spec.Components, func(comp common.ApplicationComponent) bool { return comp.Name == input.Component })
+		errs = append(errs, field.Invalid(field.NewPath("spec", "components").Index(idx).Child("inputs"), input.Input, input.String()))
+	}
+	return errs, warnings
+}
+
+// componentInputsChanged checks whether the inputs of the component are changed in the new application, the component
+// added in the new application is regarded as changed
+func componentInputsChanged(oldApp *v1beta1.Application, newApp *v1beta1.Application, component string) bool {
+	find := func(app *v1beta1.Application) *common.ApplicationComponent {
+		for i := range app.Spec.Components {
+			if app.Spec.Components[i].Name == component {
+				return &app.Spec.Components[i]
+			}
+		}
+		return nil
+	}
+	oldComp, newComp := find(oldApp), find(newApp)
+	if oldComp == nil || newComp == nil {
+		return true
+	}
+	return !reflect.DeepEqual(oldComp.Inputs, newComp.Inputs)
 }
 
 // checkDefinitionPermission checks if user has permission to access a definition in either system namespace or app namespace
@@ -453,20 +501,25 @@ func (h *ValidatingHandler) ValidateAnnotations(_ context.Context, app *v1beta1.
 }
 
 // ValidateCreate validates the Application on creation
-func (h *ValidatingHandler) ValidateCreate(ctx context.Context, app *v1beta1.Application, req admission.Request) field.ErrorList {
-	var errs field.ErrorList
-
-	errs = append(errs, h.ValidateAnnotations(ctx, app)...)
-	errs = append(errs, h.ValidateDefinitionPermissions(ctx, app, req)...)
-	errs = append(errs, h.ValidateWorkflow(ctx, app)...)
-	errs = append(errs, h.ValidateComponents(ctx, app)...)
-	return errs
+func (h *ValidatingHandler) ValidateCreate(ctx context.Context, app *v1beta1.Application, req admission.Request) (field.ErrorList, admission.Warnings) {
+	return h.validate(ctx, app, nil, req)
 }
 
 // ValidateUpdate validates the Application on update
-func (h *ValidatingHandler) ValidateUpdate(ctx context.Context, newApp, _ *v1beta1.Application, req admission.Request) field.ErrorList {
+func (h *ValidatingHandler) ValidateUpdate(ctx context.Context, newApp, oldApp *v1beta1.Application, req admission.Request) (field.ErrorList, admission.Warnings) {
 	// check if the newApp is valid
-	errs := h.ValidateCreate(ctx, newApp, req)
+	errs, warnings := h.validate(ctx, newApp, oldApp, req)
 	// TODO: add more validating
-	return errs
+	return errs, warnings
+}
+
+func (h *ValidatingHandler) validate(ctx context.Context, app, oldApp *v1beta1.Application, req admission.Request) (field.ErrorList, admission.Warnings) {
+	var errs field.ErrorList
+
+	errs = append(errs, h.ValidateAnnotations(ctx, app)...)
+	errs = append(errs, h.ValidateDefinitionPermissions(ctx, app, req)...)
+	errs = append(errs, h.ValidateWorkflow(ctx, app)...)
+	componentErrs, warnings := h.ValidateComponents(ctx, app, oldApp)
+	errs = append(errs, componentErrs...)
+	return errs, warnings
 }
@@ -38,6 +38,7 @@ import (
 	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1beta1"
 	"github.com/oam-dev/kubevela/pkg/features"
 	"github.com/oam-dev/kubevela/pkg/oam"
+	"github.com/oam-dev/kubevela/pkg/workflow/step"
 )
 
 func TestValidateCreate(t *testing.T) {
@@ -182,7 +183,7 @@ func TestValidateCreate(t *testing.T) {
 				},
 			}
 
-			errs := handler.ValidateCreate(context.Background(), tc.app, req)
+			errs, _ := handler.ValidateCreate(context.Background(), tc.app, req)
 			assert.Equal(t, tc.expectedErrorCount, len(errs),
 				"Expected %d errors, got %d: %v", tc.expectedErrorCount, len(errs), errs)
 
@@ -288,7 +289,7 @@ func TestValidateUpdate(t *testing.T) {
 				},
 			}
 
-			errs := handler.ValidateUpdate(context.Background(), tc.newApp, oldApp, req)
+			errs, _ := handler.ValidateUpdate(context.Background(), tc.newApp, oldApp, req)
 			assert.Equal(t, tc.expectedErrorCount, len(errs),
 				"Expected %d errors, got %d: %v", tc.expectedErrorCount, len(errs), errs)
 		})
@@ -416,3 +417,32 @@ func TestValidateAnnotations(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateDanglingInputs(t *testing.T) {
+	newApp := func(webInputs ...string) *v1beta1.Application {
+		app := &v1beta1.Application{Spec: v1beta1.ApplicationSpec{Components: []common.ApplicationComponent{{Name: "db"}, {Name: "web"}}}}
+		for _, input := range webInputs {
+			app.Spec.Components[1].Inputs = append(app.Spec.Components[1].Inputs, workflowv1alpha1.InputItem{From: input, ParameterKey: input})
+		}
+		return app
+	}
+	dangling := []step.DanglingInput{{Component: "web", Input: "db-host"}}
+	message := "input db-host of component web is not the output of any component or workflow step"
+
+	// rejected on creation
+	errs, warnings := validateDanglingInputs(newApp("db-host"), nil, dangling)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, "spec.components[1].inputs", errs[0].Field)
+	assert.Contains(t, errs[0].Error(), message)
+	assert.Empty(t, warnings)
+
+	// rejected if the inputs are changed
+	errs, warnings = validateDanglingInputs(newApp("db-host"), newApp(), dangling)
+	assert.Len(t, errs, 1)
+	assert.Empty(t, warnings)
+
+	// warned if the inputs are unchanged
+	errs, warnings = validateDanglingInputs(newApp("db-host"), newApp("db-host"), dangling)
+	assert.Empty(t, errs)
+	assert.Equal(t, admission.Warnings{message}, warnings)
+}
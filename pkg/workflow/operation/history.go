@@ -0,0 +1,209 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package operation
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	authv1 "k8s.io/api/authentication/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/common"
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1beta1"
+	"github.com/oam-dev/kubevela/apis/types"
+	"github.com/oam-dev/kubevela/pkg/oam"
+)
+
+const (
+	// OperationSuspend suspends the workflow or a step of the workflow
+	OperationSuspend = "suspend"
+	// OperationResume resumes the workflow or a step of the workflow
+	OperationResume = "resume"
+	// OperationRestart restarts the workflow or the workflow from a step
+	OperationRestart = "restart"
+	// OperationTerminate terminates the workflow
+	OperationTerminate = "terminate"
+	// OperationRollback rolls back the application to the previous revision
+	OperationRollback = "rollback"
+
+	// MaxOperationHistory is the max number of the operation records kept in the application status
+	MaxOperationHistory = 20
+
+	operationEventSource = "workflow-operator"
+)
+
+type operationReasonKey struct{}
+
+// ContextWithOperationReason inject the reason of the workflow operation into context, the reason is recorded
+// together with the operation
+func ContextWithOperationReason(ctx context.Context, reason string) context.Context {
+	return context.WithValue(ctx, operationReasonKey{}, reason)
+}
+
+// OperationReasonFromContext get the reason of the workflow operation from context
+func OperationReasonFromContext(ctx context.Context) string {
+	reason, _ := ctx.Value(operationReasonKey{}).(string)
+	return reason
+}
+
+// NewOperationRecord creates the record of the workflow operation with the reason in context. The user and groups
+// of the record are not trusted from the client, they are stamped by the application webhook from the admission request
+func NewOperationRecord(ctx context.Context, operation string, step string) common.WorkflowOperationRecord {
+	return common.WorkflowOperationRecord{
+		Operation: operation,
+		Step:      step,
+		Reason:    OperationReasonFromContext(ctx),
+		Time:      metav1.Now(),
+	}
+}
+
+// AppendOperationRecord appends the record to the operation history of the application, the oldest records are
+// dropped if the history exceeds MaxOperationHistory
+func AppendOperationRecord(app *v1beta1.Application, record common.WorkflowOperationRecord) {
+	history := append(app.Status.OperationHistory, record)
+	if len(history) > MaxOperationHistory {
+		history = history[len(history)-MaxOperationHistory:]
+	}
+	app.Status.OperationHistory = history
+}
+
+// RecordOperation records the workflow operation in the status of the application and emits an event for it. The
+// record is set in the annotation of the application first, so that the webhook stamps the identity of the operator,
+// and the stamped record is appended to the operation history. The operation has already been applied when it is
+// recorded, so failures are only logged as warnings
+func RecordOperation(ctx context.Context, cli client.Client, app *v1beta1.Application, operation string, step string) {
+	record, err := stampOperationRecord(ctx, cli, app, NewOperationRecord(ctx, operation, step))
+	if err == nil {
+		err = retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+			if err := cli.Get(ctx, client.ObjectKeyFromObject(app), app); err != nil {
+				return err
+			}
+			AppendOperationRecord(app, record)
+			return cli.Status().Update(ctx, app)
+		})
+	}
+	if err != nil {
+		klog.Warningf("failed to record the %s operation of application %s/%s: %s", operation, app.Namespace, app.Name, err.Error())
+		return
+	}
+	if err := cli.Create(ctx, newOperationEvent(app, record)); err != nil {
+		klog.Warningf("failed to emit event for the %s operation of application %s/%s: %s", operation, app.Namespace, app.Name, err.Error())
+	}
+}
+
+// stampOperationRecord sets the record in the annotation of the application and returns the record returned from the
+// server, which carries the identity stamped by the webhook
+func stampOperationRecord(ctx context.Context, cli client.Client, app *v1beta1.Application, record common.WorkflowOperationRecord) (common.WorkflowOperationRecord, error) {
+	patch := client.MergeFrom(app.DeepCopy())
+	if err := setOperationRecordAnnotation(app, record); err != nil {
+		return record, err
+	}
+	if err := cli.Patch(ctx, app, patch); err != nil {
+		return record, err
+	}
+	stamped, err := getOperationRecordAnnotation(app)
+	if err != nil || stamped == nil {
+		return record, err
+	}
+	return *stamped, nil
+}
+
+// StampOperationRecord sets the user and groups of the operation record in the annotation of the application if the
+// record is changed, so the record cannot be forged by the client. It returns whether the record is stamped.
+func StampOperationRecord(oldApp *v1beta1.Application, newApp *v1beta1.Application, userInfo authv1.UserInfo) (bool, error) {
+	value, found := newApp.GetAnnotations()[oam.AnnotationOperationRecord]
+	if !found || value == oldApp.GetAnnotations()[oam.AnnotationOperationRecord] {
+		return false, nil
+	}
+	record, err := getOperationRecordAnnotation(newApp)
+	if err != nil {
+		return false, err
+	}
+	record.User = userInfo.Username
+	record.Groups = userInfo.Groups
+	return true, setOperationRecordAnnotation(newApp, *record)
+}
+
+func getOperationRecordAnnotation(app *v1beta1.Application) (*common.WorkflowOperationRecord, error) {
+	value, found := app.GetAnnotations()[oam.AnnotationOperationRecord]
+	if !found {
+		return nil, nil
+	}
+	record := &common.WorkflowOperationRecord{}
+	if err := json.Unmarshal([]byte(value), record); err != nil {
+		return nil, fmt.Errorf("invalid annotation %s: %w", oam.AnnotationOperationRecord, err)
+	}
+	return record, nil
+}
+
+func setOperationRecordAnnotation(app *v1beta1.Application, record common.WorkflowOperationRecord) error {
+	bs, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	metav1.SetMetaDataAnnotation(&app.ObjectMeta, oam.AnnotationOperationRecord, string(bs))
+	return nil
+}
+
+// FormatOperationRecord formats the record as a human-readable message
+func FormatOperationRecord(record common.WorkflowOperationRecord) string {
+	msg := "Workflow " + record.Operation
+	if record.Step != "" {
+		msg += " from step " + record.Step
+	}
+	if record.User != "" {
+		msg += " by " + record.User
+		if len(record.Groups) > 0 {
+			msg += fmt.Sprintf(" (groups: %s)", strings.Join(record.Groups, ","))
+		}
+	}
+	if record.Reason != "" {
+		msg += ": " + record.Reason
+	}
+	return msg
+}
+
+func newOperationEvent(app *v1beta1.Application, record common.WorkflowOperationRecord) *corev1.Event {
+	return &corev1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: app.Name + ".",
+			Namespace:    app.Namespace,
+		},
+		InvolvedObject: corev1.ObjectReference{
+			APIVersion:      v1beta1.SchemeGroupVersion.String(),
+			Kind:            v1beta1.ApplicationKind,
+			Name:            app.Name,
+			Namespace:       app.Namespace,
+			UID:             app.UID,
+			ResourceVersion: app.ResourceVersion,
+		},
+		Reason:         types.ReasonWorkflowOperated,
+		Message:        FormatOperationRecord(record),
+		Type:           corev1.EventTypeNormal,
+		Source:         corev1.EventSource{Component: operationEventSource},
+		FirstTimestamp: record.Time,
+		LastTimestamp:  record.Time,
+		Count:          1,
+	}
+}
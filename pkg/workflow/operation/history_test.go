@@ -0,0 +1,133 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package operation
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	workflowv1alpha1 "github.com/kubevela/workflow/api/v1alpha1"
+	"github.com/stretchr/testify/require"
+	authv1 "k8s.io/api/authentication/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/common"
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1beta1"
+	"github.com/oam-dev/kubevela/apis/types"
+	"github.com/oam-dev/kubevela/pkg/oam"
+	velacommon "github.com/oam-dev/kubevela/pkg/utils/common"
+)
+
+func TestRecordOperation(t *testing.T) {
+	r := require.New(t)
+	app := &v1beta1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "default"},
+		Status: common.AppStatus{Workflow: &common.WorkflowStatus{
+			Steps: []workflowv1alpha1.WorkflowStepStatus{{StepStatus: workflowv1alpha1.StepStatus{Name: "deploy", Phase: workflowv1alpha1.WorkflowStepPhaseRunning}}},
+		}},
+	}
+	cli := fake.NewClientBuilder().WithScheme(velacommon.Scheme).WithObjects(app).WithStatusSubresource(app).WithInterceptorFuncs(interceptor.Funcs{
+		// the webhook stamps the identity of the operator in the operation record annotation
+		Patch: func(ctx context.Context, c client.WithWatch, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+			oldApp := &v1beta1.Application{}
+			if err := c.Get(ctx, client.ObjectKeyFromObject(obj), oldApp); err != nil {
+				return err
+			}
+			if err := c.Patch(ctx, obj, patch, opts...); err != nil {
+				return err
+			}
+			newApp := obj.(*v1beta1.Application)
+			if _, err := StampOperationRecord(oldApp, newApp, authv1.UserInfo{Username: "alice"}); err != nil {
+				return err
+			}
+			return c.Update(ctx, newApp)
+		},
+	}).Build()
+	ctx := ContextWithOperationReason(context.Background(), "hold the release")
+
+	r.NoError(NewApplicationWorkflowStepOperator(cli, nil, app).Suspend(ctx, "deploy"))
+	r.NoError(cli.Get(ctx, client.ObjectKeyFromObject(app), app))
+	r.True(app.Status.Workflow.Suspend)
+	r.Len(app.Status.OperationHistory, 1)
+	record := app.Status.OperationHistory[0]
+	r.Equal(OperationSuspend, record.Operation)
+	r.Equal("deploy", record.Step)
+	r.Equal("alice", record.User)
+	r.Equal("hold the release", record.Reason)
+
+	events := &corev1.EventList{}
+	r.NoError(cli.List(ctx, events, client.InNamespace("default")))
+	r.Len(events.Items, 1)
+	r.Equal(types.ReasonWorkflowOperated, events.Items[0].Reason)
+	r.Equal("app", events.Items[0].InvolvedObject.Name)
+	r.Equal("Workflow suspend from step deploy by alice: hold the release", events.Items[0].Message)
+
+	// the operation history is bounded
+	for i := 0; i < MaxOperationHistory; i++ {
+		RecordOperation(ContextWithOperationReason(context.Background(), fmt.Sprintf("retry-%d", i)), cli, app, OperationRestart, "")
+	}
+	r.NoError(cli.Get(ctx, client.ObjectKeyFromObject(app), app))
+	r.Len(app.Status.OperationHistory, MaxOperationHistory)
+	r.Equal("retry-0", app.Status.OperationHistory[0].Reason)
+	r.Equal(fmt.Sprintf("retry-%d", MaxOperationHistory-1), app.Status.OperationHistory[MaxOperationHistory-1].Reason)
+}
+
+func TestRecordOperationFailure(t *testing.T) {
+	r := require.New(t)
+	app := &v1beta1.Application{ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "default"}}
+	cli := fake.NewClientBuilder().WithScheme(velacommon.Scheme).Build()
+	// the failure of recording the operation is not returned
+	RecordOperation(context.Background(), cli, app, OperationTerminate, "")
+	events := &corev1.EventList{}
+	r.NoError(cli.List(context.Background(), events, client.InNamespace("default")))
+	r.Empty(events.Items)
+}
+
+func TestStampOperationRecord(t *testing.T) {
+	r := require.New(t)
+	t0, t1 := metav1.NewTime(time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)), metav1.NewTime(time.Date(2026, 1, 2, 4, 4, 5, 0, time.UTC))
+	oldApp := &v1beta1.Application{}
+	r.NoError(setOperationRecordAnnotation(oldApp, common.WorkflowOperationRecord{Operation: OperationSuspend, User: "bob", Time: t0}))
+	newApp := oldApp.DeepCopy()
+	userInfo := authv1.UserInfo{Username: "alice", Groups: []string{"sre"}}
+	stamped, err := StampOperationRecord(oldApp, newApp, userInfo)
+	r.NoError(err)
+	r.False(stamped)
+	r.Equal(oldApp.Annotations, newApp.Annotations)
+
+	// the changed record is stamped with the requesting user even if the user is forged
+	r.NoError(setOperationRecordAnnotation(newApp, common.WorkflowOperationRecord{Operation: OperationResume, User: "carol", Reason: "go on", Time: t1}))
+	stamped, err = StampOperationRecord(oldApp, newApp, userInfo)
+	r.NoError(err)
+	r.True(stamped)
+	record, err := getOperationRecordAnnotation(newApp)
+	r.NoError(err)
+	r.True(t1.Equal(&record.Time))
+	record.Time = t1
+	r.Equal(&common.WorkflowOperationRecord{Operation: OperationResume, User: "alice", Groups: []string{"sre"}, Reason: "go on", Time: t1}, record)
+
+	// the invalid record is rejected
+	newApp.Annotations[oam.AnnotationOperationRecord] = "invalid"
+	_, err = StampOperationRecord(oldApp, newApp, userInfo)
+	r.Error(err)
+}
@@ -87,6 +87,7 @@ func (wo appWorkflowOperator) Suspend(ctx context.Context) error {
 	if err := SuspendWorkflow(ctx, wo.cli, app, ""); err != nil {
 		return err
 	}
+	RecordOperation(ctx, wo.cli, app, OperationSuspend, "")
 	return writeOutputF(wo.outputWriter, "Successfully suspend workflow: %s\n", app.Name)
 }
 
@@ -106,6 +107,7 @@ func (wo appWorkflowStepOperator) Suspend(ctx context.Context, step string) erro
 	if err := SuspendWorkflow(ctx, wo.cli, app, step); err != nil {
 		return err
 	}
+	RecordOperation(ctx, wo.cli, app, OperationSuspend, step)
 	return writeOutputF(wo.outputWriter, "Successfully suspend workflow %s from step %s \n", app.Name, step)
 }
 
@@ -172,6 +174,7 @@ func (wo appWorkflowOperator) Resume(ctx context.Context) error {
 			return err
 		}
 	}
+	RecordOperation(ctx, wo.cli, app, OperationResume, "")
 	return writeOutputF(wo.outputWriter, "Successfully resume workflow: %s\n", app.Name)
 }
 
@@ -197,6 +200,7 @@ func (wo appWorkflowStepOperator) Resume(ctx context.Context, step string) error
 			return err
 		}
 	}
+	RecordOperation(ctx, wo.cli, app, OperationResume, step)
 	return writeOutputF(wo.outputWriter, "Successfully resume workflow %s from step %s \n", app.Name, step)
 }
 
@@ -258,6 +262,7 @@ func (wo appWorkflowOperator) Rollback(ctx context.Context) error {
 		if err := wo.cli.Update(ctx, app); err != nil {
 			return err
 		}
+		RecordOperation(ctx, wo.cli, app, OperationRollback, "")
 
 		fmt.Printf("Successfully rollback workflow to the latest revision: %s\n", app.Name)
 		return nil
@@ -333,6 +338,7 @@ func (wo appWorkflowOperator) Rollback(ctx context.Context) error {
 		}
 		app.Status.Workflow = rev.Status.Workflow
 		app.Status.Services = []common.ApplicationComponentStatus{}
+		app.Status.HealthStatus = ""
 		app.Status.AppliedResources = []common.ClusterObjectReference{}
 		for _, rsc := range matchRT.Spec.ManagedResources {
 			app.Status.AppliedResources = append(app.Status.AppliedResources, rsc.ClusterObjectReference)
@@ -395,6 +401,7 @@ func (wo appWorkflowOperator) Rollback(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	RecordOperation(ctx, wo.cli, app, OperationRollback, "")
 	return nil
 }
 
@@ -411,6 +418,7 @@ func (wo appWorkflowOperator) Restart(ctx context.Context) error {
 	if err := wo.cli.Status().Update(ctx, app); err != nil {
 		return err
 	}
+	RecordOperation(ctx, wo.cli, app, OperationRestart, "")
 
 	return writeOutputF(wo.outputWriter, "Successfully restart workflow: %s\n", app.Name)
 }
@@ -459,6 +467,7 @@ func (wo appWorkflowStepOperator) Restart(ctx context.Context, step string) erro
 			return err
 		}
 	}
+	RecordOperation(ctx, wo.cli, app, OperationRestart, step)
 	return writeOutputF(wo.outputWriter, "Successfully restart workflow %s from step %s\n", app.Name, step)
 }
 
@@ -467,6 +476,7 @@ func (wo appWorkflowOperator) Terminate(ctx context.Context) error {
 	if err := TerminateWorkflow(ctx, wo.cli, app); err != nil {
 		return err
 	}
+	RecordOperation(ctx, wo.cli, app, OperationTerminate, "")
 
 	return writeOutputF(wo.outputWriter, "Successfully terminate workflow: %s\n", app.Name)
 }
@@ -66,9 +66,10 @@ type DeployWorkflowStepExecutor interface {
 }
 
 // NewDeployWorkflowStepExecutor .
-func NewDeployWorkflowStepExecutor(cli client.Client, af *appfile.Appfile, apply oamprovidertypes.ComponentApply, healthCheck oamprovidertypes.ComponentHealthCheck, renderer oamprovidertypes.WorkloadRender, parameter DeployParameter) DeployWorkflowStepExecutor {
+func NewDeployWorkflowStepExecutor(cli client.Client, app *v1beta1.Application, af *appfile.Appfile, apply oamprovidertypes.ComponentApply, healthCheck oamprovidertypes.ComponentHealthCheck, renderer oamprovidertypes.WorkloadRender, parameter DeployParameter) DeployWorkflowStepExecutor {
 	return &deployWorkflowStepExecutor{
 		cli:         cli,
+		app:         app,
 		af:          af,
 		apply:       apply,
 		healthCheck: healthCheck,
@@ -79,6 +80,7 @@ func NewDeployWorkflowStepExecutor(cli client.Client, af *appfile.Appfile, apply
 
 type deployWorkflowStepExecutor struct {
 	cli         client.Client
+	app         *v1beta1.Application
 	af          *appfile.Appfile
 	apply       oamprovidertypes.ComponentApply
 	healthCheck oamprovidertypes.ComponentHealthCheck
@@ -99,7 +101,7 @@ func (executor *deployWorkflowStepExecutor) Deploy(ctx context.Context) (bool, s
 	}
 
 	// Dealing with topology, override and replication policies in order.
-	placements, err := pkgpolicy.GetPlacementsFromTopologyPolicies(ctx, executor.cli, executor.af.Namespace, policies, resourcekeeper.AllowCrossNamespaceResource)
+	placements, err := pkgpolicy.GetPlacementsFromTopologyPolicies(ctx, executor.cli, executor.af.Namespace, policies, resourcekeeper.AllowCrossNamespaceResource, executor.app)
 	if err != nil {
 		return false, "", err
 	}
@@ -169,7 +169,7 @@ func Deploy(ctx context.Context, params *DeployParams) (*any, error) {
 	if params.Params.Parallelism <= 0 {
 		return nil, errors.Errorf("parallelism cannot be smaller than 1")
 	}
-	executor := NewDeployWorkflowStepExecutor(params.KubeClient, params.Appfile, params.ComponentApply, params.ComponentHealthCheck, params.WorkloadRender, params.Params)
+	executor := NewDeployWorkflowStepExecutor(params.KubeClient, params.App, params.Appfile, params.ComponentApply, params.ComponentHealthCheck, params.WorkloadRender, params.Params)
 	healthy, reason, err := executor.Deploy(ctx)
 	if err != nil {
 		return nil, err
@@ -200,7 +200,7 @@ func GetPlacementsFromTopologyPolicies(ctx context.Context, params *PoliciesPara
 	if err != nil {
 		return nil, err
 	}
-	placements, err := pkgpolicy.GetPlacementsFromTopologyPolicies(ctx, params.KubeClient, params.Appfile.Namespace, policies, true)
+	placements, err := pkgpolicy.GetPlacementsFromTopologyPolicies(ctx, params.KubeClient, params.Appfile.Namespace, policies, true, params.App)
 	if err != nil {
 		return nil, err
 	}
@@ -19,6 +19,7 @@ package multicluster
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
 	"sync"
 
@@ -58,6 +59,8 @@ type DeployParameter struct {
 	IgnoreTerraformComponent bool `json:"ignoreTerraformComponent"`
 	// The policies that embeds in the `deploy` step directly
 	InlinePolicies []v1beta1.AppPolicy `json:"inlinePolicies,omitempty"`
+	// The progressive delivery strategy to roll out the components to the clusters in waves
+	Strategy *DeployStrategy `json:"strategy,omitempty"`
 }
 
 // DeployWorkflowStepExecutor executor to run deploy workflow step
@@ -66,9 +69,14 @@ type DeployWorkflowStepExecutor interface {
 }
 
 // NewDeployWorkflowStepExecutor .
-func NewDeployWorkflowStepExecutor(cli client.Client, af *appfile.Appfile, apply oamprovidertypes.ComponentApply, healthCheck oamprovidertypes.ComponentHealthCheck, renderer oamprovidertypes.WorkloadRender, parameter DeployParameter) DeployWorkflowStepExecutor {
+func NewDeployWorkflowStepExecutor(cli client.Client, app *v1beta1.Application, af *appfile.Appfile, apply oamprovidertypes.ComponentApply, healthCheck oamprovidertypes.ComponentHealthCheck, renderer oamprovidertypes.WorkloadRender, parameter DeployParameter) DeployWorkflowStepExecutor {
+	return newDeployWorkflowStepExecutor(cli, app, af, apply, healthCheck, renderer, parameter)
+}
+
+func newDeployWorkflowStepExecutor(cli client.Client, app *v1beta1.Application, af *appfile.Appfile, apply oamprovidertypes.ComponentApply, healthCheck oamprovidertypes.ComponentHealthCheck, renderer oamprovidertypes.WorkloadRender, parameter DeployParameter) *deployWorkflowStepExecutor {
 	return &deployWorkflowStepExecutor{
 		cli:         cli,
+		app:         app,
 		af:          af,
 		apply:       apply,
 		healthCheck: healthCheck,
@@ -79,6 +87,7 @@ func NewDeployWorkflowStepExecutor(cli client.Client, af *appfile.Appfile, apply
 
 type deployWorkflowStepExecutor struct {
 	cli         client.Client
+	app         *v1beta1.Application
 	af          *appfile.Appfile
 	apply       oamprovidertypes.ComponentApply
 	healthCheck oamprovidertypes.ComponentHealthCheck
@@ -88,30 +97,128 @@ type deployWorkflowStepExecutor struct {
 
 // Deploy execute deploy workflow step
 func (executor *deployWorkflowStepExecutor) Deploy(ctx context.Context) (bool, string, error) {
-	policies, err := selectPolicies(executor.af.Policies, executor.parameter.Policies)
+	components, placements, err := executor.render(ctx, executor.app, executor.af.Policies, executor.af.Components)
 	if err != nil {
 		return false, "", err
 	}
-	policies = append(policies, fillInlinePolicyNames(executor.parameter.InlinePolicies)...)
-	components, err := loadComponents(ctx, executor.renderer, executor.cli, executor.af, executor.af.Components, executor.parameter.IgnoreTerraformComponent)
+	return executor.applyPlacements(ctx, components, placements)
+}
+
+// applyPlacements applies the components to the placements. The clusters pinned to other revisions of the application
+// are applied with the components of the pinned revisions instead, and the revision deployed to each cluster is
+// recorded in the status of the application.
+func (executor *deployWorkflowStepExecutor) applyPlacements(ctx context.Context, components []common.ApplicationComponent, placements []v1alpha1.PlacementDecision) (bool, string, error) {
+	var pins map[string]string
+	if executor.app != nil {
+		var err error
+		if pins, err = oam.GetPlacementRevisions(executor.app); err != nil {
+			return false, "", errors.Wrapf(err, "failed to parse the revisions pinned to the clusters")
+		}
+	}
+	var current []v1alpha1.PlacementDecision
+	pinned := map[string][]v1alpha1.PlacementDecision{}
+	for _, pl := range placements {
+		if revision := pins[pl.Cluster]; revision != "" && revision != executor.af.AppRevisionName {
+			pinned[revision] = append(pinned[revision], pl)
+		} else {
+			current = append(current, pl)
+		}
+	}
+	healthy, reason, err := applyComponents(ctx, executor.apply, executor.healthCheck, components, current, int(executor.parameter.Parallelism))
 	if err != nil {
 		return false, "", err
 	}
+	executor.recordPlacementRevisions(current, executor.af.AppRevisionName, false)
+	reasons := []string{reason}
+	revisions := pkgmaps.Keys(pinned)
+	sort.Strings(revisions)
+	for _, revision := range revisions {
+		// the components are rendered with the definitions recorded in the pinned revision
+		revCtx := oamprovidertypes.WithAppRevision(ctx, revision)
+		revComponents, err := executor.renderRevision(revCtx, revision)
+		if err != nil {
+			return false, "", err
+		}
+		revHealthy, revReason, err := applyComponents(revCtx, executor.apply, executor.healthCheck, revComponents, pinned[revision], int(executor.parameter.Parallelism))
+		if err != nil {
+			return false, "", err
+		}
+		executor.recordPlacementRevisions(pinned[revision], revision, true)
+		healthy = healthy && revHealthy
+		reasons = append(reasons, revReason)
+	}
+	return healthy, strings.Join(slices.Filter(reasons, func(r string) bool { return r != "" }), ","), nil
+}
+
+// renderRevision loads the components of the application revision pinned to the clusters. The components are rendered
+// directly without resolving the topology again, since the clusters to deploy are decided by the current placements.
+// The override and replication policies of the revision that the step applies are kept, the policies not in the
+// revision are skipped.
+func (executor *deployWorkflowStepExecutor) renderRevision(ctx context.Context, revision string) ([]common.ApplicationComponent, error) {
+	rev := &v1beta1.ApplicationRevision{}
+	if err := executor.cli.Get(ctx, client.ObjectKey{Namespace: executor.af.Namespace, Name: revision}, rev); err != nil {
+		return nil, errors.Wrapf(err, "failed to get the revision %s pinned to the clusters", revision)
+	}
+	app := rev.Spec.Application
+	components, err := loadComponents(ctx, executor.renderer, executor.cli, executor.af, app.Spec.Components, executor.parameter.IgnoreTerraformComponent)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to render the revision %s pinned to the clusters", revision)
+	}
+	policies := slices.Filter(app.Spec.Policies, func(policy v1beta1.AppPolicy) bool {
+		return policy.Type != v1alpha1.TopologyPolicyType && slices.Contains(executor.parameter.Policies, policy.Name)
+	})
+	if components, err = overrideConfiguration(policies, components); err != nil {
+		return nil, errors.Wrapf(err, "failed to render the revision %s pinned to the clusters", revision)
+	}
+	if components, err = pkgpolicy.ReplicateComponents(policies, components); err != nil {
+		return nil, errors.Wrapf(err, "failed to render the revision %s pinned to the clusters", revision)
+	}
+	return components, nil
+}
+
+// recordPlacementRevisions records the revision deployed to the clusters in the status of the application
+func (executor *deployWorkflowStepExecutor) recordPlacementRevisions(placements []v1alpha1.PlacementDecision, revision string, pinned bool) {
+	if executor.app == nil {
+		return
+	}
+	for _, pl := range placements {
+		record := common.PlacementRevision{Cluster: pl.Cluster, Revision: revision, Pinned: pinned}
+		idx := slices.Index(executor.app.Status.PlacementRevisions, func(pr common.PlacementRevision) bool { return pr.Cluster == pl.Cluster })
+		if idx >= 0 {
+			executor.app.Status.PlacementRevisions[idx] = record
+		} else {
+			executor.app.Status.PlacementRevisions = append(executor.app.Status.PlacementRevisions, record)
+		}
+	}
+}
+
+// render loads the components and dispatches them to the placements with the selected policies.
+// The placement decisions are recorded in the status of app if it is not nil.
+func (executor *deployWorkflowStepExecutor) render(ctx context.Context, app *v1beta1.Application, appPolicies []v1beta1.AppPolicy, appComponents []common.ApplicationComponent) ([]common.ApplicationComponent, []v1alpha1.PlacementDecision, error) {
+	policies, err := selectPolicies(appPolicies, executor.parameter.Policies)
+	if err != nil {
+		return nil, nil, err
+	}
+	policies = append(policies, fillInlinePolicyNames(executor.parameter.InlinePolicies)...)
+	components, err := loadComponents(ctx, executor.renderer, executor.cli, executor.af, appComponents, executor.parameter.IgnoreTerraformComponent)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	// Dealing with topology, override and replication policies in order.
-	placements, err := pkgpolicy.GetPlacementsFromTopologyPolicies(ctx, executor.cli, executor.af.Namespace, policies, resourcekeeper.AllowCrossNamespaceResource)
+	placements, err := pkgpolicy.GetPlacementsFromTopologyPolicies(ctx, executor.cli, executor.af.Namespace, policies, resourcekeeper.AllowCrossNamespaceResource, app)
 	if err != nil {
-		return false, "", err
+		return nil, nil, err
 	}
 	components, err = overrideConfiguration(policies, components)
 	if err != nil {
-		return false, "", err
+		return nil, nil, err
 	}
 	components, err = pkgpolicy.ReplicateComponents(policies, components)
 	if err != nil {
-		return false, "", err
+		return nil, nil, err
 	}
-	return applyComponents(ctx, executor.apply, executor.healthCheck, components, placements, int(executor.parameter.Parallelism))
+	return components, placements, nil
 }
 
 func selectPolicies(policies []v1beta1.AppPolicy, policyNames []string) ([]v1beta1.AppPolicy, error) {
@@ -26,6 +26,7 @@ import (
 
 	"cuelang.org/go/cue"
 	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 
@@ -35,6 +36,7 @@ import (
 
 	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1alpha1"
 	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1beta1"
+	"github.com/oam-dev/kubevela/pkg/appfile"
 	"github.com/oam-dev/kubevela/pkg/oam"
 )
 
@@ -408,3 +410,48 @@ func TestApplyComponentsIO(t *testing.T) {
 
 	})
 }
+
+func TestDeployPinnedPlacements(t *testing.T) {
+	r := require.New(t)
+	ctx := context.Background()
+	topology := []v1beta1.AppPolicy{{
+		Name:       "topology",
+		Type:       v1alpha1.TopologyPolicyType,
+		Properties: &runtime.RawExtension{Raw: []byte(`{"clusters":["c1","c2","c3"]}`)},
+	}}
+	rev := &v1beta1.ApplicationRevision{
+		ObjectMeta: metav1.ObjectMeta{Name: "app-v1", Namespace: "default", Labels: map[string]string{oam.LabelAppName: "app"}},
+		Spec: v1beta1.ApplicationRevisionSpec{ApplicationRevisionCompressibleFields: v1beta1.ApplicationRevisionCompressibleFields{
+			Application: v1beta1.Application{Spec: v1beta1.ApplicationSpec{
+				// the topology of the pinned revision is not resolved again
+				Components: []apicommon.ApplicationComponent{{Name: "comp", Type: "webservice", Properties: &runtime.RawExtension{Raw: []byte(`{"image":"v1"}`)}}},
+			}},
+		}},
+	}
+	cli := newStrategyTestClient(t, []string{"c1", "c2", "c3"}, rev)
+	app := &v1beta1.Application{ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "default"}}
+	// the cluster pinned to the current revision follows the current revision
+	r.NoError(oam.SetPlacementRevisions(app, map[string]string{"c2": "app-v1", "c3": "app-v2"}))
+	af := &appfile.Appfile{
+		Name:            "app",
+		Namespace:       "default",
+		AppRevisionName: "app-v2",
+		Components:      []apicommon.ApplicationComponent{{Name: "comp", Type: "webservice", Properties: &runtime.RawExtension{Raw: []byte(`{"image":"v2"}`)}}},
+		Policies:        topology,
+	}
+	clusters := &fakeClusters{applied: map[string]string{}, healthy: map[string]bool{"c1": true, "c2": true, "c3": true}}
+	executor := newDeployWorkflowStepExecutor(cli, app, af, clusters.apply, clusters.healthCheck, nil, DeployParameter{Policies: []string{"topology"}, Parallelism: 5})
+	healthy, _, err := executor.Deploy(ctx)
+	r.NoError(err)
+	r.True(healthy)
+	r.Equal(map[string]string{"c1": `{"image":"v2"}`, "c2": `{"image":"v1"}`, "c3": `{"image":"v2"}`}, clusters.applied)
+	r.Equal([]apicommon.PlacementRevision{
+		{Cluster: "c1", Revision: "app-v2"},
+		{Cluster: "c3", Revision: "app-v2"},
+		{Cluster: "c2", Revision: "app-v1", Pinned: true},
+	}, app.Status.PlacementRevisions)
+
+	r.NoError(oam.SetPlacementRevisions(app, map[string]string{"c2": "app-v0"}))
+	_, _, err = executor.Deploy(ctx)
+	r.ErrorContains(err, "failed to get the revision app-v0 pinned to the clusters")
+}
@@ -35,6 +35,7 @@
 		parallelism:              int
 		ignoreTerraformComponent: bool
 		inlinePolicies: *[] | [...{...}]
+		strategy?: {...}
 	}
 	$returns?: {...}
 }
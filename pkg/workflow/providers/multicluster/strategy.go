@@ -0,0 +1,299 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package multicluster
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/kubevela/workflow/pkg/types"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1alpha1"
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1beta1"
+	"github.com/oam-dev/kubevela/pkg/oam"
+	"github.com/oam-dev/kubevela/pkg/oam/util"
+)
+
+const (
+	// StrategyOnFailurePause suspends the workflow when a wave is not healthy before the progress deadline
+	StrategyOnFailurePause = "pause"
+	// StrategyOnFailureRollback rolls back the deployed waves to the last succeeded revision
+	// and fails the workflow when a wave is not healthy before the progress deadline
+	StrategyOnFailureRollback = "rollback"
+
+	defaultProgressDeadline = 10 * time.Minute
+	strategyStateKey        = "deploy-strategy"
+)
+
+// DeployStrategy is the progressive delivery strategy of the deploy step. The components are
+// deployed to the canary clusters first, then to the rest clusters in waves. Each wave starts
+// only after all the components in the previous waves are healthy.
+type DeployStrategy struct {
+	// Canary is the clusters to deploy in the first wave. If not set, the first cluster is used.
+	Canary []string `json:"canary,omitempty"`
+	// BatchSize is the number of clusters in each wave after the canary.
+	BatchSize int `json:"batchSize,omitempty"`
+	// Percentage is the percentage of the clusters after the canary to deploy in each wave.
+	// It is ignored if batchSize is set. If neither is set, the rest clusters are deployed in one wave.
+	Percentage int `json:"percentage,omitempty"`
+	// ProgressDeadline is the maximum duration for a wave to become healthy, defaults to 10m.
+	ProgressDeadline string `json:"progressDeadline,omitempty"`
+	// OnFailure is the action to take when a wave is not healthy before the deadline, pause or rollback.
+	OnFailure string `json:"onFailure,omitempty"`
+}
+
+// wave is a group of clusters deployed together
+type wave struct {
+	clusters   []string
+	placements []v1alpha1.PlacementDecision
+}
+
+// strategyState is the progress of the strategy persisted in the workflow context
+type strategyState struct {
+	Wave      int       `json:"wave"`
+	StartTime time.Time `json:"startTime"`
+	Paused    bool      `json:"paused,omitempty"`
+}
+
+// mutableValueStore stores the values across the executions of the workflow step
+type mutableValueStore interface {
+	GetMutableValue(paths ...string) string
+	SetMutableValue(data string, paths ...string)
+}
+
+type progressiveDeployer struct {
+	*deployWorkflowStepExecutor
+	strategy *DeployStrategy
+	store    mutableValueStore
+	action   types.Action
+	now      func() time.Time
+}
+
+func newProgressiveDeployer(executor *deployWorkflowStepExecutor, strategy *DeployStrategy, store mutableValueStore, action types.Action) *progressiveDeployer {
+	return &progressiveDeployer{
+		deployWorkflowStepExecutor: executor,
+		strategy:                   strategy,
+		store:                      store,
+		action:                     action,
+		now:                        time.Now,
+	}
+}
+
+func (strategy *DeployStrategy) validate() (time.Duration, error) {
+	if strategy.BatchSize < 0 {
+		return 0, errors.Errorf("batchSize cannot be negative")
+	}
+	if strategy.Percentage < 0 || strategy.Percentage > 100 {
+		return 0, errors.Errorf("percentage must be between 0 and 100")
+	}
+	switch strategy.OnFailure {
+	case "", StrategyOnFailurePause, StrategyOnFailureRollback:
+	default:
+		return 0, errors.Errorf("unknown onFailure action %s, must be %s or %s", strategy.OnFailure, StrategyOnFailurePause, StrategyOnFailureRollback)
+	}
+	if strategy.ProgressDeadline == "" {
+		return defaultProgressDeadline, nil
+	}
+	deadline, err := time.ParseDuration(strategy.ProgressDeadline)
+	if err != nil {
+		return 0, errors.Wrapf(err, "invalid progressDeadline")
+	}
+	return deadline, nil
+}
+
+// splitWaves groups the placements by cluster and splits the clusters into the canary wave and the following waves
+func (strategy *DeployStrategy) splitWaves(placements []v1alpha1.PlacementDecision) ([]wave, error) {
+	var clusters []string
+	clusterPlacements := map[string][]v1alpha1.PlacementDecision{}
+	for _, pl := range placements {
+		if _, found := clusterPlacements[pl.Cluster]; !found {
+			clusters = append(clusters, pl.Cluster)
+		}
+		clusterPlacements[pl.Cluster] = append(clusterPlacements[pl.Cluster], pl)
+	}
+	if len(clusters) == 0 {
+		return nil, nil
+	}
+
+	canary := strategy.Canary
+	if len(canary) == 0 {
+		canary = clusters[:1]
+	}
+	isCanary := map[string]bool{}
+	for _, cluster := range canary {
+		if _, found := clusterPlacements[cluster]; !found {
+			return nil, errors.Errorf("canary cluster %s is not in the placements of the deploy step", cluster)
+		}
+		isCanary[cluster] = true
+	}
+	var rest []string
+	for _, cluster := range clusters {
+		if !isCanary[cluster] {
+			rest = append(rest, cluster)
+		}
+	}
+
+	size := len(rest)
+	switch {
+	case strategy.BatchSize > 0:
+		size = strategy.BatchSize
+	case strategy.Percentage > 0:
+		size = max((len(rest)*strategy.Percentage+99)/100, 1)
+	}
+	groups := [][]string{canary}
+	for i := 0; i < len(rest); i += size {
+		groups = append(groups, rest[i:min(i+size, len(rest))])
+	}
+
+	var waves []wave
+	for _, group := range groups {
+		w := wave{clusters: group}
+		for _, cluster := range group {
+			w.placements = append(w.placements, clusterPlacements[cluster]...)
+		}
+		waves = append(waves, w)
+	}
+	return waves, nil
+}
+
+func (d *progressiveDeployer) stateKey() []string {
+	return []string{strategyStateKey, d.action.GetStatus().Name}
+}
+
+func (d *progressiveDeployer) loadState() (*strategyState, error) {
+	state := &strategyState{}
+	if data := d.store.GetMutableValue(d.stateKey()...); data != "" {
+		if err := json.Unmarshal([]byte(data), state); err != nil {
+			return nil, errors.Wrapf(err, "failed to load the progress of deploy strategy")
+		}
+	}
+	return state, nil
+}
+
+func (d *progressiveDeployer) saveState(state *strategyState) error {
+	bs, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+	d.store.SetMutableValue(string(bs), d.stateKey()...)
+	return nil
+}
+
+// Deploy rolls out the components wave by wave. The wave in progress is recorded in the workflow
+// context, so that the step continues from it in the next execution.
+func (d *progressiveDeployer) Deploy(ctx context.Context) error {
+	deadline, err := d.strategy.validate()
+	if err != nil {
+		return err
+	}
+	components, placements, err := d.render(ctx, d.app, d.af.Policies, d.af.Components)
+	if err != nil {
+		return err
+	}
+	waves, err := d.strategy.splitWaves(placements)
+	if err != nil {
+		return err
+	}
+	state, err := d.loadState()
+	if err != nil {
+		return err
+	}
+	now := d.now()
+	if state.Paused || state.StartTime.IsZero() {
+		// the step is resumed after paused, restart the progress deadline of the current wave
+		state.Paused = false
+		state.StartTime = now
+	}
+
+	for state.Wave < len(waves) {
+		current := waves[state.Wave]
+		healthy, reason, err := d.applyPlacements(ctx, components, current.placements)
+		if err != nil {
+			return err
+		}
+		if !healthy {
+			progress := fmt.Sprintf("wave %d/%d [%s]", state.Wave+1, len(waves), strings.Join(current.clusters, ","))
+			if now.Sub(state.StartTime) > deadline {
+				return d.handleFailure(ctx, state, waves, fmt.Sprintf("%s is not healthy after %s: %s", progress, deadline, reason))
+			}
+			d.action.Wait(fmt.Sprintf("%s is progressing, %d/%d waves healthy: %s", progress, state.Wave, len(waves), reason))
+			return d.saveState(state)
+		}
+		state.Wave++
+		state.StartTime = now
+	}
+	d.action.Message(fmt.Sprintf("all %d waves are healthy", len(waves)))
+	return d.saveState(state)
+}
+
+func (d *progressiveDeployer) handleFailure(ctx context.Context, state *strategyState, waves []wave, message string) error {
+	if d.strategy.OnFailure != StrategyOnFailureRollback {
+		state.Paused = true
+		d.action.Suspend(message + ", resume the workflow to continue waiting")
+		return d.saveState(state)
+	}
+	var placements []v1alpha1.PlacementDecision
+	for _, w := range waves[:state.Wave+1] {
+		placements = append(placements, w.placements...)
+	}
+	revision, err := d.rollback(ctx, placements)
+	if err != nil {
+		d.action.Fail(fmt.Sprintf("%s, failed to roll back: %s", message, err.Error()))
+	} else {
+		d.action.Fail(fmt.Sprintf("%s, rolled back to revision %s", message, revision))
+	}
+	return d.saveState(state)
+}
+
+// rollback re-applies the components of the last succeeded application revision to the placements
+func (d *progressiveDeployer) rollback(ctx context.Context, placements []v1alpha1.PlacementDecision) (string, error) {
+	revisions := &v1beta1.ApplicationRevisionList{}
+	if err := d.cli.List(ctx, revisions, client.InNamespace(d.af.Namespace), client.MatchingLabels{oam.LabelAppName: d.af.Name}); err != nil {
+		return "", err
+	}
+	var last *v1beta1.ApplicationRevision
+	lastNum := 0
+	for i, rev := range revisions.Items {
+		if rev.Name == d.af.AppRevisionName || !rev.Status.Succeeded {
+			continue
+		}
+		num, err := util.ExtractRevisionNum(rev.Name, "-")
+		if err != nil {
+			continue
+		}
+		if last == nil || num > lastNum {
+			last, lastNum = &revisions.Items[i], num
+		}
+	}
+	if last == nil {
+		return "", errors.Errorf("no succeeded revision found")
+	}
+	app := last.Spec.Application
+	components, _, err := d.render(ctx, nil, app.Spec.Policies, app.Spec.Components)
+	if err != nil {
+		return "", err
+	}
+	if _, _, err = applyComponents(ctx, d.apply, d.healthCheck, components, placements, int(d.parameter.Parallelism)); err != nil {
+		return "", err
+	}
+	return last.Name, nil
+}
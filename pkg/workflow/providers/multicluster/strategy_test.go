@@ -0,0 +1,304 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package multicluster
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"cuelang.org/go/cue"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	wfmock "github.com/kubevela/workflow/pkg/mock"
+	clusterv1alpha1 "github.com/oam-dev/cluster-gateway/pkg/apis/cluster/v1alpha1"
+	clustercommon "github.com/oam-dev/cluster-gateway/pkg/common"
+
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/common"
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1alpha1"
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1beta1"
+	"github.com/oam-dev/kubevela/apis/types"
+	"github.com/oam-dev/kubevela/pkg/appfile"
+	"github.com/oam-dev/kubevela/pkg/multicluster"
+	"github.com/oam-dev/kubevela/pkg/oam"
+	commontypes "github.com/oam-dev/kubevela/pkg/utils/common"
+)
+
+type fakeMutableValueStore map[string]string
+
+func (s fakeMutableValueStore) GetMutableValue(paths ...string) string {
+	return s[strings.Join(paths, ".")]
+}
+
+func (s fakeMutableValueStore) SetMutableValue(data string, paths ...string) {
+	s[strings.Join(paths, ".")] = data
+}
+
+// fakeClusters records the components applied to each cluster and reports the healthy clusters
+type fakeClusters struct {
+	sync.Mutex
+	applied map[string]string
+	healthy map[string]bool
+}
+
+func (c *fakeClusters) apply(_ context.Context, comp common.ApplicationComponent, _ *cue.Value, clusterName string, _ string) (*unstructured.Unstructured, []*unstructured.Unstructured, bool, error) {
+	c.Lock()
+	defer c.Unlock()
+	c.applied[clusterName] = string(comp.Properties.Raw)
+	return nil, nil, c.healthy[clusterName], nil
+}
+
+func (c *fakeClusters) healthCheck(_ context.Context, _ common.ApplicationComponent, _ *cue.Value, clusterName string, _ string) (bool, *common.ApplicationComponentStatus, *unstructured.Unstructured, []*unstructured.Unstructured, error) {
+	c.Lock()
+	defer c.Unlock()
+	_, applied := c.applied[clusterName]
+	return applied && c.healthy[clusterName], nil, nil, nil, nil
+}
+
+func (c *fakeClusters) setHealthy(clusters ...string) {
+	c.Lock()
+	defer c.Unlock()
+	for _, cluster := range clusters {
+		c.healthy[cluster] = true
+	}
+}
+
+func (c *fakeClusters) appliedClusters() []string {
+	c.Lock()
+	defer c.Unlock()
+	var clusters []string
+	for cluster := range c.applied {
+		clusters = append(clusters, cluster)
+	}
+	return clusters
+}
+
+func newStrategyTestClient(t *testing.T, clusters []string, objs ...client.Object) client.Client {
+	originalNS := multicluster.ClusterGatewaySecretNamespace
+	multicluster.ClusterGatewaySecretNamespace = types.DefaultKubeVelaNS
+	t.Cleanup(func() {
+		multicluster.ClusterGatewaySecretNamespace = originalNS
+	})
+	for _, cluster := range clusters {
+		objs = append(objs, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      cluster,
+				Namespace: multicluster.ClusterGatewaySecretNamespace,
+				Labels: map[string]string{
+					clustercommon.LabelKeyClusterCredentialType: string(clusterv1alpha1.CredentialTypeX509Certificate),
+				},
+			},
+			Data: map[string][]byte{"endpoint": []byte("https://" + cluster)},
+		})
+	}
+	return fake.NewClientBuilder().WithScheme(commontypes.Scheme).WithObjects(objs...).Build()
+}
+
+func newStrategyTestDeployer(cli client.Client, clusters *fakeClusters, strategy *DeployStrategy, action *wfmock.Action, store fakeMutableValueStore) *progressiveDeployer {
+	af := &appfile.Appfile{
+		Name:            "app",
+		Namespace:       "default",
+		AppRevisionName: "app-v3",
+		Components:      []common.ApplicationComponent{{Name: "comp", Type: "webservice", Properties: &runtime.RawExtension{Raw: []byte(`{"image":"v3"}`)}}},
+		Policies: []v1beta1.AppPolicy{{
+			Name:       "topology",
+			Type:       v1alpha1.TopologyPolicyType,
+			Properties: &runtime.RawExtension{Raw: []byte(`{"clusters":["c1","c2","c3","c4","c5"]}`)},
+		}},
+	}
+	executor := newDeployWorkflowStepExecutor(cli, nil, af, clusters.apply, clusters.healthCheck, nil, DeployParameter{Policies: []string{"topology"}, Parallelism: 5})
+	return newProgressiveDeployer(executor, strategy, store, action)
+}
+
+func TestSplitWaves(t *testing.T) {
+	var placements []v1alpha1.PlacementDecision
+	for i := 1; i <= 5; i++ {
+		placements = append(placements, v1alpha1.PlacementDecision{Cluster: fmt.Sprintf("c%d", i), Namespace: "default"})
+	}
+	placements = append(placements, v1alpha1.PlacementDecision{Cluster: "c1", Namespace: "other"})
+
+	testCases := map[string]struct {
+		strategy    DeployStrategy
+		expected    [][]string
+		expectedErr string
+	}{
+		"first cluster as canary and the rest in one wave": {
+			strategy: DeployStrategy{},
+			expected: [][]string{{"c1"}, {"c2", "c3", "c4", "c5"}},
+		},
+		"batch size": {
+			strategy: DeployStrategy{Canary: []string{"c3"}, BatchSize: 3, Percentage: 50},
+			expected: [][]string{{"c3"}, {"c1", "c2", "c4"}, {"c5"}},
+		},
+		"percentage": {
+			strategy: DeployStrategy{Percentage: 30},
+			expected: [][]string{{"c1"}, {"c2", "c3"}, {"c4", "c5"}},
+		},
+		"all clusters as canary": {
+			strategy: DeployStrategy{Canary: []string{"c1", "c2", "c3", "c4", "c5"}, BatchSize: 1},
+			expected: [][]string{{"c1", "c2", "c3", "c4", "c5"}},
+		},
+		"unknown canary": {
+			strategy:    DeployStrategy{Canary: []string{"c6"}},
+			expectedErr: "canary cluster c6 is not in the placements",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			r := require.New(t)
+			waves, err := tc.strategy.splitWaves(placements)
+			if tc.expectedErr != "" {
+				r.ErrorContains(err, tc.expectedErr)
+				return
+			}
+			r.NoError(err)
+			var clusters [][]string
+			var deployed []v1alpha1.PlacementDecision
+			for _, w := range waves {
+				clusters = append(clusters, w.clusters)
+				deployed = append(deployed, w.placements...)
+			}
+			r.Equal(tc.expected, clusters)
+			r.ElementsMatch(placements, deployed)
+		})
+	}
+}
+
+func TestValidateDeployStrategy(t *testing.T) {
+	r := require.New(t)
+	deadline, err := (&DeployStrategy{}).validate()
+	r.NoError(err)
+	r.Equal(10*time.Minute, deadline)
+	deadline, err = (&DeployStrategy{ProgressDeadline: "30s", OnFailure: StrategyOnFailureRollback}).validate()
+	r.NoError(err)
+	r.Equal(30*time.Second, deadline)
+	_, err = (&DeployStrategy{ProgressDeadline: "invalid"}).validate()
+	r.ErrorContains(err, "invalid progressDeadline")
+	_, err = (&DeployStrategy{Percentage: 101}).validate()
+	r.ErrorContains(err, "percentage")
+	_, err = (&DeployStrategy{BatchSize: -1}).validate()
+	r.ErrorContains(err, "batchSize")
+	_, err = (&DeployStrategy{OnFailure: "ignore"}).validate()
+	r.ErrorContains(err, "unknown onFailure action ignore")
+}
+
+func TestProgressiveDeploy(t *testing.T) {
+	r := require.New(t)
+	ctx := context.Background()
+	cli := newStrategyTestClient(t, []string{"c1", "c2", "c3", "c4", "c5"})
+	clusters := &fakeClusters{applied: map[string]string{}, healthy: map[string]bool{}}
+	action := &wfmock.Action{}
+	store := fakeMutableValueStore{}
+	now := time.Now()
+	deployer := newStrategyTestDeployer(cli, clusters, &DeployStrategy{BatchSize: 2}, action, store)
+	deployer.now = func() time.Time { return now }
+
+	// the canary wave is deployed first
+	r.NoError(deployer.Deploy(ctx))
+	r.Equal("Wait", action.Phase)
+	r.Contains(action.Msg, "wave 1/3 [c1] is progressing, 0/3 waves healthy")
+	r.Equal([]string{"c1"}, clusters.appliedClusters())
+
+	// the healthy waves are passed in one execution
+	clusters.setHealthy("c1", "c2", "c3")
+	r.NoError(deployer.Deploy(ctx))
+	r.Equal("Wait", action.Phase)
+	r.Contains(action.Msg, "wave 3/3 [c4,c5] is progressing, 2/3 waves healthy")
+	r.ElementsMatch([]string{"c1", "c2", "c3", "c4", "c5"}, clusters.appliedClusters())
+
+	// the workflow is paused when the wave is not healthy before the deadline
+	now = now.Add(11 * time.Minute)
+	r.NoError(deployer.Deploy(ctx))
+	r.Equal("Suspend", action.Phase)
+	r.Contains(action.Msg, "wave 3/3 [c4,c5] is not healthy after 10m0s")
+
+	// the deadline restarts after resumed
+	r.NoError(deployer.Deploy(ctx))
+	r.Equal("Wait", action.Phase)
+	r.Contains(action.Msg, "wave 3/3 [c4,c5] is progressing")
+
+	clusters.setHealthy("c4", "c5")
+	r.NoError(deployer.Deploy(ctx))
+	r.Equal("all 3 waves are healthy", action.Msg)
+}
+
+func TestProgressiveDeployRollback(t *testing.T) {
+	newRevision := func(name string, succeeded bool, image string) client.Object {
+		return &v1beta1.ApplicationRevision{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", Labels: map[string]string{oam.LabelAppName: "app"}},
+			Spec: v1beta1.ApplicationRevisionSpec{ApplicationRevisionCompressibleFields: v1beta1.ApplicationRevisionCompressibleFields{
+				Application: v1beta1.Application{Spec: v1beta1.ApplicationSpec{
+					Components: []common.ApplicationComponent{{Name: "comp", Type: "webservice", Properties: &runtime.RawExtension{Raw: []byte(fmt.Sprintf(`{"image":"%s"}`, image))}}},
+					Policies: []v1beta1.AppPolicy{{
+						Name:       "topology",
+						Type:       v1alpha1.TopologyPolicyType,
+						Properties: &runtime.RawExtension{Raw: []byte(`{"clusters":["c1","c2","c3","c4","c5"]}`)},
+					}},
+				}},
+			}},
+			Status: v1beta1.ApplicationRevisionStatus{Succeeded: succeeded},
+		}
+	}
+	testCases := map[string]struct {
+		revisions []client.Object
+		expected  string
+		image     string
+	}{
+		"roll back to the last succeeded revision": {
+			revisions: []client.Object{newRevision("app-v1", true, "v1"), newRevision("app-v2", true, "v2"), newRevision("app-v3", false, "v3")},
+			expected:  "rolled back to revision app-v2",
+			image:     `{"image":"v2"}`,
+		},
+		"no succeeded revision": {
+			revisions: []client.Object{newRevision("app-v1", false, "v1"), newRevision("app-v3", false, "v3")},
+			expected:  "failed to roll back: no succeeded revision found",
+			image:     `{"image":"v3"}`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			r := require.New(t)
+			ctx := context.Background()
+			cli := newStrategyTestClient(t, []string{"c1", "c2", "c3", "c4", "c5"}, tc.revisions...)
+			clusters := &fakeClusters{applied: map[string]string{}, healthy: map[string]bool{}}
+			action := &wfmock.Action{}
+			now := time.Now()
+			deployer := newStrategyTestDeployer(cli, clusters, &DeployStrategy{Canary: []string{"c2"}, ProgressDeadline: "1m", OnFailure: StrategyOnFailureRollback}, action, fakeMutableValueStore{})
+			deployer.now = func() time.Time { return now }
+
+			r.NoError(deployer.Deploy(ctx))
+			r.Equal("Wait", action.Phase)
+
+			now = now.Add(2 * time.Minute)
+			r.NoError(deployer.Deploy(ctx))
+			r.Equal("Fail", action.Phase)
+			r.Contains(action.Msg, "wave 1/2 [c2] is not healthy after 1m0s")
+			r.Contains(action.Msg, tc.expected)
+			// only the deployed waves are rolled back
+			r.Equal(map[string]string{"c2": tc.image}, clusters.applied)
+		})
+	}
+}
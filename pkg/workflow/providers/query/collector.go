@@ -139,7 +139,7 @@ func (c *AppCollector) ListApplicationResources(ctx context.Context, app *v1beta
 	}
 
 	// merge user defined customize rule before every request.
-	err = mergeCustomRules(ctx, c.k8sClient)
+	err = MergeCustomRules(ctx, c.k8sClient)
 	if err != nil {
 		return managedResources, err
 	}
@@ -0,0 +1,233 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package query
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"cuelang.org/go/cue"
+	"cuelang.org/go/cue/cuecontext"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/klog/v2"
+
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/common"
+	"github.com/oam-dev/kubevela/pkg/cue/definition/health"
+	"github.com/oam-dev/kubevela/pkg/utils/cel"
+	"github.com/oam-dev/kubevela/pkg/utils/types"
+)
+
+// healthRuleKey is the configmap key of health rule
+var healthRuleKey = "health"
+
+// additionalInfoField is the field of the CUE health rule holding the additional info
+const additionalInfoField = "additionalInfo"
+
+// CustomHealthRule define the health assessment of the resources created by user, the status of the resource is
+// evaluated by either the CUE or the CEL expressions
+type CustomHealthRule struct {
+	GroupResourceType `json:",inline"`
+	// Version restricts the rule to the version of the resource, empty means all versions
+	Version string `json:"version,omitempty"`
+	// CUE is the health policy like the one in definitions, with the resource as `context.output`. It reports the
+	// health by `isHealth` or `healthStatus`, and optionally `message` and `additionalInfo`.
+	CUE string `json:"cue,omitempty"`
+	// CEL is the CEL expressions with the resource as `object`
+	CEL *CELHealthRule `json:"cel,omitempty"`
+}
+
+// CELHealthRule define the health assessment by CEL expressions
+type CELHealthRule struct {
+	// Health returns either a bool or a health status like Healthy, Progressing, Degraded
+	Health string `json:"health"`
+	// Message returns the message explaining the health status
+	Message string `json:"message,omitempty"`
+	// AdditionalInfo returns the additional info displayed for the resource, keyed by the info name
+	AdditionalInfo map[string]string `json:"additionalInfo,omitempty"`
+}
+
+var (
+	customHealthRules   = map[schema.GroupVersionKind]*CustomHealthRule{}
+	customHealthRulesMu sync.RWMutex
+)
+
+// setCustomHealthRules replaces the custom health rules, rules for the same resource type are overridden by the later
+func setCustomHealthRules(rules []*CustomHealthRule) {
+	m := map[schema.GroupVersionKind]*CustomHealthRule{}
+	for _, rule := range rules {
+		if rule.CUE == "" && (rule.CEL == nil || rule.CEL.Health == "") {
+			klog.Warningf("ignore health rule for %s/%s without cue or cel health expression", rule.Group, rule.Kind)
+			continue
+		}
+		m[schema.GroupVersionKind{Group: rule.Group, Version: rule.Version, Kind: rule.Kind}] = rule
+	}
+	customHealthRulesMu.Lock()
+	defer customHealthRulesMu.Unlock()
+	customHealthRules = m
+}
+
+// getCustomHealthRule returns the custom health rule for the resource, the rule for the exact version takes precedence
+// over the one for all versions
+func getCustomHealthRule(gvk schema.GroupVersionKind) (*CustomHealthRule, bool) {
+	customHealthRulesMu.RLock()
+	defer customHealthRulesMu.RUnlock()
+	if rule, found := customHealthRules[gvk]; found {
+		return rule, true
+	}
+	rule, found := customHealthRules[gvk.GroupKind().WithVersion("")]
+	return rule, found
+}
+
+// HasCustomHealthRule checks if there is a custom health rule for the resource type
+func HasCustomHealthRule(gvk schema.GroupVersionKind) bool {
+	_, found := getCustomHealthRule(gvk)
+	return found
+}
+
+// checkStatus evaluates the health status of the resource. Failures of the evaluation are reported as unknown status
+// instead of errors, so that one miss-configured rule won't break the whole resource tree.
+func (r *CustomHealthRule) checkStatus(obj unstructured.Unstructured) *types.HealthStatus {
+	var (
+		status  common.HealthStatus
+		message string
+		err     error
+	)
+	if r.CUE != "" {
+		status, message, err = r.evalCUEStatus(obj)
+	} else {
+		status, message, err = r.evalCELStatus(obj)
+	}
+	if err != nil {
+		return &types.HealthStatus{Status: types.HealthStatusUnKnown, Reason: "HealthRuleError", Message: err.Error()}
+	}
+	return &types.HealthStatus{Status: convertHealthStatus(status), Reason: string(status), Message: message}
+}
+
+func (r *CustomHealthRule) evalCUEStatus(obj unstructured.Unstructured) (common.HealthStatus, string, error) {
+	val, err := r.compileCUE(obj)
+	if err != nil {
+		return "", "", err
+	}
+	// the rule works as the health policy and the health status check at the same time
+	var healthPolicy, healthStatus string
+	if val.LookupPath(cue.ParsePath(health.IsHealthPolicy)).Exists() {
+		healthPolicy = r.CUE
+	}
+	if val.LookupPath(cue.ParsePath(health.HealthStatusPolicy)).Exists() {
+		healthStatus = r.CUE
+	}
+	if healthPolicy == "" && healthStatus == "" {
+		return "", "", errors.New("health rule reports neither isHealth nor healthStatus")
+	}
+	templateContext := map[string]interface{}{"output": obj.Object}
+	_, status, err := health.CheckHealthStatus(templateContext, healthPolicy, healthStatus, nil)
+	if err != nil {
+		return "", "", err
+	}
+	var message string
+	if v := val.LookupPath(cue.ParsePath(health.CustomMessage)); v.Exists() {
+		if message, err = v.String(); err != nil {
+			return "", "", errors.WithMessage(err, "evaluate message")
+		}
+	}
+	return status, message, nil
+}
+
+func (r *CustomHealthRule) evalCELStatus(obj unstructured.Unstructured) (common.HealthStatus, string, error) {
+	vars := map[string]interface{}{"object": obj.Object}
+	result, err := cel.Eval(r.CEL.Health, vars)
+	if err != nil {
+		return "", "", err
+	}
+	var status common.HealthStatus
+	switch v := result.(type) {
+	case bool:
+		status = common.HealthStatusFromHealthy(v)
+	case string:
+		if status = common.HealthStatus(v); !status.IsValid() {
+			return "", "", errors.Errorf("invalid health status %q", v)
+		}
+	default:
+		return "", "", errors.Errorf("cel expression %q returns %T instead of bool or health status", r.CEL.Health, result)
+	}
+	var message string
+	if r.CEL.Message != "" {
+		msg, err := cel.Eval(r.CEL.Message, vars)
+		if err != nil {
+			return "", "", err
+		}
+		message = fmt.Sprint(msg)
+	}
+	return status, message, nil
+}
+
+// additionalInfo evaluates the additional info of the resource, the info failed to be evaluated is skipped
+func (r *CustomHealthRule) additionalInfo(obj unstructured.Unstructured) map[string]interface{} {
+	info := map[string]interface{}{}
+	if r.CUE != "" {
+		val, err := r.compileCUE(obj)
+		if err != nil {
+			klog.Warningf("failed to evaluate additional info of %s %s: %v", obj.GetKind(), obj.GetName(), err)
+			return nil
+		}
+		if v := val.LookupPath(cue.ParsePath(additionalInfoField)); v.Exists() {
+			if err := v.Decode(&info); err != nil {
+				klog.Warningf("failed to evaluate additional info of %s %s: %v", obj.GetKind(), obj.GetName(), err)
+			}
+		}
+	} else {
+		vars := map[string]interface{}{"object": obj.Object}
+		for name, expr := range r.CEL.AdditionalInfo {
+			v, err := cel.Eval(expr, vars)
+			if err != nil {
+				klog.Warningf("failed to evaluate additional info %s of %s %s: %v", name, obj.GetKind(), obj.GetName(), err)
+				continue
+			}
+			info[name] = v
+		}
+	}
+	return info
+}
+
+func (r *CustomHealthRule) compileCUE(obj unstructured.Unstructured) (cue.Value, error) {
+	bs, err := json.Marshal(obj.Object)
+	if err != nil {
+		return cue.Value{}, err
+	}
+	val := cuecontext.New().CompileString(r.CUE + "\ncontext: output: " + string(bs) + "\n")
+	if val.Err() != nil {
+		return cue.Value{}, errors.WithMessage(val.Err(), "compile health rule")
+	}
+	return val, nil
+}
+
+// convertHealthStatus converts the health status of the application to the one of the resource tree
+func convertHealthStatus(status common.HealthStatus) types.HealthStatusCode {
+	switch status {
+	case common.HealthStatusHealthy:
+		return types.HealthStatusHealthy
+	case common.HealthStatusProgressing, common.HealthStatusSuspended:
+		return types.HealthStatusProgressing
+	case common.HealthStatusDegraded, common.HealthStatusMissing:
+		return types.HealthStatusUnHealthy
+	default:
+		return types.HealthStatusUnKnown
+	}
+}
@@ -0,0 +1,155 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package query
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/yaml"
+
+	velatypes "github.com/oam-dev/kubevela/apis/types"
+	"github.com/oam-dev/kubevela/pkg/oam"
+	"github.com/oam-dev/kubevela/pkg/utils/types"
+)
+
+func TestCustomHealthRules(t *testing.T) {
+	defer setCustomHealthRules(nil)
+	r := require.New(t)
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "health-rules",
+			Namespace: velatypes.DefaultKubeVelaNS,
+			Labels:    map[string]string{oam.LabelResourceRules: "true"},
+		},
+		Data: map[string]string{healthRuleKey: `
+- group: cert-manager.io
+  kind: Certificate
+  cel:
+    health: 'object.status.conditions.exists(c, c.type == "Ready" && c.status == "True")'
+    message: 'object.status.conditions.filter(c, c.type == "Ready")[0].message'
+    additionalInfo:
+      Expiration: 'object.status.notAfter'
+      Renewal: 'object.status.renewalTime'
+- group: kafka.strimzi.io
+  kind: KafkaTopic
+  version: v1beta2
+  cue: |
+    ready: *false | bool
+    if context.output.status != _|_ {
+      ready: context.output.status.ready
+    }
+    healthStatus: *"Progressing" | string
+    if ready {
+      healthStatus: "Healthy"
+    }
+    message: "topic has \(context.output.spec.partitions) partitions"
+    additionalInfo: Partitions: context.output.spec.partitions
+- group: example.com
+  kind: Invalid
+  cel:
+    health: 'object.status.phase'
+- group: example.com
+  kind: Empty
+`},
+	}
+	cli := fake.NewClientBuilder().WithObjects(cm).Build()
+	r.NoError(MergeCustomRules(context.Background(), cli))
+
+	newObject := func(s string) unstructured.Unstructured {
+		obj := unstructured.Unstructured{}
+		r.NoError(yaml.Unmarshal([]byte(s), &obj.Object))
+		return obj
+	}
+	cert := newObject(`
+apiVersion: cert-manager.io/v1
+kind: Certificate
+metadata:
+  name: cert
+status:
+  notAfter: "2027-01-01T00:00:00Z"
+  conditions:
+  - type: Ready
+    status: "False"
+    message: issuing certificate
+`)
+	status, err := CheckResourceStatus(cert)
+	r.NoError(err)
+	// unhealthy resource reported by bool is regarded as progressing, the same as the health policy in definitions
+	r.Equal(types.HealthStatusProgressing, status.Status)
+	r.Equal("issuing certificate", status.Message)
+	info, err := additionalInfo(cert)
+	r.NoError(err)
+	// the info failed to be evaluated is skipped
+	r.Equal(map[string]interface{}{"Expiration": "2027-01-01T00:00:00Z"}, info)
+
+	topic := newObject(`
+apiVersion: kafka.strimzi.io/v1beta2
+kind: KafkaTopic
+metadata:
+  name: topic
+spec:
+  partitions: 3
+`)
+	status, err = CheckResourceStatus(topic)
+	r.NoError(err)
+	r.Equal(types.HealthStatusProgressing, status.Status)
+	r.Equal("topic has 3 partitions", status.Message)
+	topic.Object["status"] = map[string]interface{}{"ready": true}
+	status, err = CheckResourceStatus(topic)
+	r.NoError(err)
+	r.Equal(types.HealthStatusHealthy, status.Status)
+	info, err = additionalInfo(topic)
+	r.NoError(err)
+	r.Equal(map[string]interface{}{"Partitions": int64(3)}, info)
+
+	r.True(HasCustomHealthRule(cert.GroupVersionKind()))
+	r.True(HasCustomHealthRule(topic.GroupVersionKind()))
+	// the rule is restricted to the version
+	topic.SetAPIVersion("kafka.strimzi.io/v1beta1")
+	r.False(HasCustomHealthRule(topic.GroupVersionKind()))
+	status, err = CheckResourceStatus(topic)
+	r.NoError(err)
+	r.Equal(types.HealthStatusHealthy, status.Status)
+	r.Equal("", status.Message)
+
+	invalid := newObject(`
+apiVersion: example.com/v1
+kind: Invalid
+metadata:
+  name: invalid
+status:
+  phase: Running
+`)
+	status, err = CheckResourceStatus(invalid)
+	r.NoError(err)
+	r.Equal(types.HealthStatusUnKnown, status.Status)
+	r.Contains(status.Message, `invalid health status "Running"`)
+
+	// the rules are removed together with the configmap
+	r.NoError(cli.Delete(context.Background(), cm))
+	r.NoError(MergeCustomRules(context.Background(), cli))
+	r.False(HasCustomHealthRule(cert.GroupVersionKind()))
+	status, err = CheckResourceStatus(cert)
+	r.NoError(err)
+	r.Equal(types.HealthStatusHealthy, status.Status)
+}
@@ -632,8 +632,12 @@ var checkServiceStatus = func(obj unstructured.Unstructured) (*types.HealthStatu
 	return &health, nil
 }
 
-// CheckResourceStatus return object status data
+// CheckResourceStatus return object status data, the custom health rule of the object type takes precedence over the
+// built-in ones
 func CheckResourceStatus(obj unstructured.Unstructured) (*types.HealthStatus, error) {
+	if rule, ok := getCustomHealthRule(obj.GroupVersionKind()); ok {
+		return rule.checkStatus(obj), nil
+	}
 	group := obj.GroupVersionKind().Group
 	kind := obj.GroupVersionKind().Kind
 	var checkFunc healthyCheckFunc
@@ -697,10 +701,22 @@ func additionalInfo(obj unstructured.Unstructured) (map[string]interface{}, erro
 		}
 	default:
 	}
+	var info map[string]interface{}
 	if infoFunc != nil {
-		return infoFunc(obj)
+		var err error
+		if info, err = infoFunc(obj); err != nil {
+			return nil, err
+		}
 	}
-	return nil, nil
+	if rule, ok := getCustomHealthRule(obj.GroupVersionKind()); ok {
+		for k, v := range rule.additionalInfo(obj) {
+			if info == nil {
+				info = map[string]interface{}{}
+			}
+			info[k] = v
+		}
+	}
+	return info, nil
 }
 
 func svcAdditionalInfo(obj unstructured.Unstructured) (map[string]interface{}, error) {
@@ -1011,29 +1027,34 @@ func iterateListSubResources(ctx context.Context, cluster string, k8sClient clie
 	return nil, nil
 }
 
-// mergeCustomRules merge user defined resource topology rules with the system ones
-func mergeCustomRules(ctx context.Context, k8sClient client.Client) error {
+// MergeCustomRules merge user defined resource topology rules with the system ones, and load the user defined health
+// rules of the resources
+func MergeCustomRules(ctx context.Context, k8sClient client.Client) error {
 	rulesList := v12.ConfigMapList{}
 	if err := k8sClient.List(ctx, &rulesList, client.InNamespace(velatypes.DefaultKubeVelaNS), client.HasLabels{oam.LabelResourceRules}); err != nil {
 		return client.IgnoreNotFound(err)
 	}
+	var healthRules []*CustomHealthRule
 	for _, item := range rulesList.Items {
-		ruleStr := item.Data[relationshipKey]
-		var (
-			customRules []*customRule
-			format      string
-			err         error
-		)
+		var format string
 		if item.Labels != nil {
 			format = item.Labels[oam.LabelResourceRuleFormat]
 		}
-		switch format {
-		case oam.ResourceTopologyFormatJSON:
-			err = json.Unmarshal([]byte(ruleStr), &customRules)
-		case oam.ResourceTopologyFormatYAML, "":
-			err = yaml.Unmarshal([]byte(ruleStr), &customRules)
+		if healthStr, ok := item.Data[healthRuleKey]; ok {
+			var rules []*CustomHealthRule
+			if err := unmarshalRules(format, healthStr, &rules); err != nil {
+				// don't let one miss-config configmap brake whole process
+				klog.Errorf("health rule configmap %s miss config %v", item.Name, err)
+			} else {
+				healthRules = append(healthRules, rules...)
+			}
+		}
+		ruleStr, ok := item.Data[relationshipKey]
+		if !ok {
+			continue
 		}
-		if err != nil {
+		var customRules []*customRule
+		if err := unmarshalRules(format, ruleStr, &customRules); err != nil {
 			// don't let one miss-config configmap brake whole process
 			klog.Errorf("relationship rule configmap %s miss config %v", item.Name, err)
 			continue
@@ -1058,6 +1079,17 @@ func mergeCustomRules(ctx context.Context, k8sClient client.Client) error {
 			}
 		}
 	}
+	setCustomHealthRules(healthRules)
+	return nil
+}
+
+func unmarshalRules(format string, ruleStr string, rules interface{}) error {
+	switch format {
+	case oam.ResourceTopologyFormatJSON:
+		return json.Unmarshal([]byte(ruleStr), rules)
+	case oam.ResourceTopologyFormatYAML, "":
+		return yaml.Unmarshal([]byte(ruleStr), rules)
+	}
 	return nil
 }
 
@@ -1864,7 +1864,7 @@ childrenResourceType:
 		}
 		Expect(k8sClient.Create(ctx, &clickhouseJsonCm)).Should(BeNil())
 
-		Expect(mergeCustomRules(ctx, k8sClient)).Should(BeNil())
+		Expect(MergeCustomRules(ctx, k8sClient)).Should(BeNil())
 		childrenResources, ok := globalRule.GetRule(GroupResourceType{Group: "apps.kruise.io", Kind: "CloneSet"})
 		Expect(ok).Should(BeTrue())
 		Expect(childrenResources.DefaultGenListOptionFunc).Should(BeNil())
@@ -26,6 +26,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/kubevela/pkg/util/singleton"
+	wfContext "github.com/kubevela/workflow/pkg/context"
 	providertypes "github.com/kubevela/workflow/pkg/providers/types"
 	"github.com/kubevela/workflow/pkg/types"
 
@@ -56,8 +57,21 @@ const (
 	appfileKey              providertypes.ContextKey = "appfile"
 	configFactoryKey        providertypes.ContextKey = "configFactory"
 	kubeconfigKey           providertypes.ContextKey = "kubeconfig"
+	appRevisionKey          providertypes.ContextKey = "appRevision"
 )
 
+// WithAppRevision sets the application revision to render the components with, such as the revision pinned to the
+// clusters which are rolled back separately
+func WithAppRevision(ctx context.Context, revision string) context.Context {
+	return context.WithValue(ctx, appRevisionKey, revision)
+}
+
+// AppRevisionFromContext returns the application revision to render the components with, empty for the current one
+func AppRevisionFromContext(ctx context.Context) string {
+	revision, _ := ctx.Value(appRevisionKey).(string)
+	return revision
+}
+
 // RuntimeParams is the params for runtime
 type RuntimeParams struct {
 	ComponentApply       ComponentApply
@@ -68,6 +82,7 @@ type RuntimeParams struct {
 	AppLabels            map[string]string
 	Appfile              *appfile.Appfile
 	Action               types.Action
+	WorkflowContext      wfContext.Context
 	ConfigFactory        config.Factory
 	KubeHandlers         *providertypes.KubeHandlers
 	KubeClient           client.Client
@@ -176,6 +191,9 @@ func WithRuntimeParams(parent context.Context, params RuntimeParams) context.Con
 
 	ctx = context.WithValue(ctx, providertypes.KubeHandlersKey, params.KubeHandlers)
 	ctx = context.WithValue(ctx, providertypes.ActionKey, params.Action)
+	if params.WorkflowContext != nil {
+		ctx = context.WithValue(ctx, providertypes.WorkflowContextKey, params.WorkflowContext)
+	}
 	ctx = context.WithValue(ctx, configFactoryKey, params.ConfigFactory)
 
 	ctx = context.WithValue(ctx, providertypes.KubeClientKey, params.KubeClient)
@@ -214,6 +232,9 @@ func RuntimeParamsFrom(ctx context.Context) RuntimeParams {
 	if action, ok := ctx.Value(providertypes.ActionKey).(types.Action); ok {
 		params.Action = action
 	}
+	if wfCtx, ok := ctx.Value(providertypes.WorkflowContextKey).(wfContext.Context); ok {
+		params.WorkflowContext = wfCtx
+	}
 	if configFactory, ok := ctx.Value(configFactoryKey).(config.Factory); ok {
 		params.ConfigFactory = configFactory
 	}
@@ -67,7 +67,13 @@ type RefWorkflowStepGenerator struct {
 
 // Generate generate workflow steps
 func (g *RefWorkflowStepGenerator) Generate(app *v1beta1.Application, existingSteps []workflowv1alpha1.WorkflowStep) (steps []workflowv1alpha1.WorkflowStep, err error) {
-	if app.Spec.Workflow == nil || app.Spec.Workflow.Ref == "" {
+	if app.Spec.Workflow == nil {
+		return existingSteps, nil
+	}
+	if app.Spec.Workflow.Ref == "" {
+		if app.Spec.Workflow.Parameters != nil {
+			return nil, errors.Errorf("cannot set parameters in workflow without ref")
+		}
 		return existingSteps, nil
 	}
 	if app.Spec.Workflow.Steps != nil {
@@ -77,7 +83,13 @@ func (g *RefWorkflowStepGenerator) Generate(app *v1beta1.Application, existingSt
 	if err = g.Client.Get(g.Context, types.NamespacedName{Namespace: app.GetNamespace(), Name: app.Spec.Workflow.Ref}, wf); err != nil {
 		return
 	}
-	return wf.Steps, nil
+	if steps, err = ResolveWorkflowParameters(wf, app.Spec.Workflow.Parameters); err != nil {
+		return nil, errors.Wrapf(err, "failed to resolve the parameters of workflow %s", wf.Name)
+	}
+	// the resolved steps are written back to the referenced workflow, which is recorded in the application revision,
+	// so that the revision keeps the steps it runs even if the referenced workflow is changed later
+	wf.Steps = steps
+	return steps, nil
 }
 
 // ApplyComponentWorkflowStepGenerator generate apply-component workflow steps for all components in the application
@@ -88,6 +100,11 @@ func (g *ApplyComponentWorkflowStepGenerator) Generate(app *v1beta1.Application,
 	if len(existingSteps) > 0 {
 		return existingSteps, nil
 	}
+	// the steps depend on both the dependsOn of the components and the components producing their inputs
+	graph, err := BuildComponentGraph(app.Spec.Components, nil)
+	if err != nil {
+		return nil, err
+	}
 	for _, comp := range app.Spec.Components {
 		steps = append(steps, workflowv1alpha1.WorkflowStep{
 			WorkflowStepBase: workflowv1alpha1.WorkflowStepBase{
@@ -96,7 +113,7 @@ func (g *ApplyComponentWorkflowStepGenerator) Generate(app *v1beta1.Application,
 				Properties: util.Object2RawExtension(map[string]string{
 					"component": comp.Name,
 				}),
-				DependsOn: comp.DependsOn,
+				DependsOn: graph.DependenciesOf(comp.Name),
 			},
 		})
 	}
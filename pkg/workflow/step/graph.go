@@ -0,0 +1,191 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package step
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	workflowv1alpha1 "github.com/kubevela/workflow/api/v1alpha1"
+	"github.com/pkg/errors"
+
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/common"
+)
+
+// ComponentDependency is an edge of the component dependency graph, the component depends on the dependency
+type ComponentDependency struct {
+	Component  string
+	Dependency string
+	// DependsOn means the dependency is declared in the dependsOn of the component
+	DependsOn bool
+	// Inputs are the outputs of the dependency consumed by the inputs of the component
+	Inputs []string
+}
+
+// DanglingInput is an input of the component which is neither the output of the components nor the output of the
+// workflow steps
+type DanglingInput struct {
+	Component string
+	Input     string
+}
+
+// String returns the message describing the dangling input
+func (in DanglingInput) String() string {
+	return fmt.Sprintf("input %s of component %s is not the output of any component or workflow step", in.Input, in.Component)
+}
+
+// ComponentGraph is the dependency graph of the components, built from the dependsOn of the components and the
+// references from the inputs of the components to the outputs of the other components
+type ComponentGraph struct {
+	Components   []string
+	Dependencies []ComponentDependency
+	// DanglingInputs are the inputs skipped when building the graph
+	DanglingInputs []DanglingInput
+}
+
+// BuildComponentGraph builds the dependency graph of the components. The components depending on components not
+// exist and the cycles in the graph are rejected. The inputs that are neither the outputs of the components nor the
+// outputs of the workflow steps are skipped and reported in the dangling inputs of the graph, as the applications
+// created before the graph was introduced may contain them.
+func BuildComponentGraph(components []common.ApplicationComponent, steps []workflowv1alpha1.WorkflowStep) (*ComponentGraph, error) {
+	graph := &ComponentGraph{}
+	producers := map[string][]string{}
+	for _, comp := range components {
+		graph.Components = append(graph.Components, comp.Name)
+		for _, output := range comp.Outputs {
+			if !slices.Contains(producers[output.Name], comp.Name) {
+				producers[output.Name] = append(producers[output.Name], comp.Name)
+			}
+		}
+	}
+	stepOutputs := map[string]bool{}
+	for _, step := range steps {
+		for _, output := range step.Outputs {
+			stepOutputs[output.Name] = true
+		}
+		for _, sub := range step.SubSteps {
+			for _, output := range sub.Outputs {
+				stepOutputs[output.Name] = true
+			}
+		}
+	}
+	for _, comp := range components {
+		var deps []ComponentDependency
+		dependency := func(name string) *ComponentDependency {
+			idx := slices.IndexFunc(deps, func(dep ComponentDependency) bool { return dep.Dependency == name })
+			if idx < 0 {
+				deps = append(deps, ComponentDependency{Component: comp.Name, Dependency: name})
+				idx = len(deps) - 1
+			}
+			return &deps[idx]
+		}
+		for _, name := range comp.DependsOn {
+			if !slices.Contains(graph.Components, name) {
+				return nil, errors.Errorf("component %s depends on component %s which does not exist", comp.Name, name)
+			}
+			dependency(name).DependsOn = true
+		}
+		for _, input := range comp.Inputs {
+			if len(producers[input.From]) == 0 {
+				if stepOutputs[input.From] {
+					continue
+				}
+				graph.DanglingInputs = append(graph.DanglingInputs, DanglingInput{Component: comp.Name, Input: input.From})
+				continue
+			}
+			for _, producer := range producers[input.From] {
+				dep := dependency(producer)
+				if !slices.Contains(dep.Inputs, input.From) {
+					dep.Inputs = append(dep.Inputs, input.From)
+				}
+			}
+		}
+		graph.Dependencies = append(graph.Dependencies, deps...)
+	}
+	if cycle := graph.findCycle(); cycle != nil {
+		return nil, errors.Errorf("dependency cycle found in components: %s", strings.Join(cycle, " -> "))
+	}
+	return graph, nil
+}
+
+// DependenciesOf returns the components that the component depends on
+func (g *ComponentGraph) DependenciesOf(component string) []string {
+	var deps []string
+	for _, dep := range g.Dependencies {
+		if dep.Component == component {
+			deps = append(deps, dep.Dependency)
+		}
+	}
+	return deps
+}
+
+// findCycle returns the components in the first cycle found, the first component is repeated at the end
+func (g *ComponentGraph) findCycle() []string {
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	states := map[string]int{}
+	var path []string
+	var visit func(string) []string
+	visit = func(comp string) []string {
+		states[comp] = visiting
+		path = append(path, comp)
+		for _, dep := range g.DependenciesOf(comp) {
+			switch states[dep] {
+			case visiting:
+				return append(slices.Clone(path[slices.Index(path, dep):]), dep)
+			case unvisited:
+				if cycle := visit(dep); cycle != nil {
+					return cycle
+				}
+			}
+		}
+		path = path[:len(path)-1]
+		states[comp] = visited
+		return nil
+	}
+	for _, comp := range g.Components {
+		if states[comp] == unvisited {
+			if cycle := visit(comp); cycle != nil {
+				return cycle
+			}
+		}
+	}
+	return nil
+}
+
+// DOT renders the graph in the DOT language, the edges point from the dependencies to the components depending on
+// them and are labeled with the inputs
+func (g *ComponentGraph) DOT(name string) string {
+	sb := &strings.Builder{}
+	fmt.Fprintf(sb, "digraph %q {\n", name)
+	for _, comp := range g.Components {
+		fmt.Fprintf(sb, "  %q;\n", comp)
+	}
+	for _, dep := range g.Dependencies {
+		if len(dep.Inputs) > 0 {
+			fmt.Fprintf(sb, "  %q -> %q [label=%q];\n", dep.Dependency, dep.Component, strings.Join(dep.Inputs, ","))
+		} else {
+			fmt.Fprintf(sb, "  %q -> %q;\n", dep.Dependency, dep.Component)
+		}
+	}
+	sb.WriteString("}\n")
+	return sb.String()
+}
@@ -0,0 +1,154 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package step
+
+import (
+	"testing"
+
+	workflowv1alpha1 "github.com/kubevela/workflow/api/v1alpha1"
+	"github.com/stretchr/testify/require"
+
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/common"
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1beta1"
+)
+
+func TestBuildComponentGraph(t *testing.T) {
+	outputs := func(names ...string) workflowv1alpha1.StepOutputs {
+		var items workflowv1alpha1.StepOutputs
+		for _, name := range names {
+			items = append(items, workflowv1alpha1.OutputItem{Name: name, ValueFrom: "output.metadata.name"})
+		}
+		return items
+	}
+	inputs := func(names ...string) workflowv1alpha1.StepInputs {
+		var items workflowv1alpha1.StepInputs
+		for _, name := range names {
+			items = append(items, workflowv1alpha1.InputItem{From: name, ParameterKey: name})
+		}
+		return items
+	}
+	steps := []workflowv1alpha1.WorkflowStep{{
+		WorkflowStepBase: workflowv1alpha1.WorkflowStepBase{Name: "group", Type: "step-group"},
+		SubSteps:         []workflowv1alpha1.WorkflowStepBase{{Name: "notify", Type: "notification", Outputs: outputs("channel")}},
+	}}
+	testCases := map[string]struct {
+		components   []common.ApplicationComponent
+		steps        []workflowv1alpha1.WorkflowStep
+		dependencies []ComponentDependency
+		dangling     []DanglingInput
+		expectedErr  string
+	}{
+		"dependsOn and inputs": {
+			components: []common.ApplicationComponent{
+				{Name: "db", Outputs: outputs("db-host")},
+				{Name: "cache", Outputs: outputs("cache-host", "cache-port")},
+				{Name: "web", DependsOn: []string{"cache"}, Inputs: inputs("db-host", "cache-host", "cache-port", "channel")},
+			},
+			steps: steps,
+			dependencies: []ComponentDependency{
+				{Component: "web", Dependency: "cache", DependsOn: true, Inputs: []string{"cache-host", "cache-port"}},
+				{Component: "web", Dependency: "db", Inputs: []string{"db-host"}},
+			},
+		},
+		"output produced by multiple components": {
+			components: []common.ApplicationComponent{
+				{Name: "db-1", Outputs: outputs("db-host")},
+				{Name: "db-2", Outputs: outputs("db-host")},
+				{Name: "web", Inputs: inputs("db-host")},
+			},
+			dependencies: []ComponentDependency{
+				{Component: "web", Dependency: "db-1", Inputs: []string{"db-host"}},
+				{Component: "web", Dependency: "db-2", Inputs: []string{"db-host"}},
+			},
+		},
+		"dependsOn not exists": {
+			components: []common.ApplicationComponent{
+				{Name: "web", DependsOn: []string{"db"}},
+			},
+			expectedErr: "component web depends on component db which does not exist",
+		},
+		"dangling input": {
+			components: []common.ApplicationComponent{
+				{Name: "web", Inputs: inputs("channel")},
+			},
+			dangling: []DanglingInput{{Component: "web", Input: "channel"}},
+		},
+		"cycle": {
+			components: []common.ApplicationComponent{
+				{Name: "web", Inputs: inputs("db-host"), Outputs: outputs("web-host")},
+				{Name: "db", DependsOn: []string{"cache"}, Outputs: outputs("db-host")},
+				{Name: "cache", Inputs: inputs("web-host")},
+			},
+			expectedErr: "dependency cycle found in components: web -> db -> cache -> web",
+		},
+		"depends on itself": {
+			components: []common.ApplicationComponent{
+				{Name: "web", Inputs: inputs("web-host"), Outputs: outputs("web-host")},
+			},
+			expectedErr: "dependency cycle found in components: web -> web",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			r := require.New(t)
+			graph, err := BuildComponentGraph(tc.components, tc.steps)
+			if tc.expectedErr != "" {
+				r.EqualError(err, tc.expectedErr)
+				return
+			}
+			r.NoError(err)
+			r.Equal(tc.dependencies, graph.Dependencies)
+			r.Equal(tc.dangling, graph.DanglingInputs)
+		})
+	}
+}
+
+func TestComponentGraphDOT(t *testing.T) {
+	r := require.New(t)
+	graph, err := BuildComponentGraph([]common.ApplicationComponent{
+		{Name: "db", Outputs: workflowv1alpha1.StepOutputs{{Name: "db-host", ValueFrom: "output.metadata.name"}}},
+		{Name: "cache"},
+		{Name: "web", DependsOn: []string{"cache"}, Inputs: workflowv1alpha1.StepInputs{{From: "db-host", ParameterKey: "host"}}},
+	}, nil)
+	r.NoError(err)
+	r.Equal([]string{"cache", "db"}, graph.DependenciesOf("web"))
+	r.Equal(`digraph "app" {
+  "db";
+  "cache";
+  "web";
+  "cache" -> "web";
+  "db" -> "web" [label="db-host"];
+}
+`, graph.DOT("app"))
+}
+
+func TestApplyComponentWorkflowStepGeneratorWithInputs(t *testing.T) {
+	r := require.New(t)
+	app := &v1beta1.Application{Spec: v1beta1.ApplicationSpec{Components: []common.ApplicationComponent{
+		{Name: "db", Outputs: workflowv1alpha1.StepOutputs{{Name: "db-host", ValueFrom: "output.metadata.name"}}},
+		{Name: "web", Inputs: workflowv1alpha1.StepInputs{{From: "db-host", ParameterKey: "host"}}},
+	}}}
+	steps, err := (&ApplyComponentWorkflowStepGenerator{}).Generate(app, nil)
+	r.NoError(err)
+	r.Len(steps, 2)
+	r.Empty(steps[0].DependsOn)
+	r.Equal([]string{"db"}, steps[1].DependsOn)
+
+	app.Spec.Components[0].DependsOn = []string{"web"}
+	_, err = (&ApplyComponentWorkflowStepGenerator{}).Generate(app, nil)
+	r.ErrorContains(err, "dependency cycle found in components")
+}
@@ -0,0 +1,169 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package step
+
+import (
+	"encoding/json"
+	"regexp"
+	"strings"
+
+	"cuelang.org/go/cue"
+	"cuelang.org/go/cue/cuecontext"
+	workflowv1alpha1 "github.com/kubevela/workflow/api/v1alpha1"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/oam-dev/kubevela/pkg/oam"
+)
+
+// parameterRefPattern matches the reference to the workflow parameter in step properties, like {{ parameter.cluster }}
+var parameterRefPattern = regexp.MustCompile(`\{\{\s*parameter\.([\w.\-]+)\s*\}\}`)
+
+// ResolveWorkflowParameters validates the parameters against the parameter schema declared by the workflow, and
+// returns the steps of the workflow with the parameter references in step properties substituted. A property value
+// that is exactly one reference is replaced by the typed parameter value, otherwise the references are formatted
+// into the string.
+func ResolveWorkflowParameters(wf *workflowv1alpha1.Workflow, parameters *runtime.RawExtension) ([]workflowv1alpha1.WorkflowStep, error) {
+	schema, declared := wf.GetAnnotations()[oam.AnnotationWorkflowParameterSchema]
+	if !declared {
+		if parameters != nil && len(parameters.Raw) > 0 {
+			return nil, errors.Errorf("workflow %s does not declare any parameter", wf.Name)
+		}
+		return wf.Steps, nil
+	}
+	values, err := validateWorkflowParameters(schema, parameters)
+	if err != nil {
+		return nil, err
+	}
+	steps := make([]workflowv1alpha1.WorkflowStep, 0, len(wf.Steps))
+	for _, step := range wf.Steps {
+		step = *step.DeepCopy()
+		if step.Properties, err = substituteParameters(step.Properties, values); err != nil {
+			return nil, errors.Wrapf(err, "failed to substitute parameters in step %s", step.Name)
+		}
+		for i := range step.SubSteps {
+			if step.SubSteps[i].Properties, err = substituteParameters(step.SubSteps[i].Properties, values); err != nil {
+				return nil, errors.Wrapf(err, "failed to substitute parameters in step %s", step.SubSteps[i].Name)
+			}
+		}
+		steps = append(steps, step)
+	}
+	return steps, nil
+}
+
+// validateWorkflowParameters unifies the parameters with the schema, the parameters not declared are rejected and the
+// defaults in the schema are filled in
+func validateWorkflowParameters(schema string, parameters *runtime.RawExtension) (map[string]interface{}, error) {
+	cuectx := cuecontext.New()
+	schemaValue := cuectx.CompileString("#parameter: {\n" + schema + "\n}")
+	if schemaValue.Err() != nil {
+		return nil, errors.Wrapf(schemaValue.Err(), "invalid parameter schema")
+	}
+	data := []byte("{}")
+	if parameters != nil && len(parameters.Raw) > 0 {
+		data = parameters.Raw
+	}
+	value := schemaValue.LookupPath(cue.MakePath(cue.Def("parameter"))).Unify(cuectx.CompileBytes(data))
+	if err := value.Validate(cue.Concrete(true)); err != nil {
+		return nil, errors.Wrapf(err, "invalid parameters")
+	}
+	values := map[string]interface{}{}
+	if err := value.Decode(&values); err != nil {
+		return nil, errors.Wrapf(err, "invalid parameters")
+	}
+	return values, nil
+}
+
+// substituteParameters substitutes the parameter references in the properties
+func substituteParameters(properties *runtime.RawExtension, values map[string]interface{}) (*runtime.RawExtension, error) {
+	if properties == nil || len(properties.Raw) == 0 {
+		return properties, nil
+	}
+	var obj interface{}
+	if err := json.Unmarshal(properties.Raw, &obj); err != nil {
+		return nil, err
+	}
+	obj, err := substituteValue(obj, values)
+	if err != nil {
+		return nil, err
+	}
+	bs, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+	return &runtime.RawExtension{Raw: bs}, nil
+}
+
+func substituteValue(obj interface{}, values map[string]interface{}) (interface{}, error) {
+	var err error
+	switch o := obj.(type) {
+	case map[string]interface{}:
+		for k, v := range o {
+			if o[k], err = substituteValue(v, values); err != nil {
+				return nil, err
+			}
+		}
+	case []interface{}:
+		for i, v := range o {
+			if o[i], err = substituteValue(v, values); err != nil {
+				return nil, err
+			}
+		}
+	case string:
+		if match := parameterRefPattern.FindStringSubmatch(o); match != nil && match[0] == o {
+			return lookupParameter(values, match[1])
+		}
+		return replaceParameterRefs(o, values)
+	}
+	return obj, nil
+}
+
+func replaceParameterRefs(s string, values map[string]interface{}) (string, error) {
+	var err error
+	result := parameterRefPattern.ReplaceAllStringFunc(s, func(ref string) string {
+		v, lookupErr := lookupParameter(values, parameterRefPattern.FindStringSubmatch(ref)[1])
+		if lookupErr != nil {
+			err = lookupErr
+			return ref
+		}
+		if str, ok := v.(string); ok {
+			return str
+		}
+		bs, marshalErr := json.Marshal(v)
+		if marshalErr != nil {
+			err = marshalErr
+			return ref
+		}
+		return string(bs)
+	})
+	return result, err
+}
+
+// lookupParameter finds the parameter value by the dot separated path
+func lookupParameter(values map[string]interface{}, path string) (interface{}, error) {
+	var v interface{} = values
+	for _, key := range strings.Split(path, ".") {
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			return nil, errors.Errorf("parameter %s is not declared", path)
+		}
+		if v, ok = m[key]; !ok {
+			return nil, errors.Errorf("parameter %s is not declared", path)
+		}
+	}
+	return v, nil
+}
@@ -0,0 +1,169 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package step
+
+import (
+	"context"
+	"testing"
+
+	workflowv1alpha1 "github.com/kubevela/workflow/api/v1alpha1"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1beta1"
+	"github.com/oam-dev/kubevela/pkg/oam"
+	common2 "github.com/oam-dev/kubevela/pkg/utils/common"
+)
+
+func newParameterizedWorkflow(schema string) *workflowv1alpha1.Workflow {
+	wf := &workflowv1alpha1.Workflow{
+		ObjectMeta: v1.ObjectMeta{Name: "ref-wf", Namespace: "test"},
+		WorkflowSpec: workflowv1alpha1.WorkflowSpec{
+			Steps: []workflowv1alpha1.WorkflowStep{{
+				WorkflowStepBase: workflowv1alpha1.WorkflowStepBase{
+					Name:       "approve",
+					Type:       "suspend",
+					Properties: &runtime.RawExtension{Raw: []byte(`{"duration":"{{ parameter.timeout }}","message":"approved by {{parameter.approval.group}}"}`)},
+				},
+			}, {
+				WorkflowStepBase: workflowv1alpha1.WorkflowStepBase{Name: "group", Type: "step-group"},
+				SubSteps: []workflowv1alpha1.WorkflowStepBase{{
+					Name:       "deploy",
+					Type:       "deploy",
+					Properties: &runtime.RawExtension{Raw: []byte(`{"clusters":["{{parameter.cluster}}"],"parallelism":"{{parameter.parallelism}}"}`)},
+				}},
+			}},
+		},
+	}
+	if schema != "" {
+		wf.SetAnnotations(map[string]string{oam.AnnotationWorkflowParameterSchema: schema})
+	}
+	return wf
+}
+
+func TestResolveWorkflowParameters(t *testing.T) {
+	schema := `
+cluster: string
+timeout: *"10m" | string
+parallelism: *5 | int
+approval: group: string
+`
+	testCases := map[string]struct {
+		schema      string
+		parameters  string
+		approve     string
+		deploy      string
+		expectedErr string
+	}{
+		"substitute with defaults": {
+			schema:     schema,
+			parameters: `{"cluster":"eu-1","approval":{"group":"sre"}}`,
+			approve:    `{"duration":"10m","message":"approved by sre"}`,
+			deploy:     `{"clusters":["eu-1"],"parallelism":5}`,
+		},
+		"substitute overridden defaults": {
+			schema:     schema,
+			parameters: `{"cluster":"us-1","timeout":"1h","parallelism":2,"approval":{"group":"dev"}}`,
+			approve:    `{"duration":"1h","message":"approved by dev"}`,
+			deploy:     `{"clusters":["us-1"],"parallelism":2}`,
+		},
+		"missing required parameter": {
+			schema:      schema,
+			parameters:  `{"approval":{"group":"sre"}}`,
+			expectedErr: "invalid parameters",
+		},
+		"parameter with wrong type": {
+			schema:      schema,
+			parameters:  `{"cluster":"eu-1","parallelism":"2","approval":{"group":"sre"}}`,
+			expectedErr: "invalid parameters",
+		},
+		"parameter not declared": {
+			schema:      schema,
+			parameters:  `{"cluster":"eu-1","region":"eu","approval":{"group":"sre"}}`,
+			expectedErr: "invalid parameters",
+		},
+		"reference not declared": {
+			schema:      `cluster: string`,
+			parameters:  `{"cluster":"eu-1"}`,
+			expectedErr: "parameter timeout is not declared",
+		},
+		"invalid schema": {
+			schema:      `cluster: string |`,
+			expectedErr: "invalid parameter schema",
+		},
+		"no schema": {
+			parameters:  `{"cluster":"eu-1"}`,
+			expectedErr: "workflow ref-wf does not declare any parameter",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			r := require.New(t)
+			var parameters *runtime.RawExtension
+			if tc.parameters != "" {
+				parameters = &runtime.RawExtension{Raw: []byte(tc.parameters)}
+			}
+			wf := newParameterizedWorkflow(tc.schema)
+			steps, err := ResolveWorkflowParameters(wf, parameters)
+			if tc.expectedErr != "" {
+				r.ErrorContains(err, tc.expectedErr)
+				return
+			}
+			r.NoError(err)
+			r.Len(steps, 2)
+			r.JSONEq(tc.approve, string(steps[0].Properties.Raw))
+			r.JSONEq(tc.deploy, string(steps[1].SubSteps[0].Properties.Raw))
+			// the steps of the workflow are not modified
+			r.Contains(string(wf.Steps[0].Properties.Raw), "{{ parameter.timeout }}")
+		})
+	}
+
+	steps, err := ResolveWorkflowParameters(newParameterizedWorkflow(""), nil)
+	require.NoError(t, err)
+	require.Contains(t, string(steps[0].Properties.Raw), "{{ parameter.timeout }}")
+}
+
+func TestRefWorkflowStepGeneratorWithParameters(t *testing.T) {
+	r := require.New(t)
+	cli := fake.NewClientBuilder().WithScheme(common2.Scheme).WithObjects(newParameterizedWorkflow(`
+cluster: string
+timeout: *"10m" | string
+parallelism: *5 | int
+approval: group: *"sre" | string
+`)).Build()
+	generator := &RefWorkflowStepGenerator{Context: context.Background(), Client: cli}
+	app := &v1beta1.Application{
+		ObjectMeta: v1.ObjectMeta{Namespace: "test"},
+		Spec: v1beta1.ApplicationSpec{Workflow: &v1beta1.Workflow{
+			Ref:        "ref-wf",
+			Parameters: &runtime.RawExtension{Raw: []byte(`{"cluster":"eu-1"}`)},
+		}},
+	}
+	steps, err := generator.Generate(app, nil)
+	r.NoError(err)
+	r.JSONEq(`{"clusters":["eu-1"],"parallelism":5}`, string(steps[1].SubSteps[0].Properties.Raw))
+
+	app.Spec.Workflow.Parameters = &runtime.RawExtension{Raw: []byte(`{}`)}
+	_, err = generator.Generate(app, nil)
+	r.ErrorContains(err, "failed to resolve the parameters of workflow ref-wf")
+
+	app.Spec.Workflow.Ref = ""
+	_, err = generator.Generate(app, nil)
+	r.ErrorContains(err, "cannot set parameters in workflow without ref")
+}
@@ -20,6 +20,9 @@ import (
 	"context"
 	"fmt"
 	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
 
 	"github.com/gosuri/uitable"
 	"github.com/pkg/errors"
@@ -41,11 +44,17 @@ const (
 	addonGiteeType    = "gitee"
 	addonGitlabType   = "gitlab"
 	addonHelmType     = "helm"
+	addonOCIType      = "oci"
 	addonUsername     = "username"
 	addonPassword     = "password"
 	// only gitlab registry need set this flag
 	addonRepoName            = "gitlabRepoName"
 	addonHelmInsecureSkipTLS = "insecureSkipTLS"
+	// only oci registry need set these flags
+	addonDockerConfigSecret = "dockerConfigSecret"
+	addonPlainHTTP          = "plainHTTP"
+	// the trusted public keys to verify the signature of addons in the registry
+	addonPublicKey = "publicKey"
 )
 
 // NewAddonRegistryCommand return an addon registry command
@@ -75,18 +84,20 @@ func NewAddAddonRegistryCommand(c common.Args, _ cmdutil.IOStreams) *cobra.Comma
 add a github registry: vela addon registry add my-repo --type git --endpoint=<URL> --path=<path> --gitToken=<git token>
 add a specified github registry: vela addon registry add my-repo --type git --endpoint=https://github.com/kubevela/catalog --path=addons --gitToken=<git token>
 add a gitlab registry: vela addon registry add my-repo --type gitlab --endpoint=<URL> --gitlabRepoName=<repoName> --path=<path> --gitToken=<git token>
-add a specified gitlab registry: vela addon registry add my-repo --type gitlab --endpoint=http://gitlab.xxx.com/xxx/catalog --path=addons --gitlabRepoName=catalog --gitToken=<git token>`,
+add a specified gitlab registry: vela addon registry add my-repo --type gitlab --endpoint=http://gitlab.xxx.com/xxx/catalog --path=addons --gitlabRepoName=catalog --gitToken=<git token>
+add an oci registry: vela addon registry add my-repo --type oci --endpoint=oci://<registry>/<path> --dockerConfigSecret=<secret name>
+add a registry only serving signed addons: vela addon registry add my-repo --type helm --endpoint=<URL> --publicKey=<public key file>`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			registry, err := getRegistryFromArgs(cmd, args)
 			if err != nil {
 				return err
 			}
+			// oci registry is not checked here, as its credentials are loaded from the secret after it is added
 			if registry.Helm != nil {
-				versionedRegistry := pkgaddon.BuildVersionedRegistry(registry.Name, registry.Helm.URL, &common.HTTPOption{
-					Username:        registry.Helm.Username,
-					Password:        registry.Helm.Password,
-					InsecureSkipTLS: registry.Helm.InsecureSkipTLS,
-				})
+				versionedRegistry, err := pkgaddon.ToVersionedRegistry(*registry)
+				if err != nil {
+					return err
+				}
 				_, err = versionedRegistry.ListAddon()
 				if err != nil {
 					return fmt.Errorf("fail to add registry %s: %w", registry.Name, err)
@@ -215,6 +226,9 @@ func listAddonRegistry(ctx context.Context, c common.Args) error {
 		case registry.Gitlab != nil:
 			repoType = "gitlab"
 			repoURL = registry.Gitlab.URL
+		case registry.OCI != nil:
+			repoType = "oci"
+			repoURL = registry.OCI.URL
 		}
 
 		table.AddRow(registry.Name, repoType, repoURL)
@@ -250,6 +264,9 @@ func getAddonRegistry(ctx context.Context, c common.Args, name string) error {
 	case registry.Git != nil:
 		table.AddRow("NAME", "Type", "ENDPOINT", "PATH")
 		table.AddRow(registry.Name, "Git", registry.Git.URL, registry.Git.Path)
+	case registry.OCI != nil:
+		table.AddRow("NAME", "Type", "ENDPOINT", "DOCKER-CONFIG-SECRET")
+		table.AddRow(registry.Name, "OCI", registry.OCI.URL, registry.OCI.DockerConfigSecretRef)
 	default:
 		table.AddRow("Name")
 		table.AddRow(registry.Name)
@@ -307,7 +324,10 @@ func parseArgsFromFlag(cmd *cobra.Command) {
 	cmd.Flags().StringP(addonPassword, "", "", "specify the Helm addon registry password")
 	cmd.Flags().StringP(addonRepoName, "", "", "specify the gitlab addon registry repoName, must be set when registry is gitlab")
 	cmd.Flags().BoolP(addonHelmInsecureSkipTLS, "", false,
-		"specify the Helm addon registry skip tls verify")
+		"specify the Helm or OCI addon registry skip tls verify")
+	cmd.Flags().StringP(addonDockerConfigSecret, "", "", "specify the secret with type kubernetes.io/dockerconfigjson in vela-system namespace, which provides the credentials of the OCI addon registry")
+	cmd.Flags().BoolP(addonPlainHTTP, "", false, "specify the OCI addon registry use plain HTTP")
+	cmd.Flags().StringArrayP(addonPublicKey, "", nil, "specify the public key file in PEM format trusted to sign the addons in the registry, can be set multiple times")
 }
 
 func getRegistryFromArgs(cmd *cobra.Command, args []string) (*pkgaddon.Registry, error) {
@@ -404,9 +424,41 @@ func getRegistryFromArgs(cmd *cobra.Command, args []string) (*pkgaddon.Registry,
 		if err != nil {
 			return nil, err
 		}
+	case addonOCIType:
+		if !strings.HasPrefix(endpoint, pkgaddon.OCIScheme) {
+			return nil, fmt.Errorf("the endpoint of oci addon registry must start with %s", pkgaddon.OCIScheme)
+		}
+		r.OCI = &pkgaddon.OCIAddonSource{}
+		r.OCI.URL = endpoint
+		r.OCI.DockerConfigSecretRef, err = cmd.Flags().GetString(addonDockerConfigSecret)
+		if err != nil {
+			return nil, err
+		}
+		r.OCI.PlainHTTP, err = cmd.Flags().GetBool(addonPlainHTTP)
+		if err != nil {
+			return nil, err
+		}
+		r.OCI.InsecureSkipTLS, err = cmd.Flags().GetBool(addonHelmInsecureSkipTLS)
+		if err != nil {
+			return nil, err
+		}
 
 	default:
 		return nil, errors.New("not support addon registry type")
 	}
+	publicKeyFiles, err := cmd.Flags().GetStringArray(addonPublicKey)
+	if err != nil {
+		return nil, err
+	}
+	for _, file := range publicKeyFiles {
+		publicKey, err := os.ReadFile(filepath.Clean(file))
+		if err != nil {
+			return nil, err
+		}
+		r.PublicKeys = append(r.PublicKeys, string(publicKey))
+	}
+	if _, err = pkgaddon.ParsePublicKeys(r.PublicKeys); err != nil {
+		return nil, err
+	}
 	return r, nil
 }
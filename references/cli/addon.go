@@ -66,6 +66,9 @@ var (
 	overrideDefs  bool
 	dryRun        bool
 	yes2all       bool
+
+	insecureSkipVerify bool
+	updateDependencies bool
 )
 
 // NewAddonCommand create `addon` command
@@ -89,6 +92,7 @@ func NewAddonCommand(c common.Args, order string, ioStreams cmdutil.IOStreams) *
 		NewAddonPackageCommand(c),
 		NewAddonInitCommand(),
 		NewAddonPushCommand(c),
+		NewAddonSignCommand(),
 	)
 	return cmd
 }
@@ -226,6 +230,7 @@ func NewAddonEnableCommand(c common.Args, ioStream cmdutil.IOStreams) *cobra.Com
 	cmd.Flags().StringVarP(&addonClusters, types.ClustersArg, "c", "", "specify the runtime-clusters to enable")
 	cmd.Flags().BoolVarP(&skipValidate, "skip-version-validating", "s", false, "skip validating system version requirement")
 	cmd.Flags().BoolVarP(&overrideDefs, "override-definitions", "", false, "override existing definitions if conflict with those contained in this addon")
+	cmd.Flags().BoolVarP(&insecureSkipVerify, "insecure-skip-verify", "", false, "skip verifying the signature of the addon package even if the registry has trusted public keys")
 	cmd.Flags().BoolVarP(&dryRun, FlagDryRun, "", false, "render all yaml files out without real execute it")
 	cmd.Flags().BoolVarP(&yes2all, "yes", "y", false, "all checks will be skipped and the default answer is yes for all validation check.")
 	return cmd
@@ -263,6 +268,8 @@ func NewAddonUpgradeCommand(c common.Args, ioStream cmdutil.IOStreams) *cobra.Co
 	vela addon upgrade <addon-name> <my-parameter-of-addon>=<my-value>
   The specified args will be merged with legacy args, what user specified in 'vela addon enable', and non-empty legacy arg will be overridden by
 non-empty new arg
+  Upgrade addon and its dependencies to the latest versions satisfying the constraints:
+	vela addon upgrade <addon-name> --update-dependencies
 `,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if len(args) < 1 {
@@ -347,6 +354,8 @@ non-empty new arg
 	cmd.Flags().StringVarP(&addonClusters, types.ClustersArg, "c", "", "specify the runtime-clusters to upgrade")
 	cmd.Flags().BoolVarP(&skipValidate, "skip-version-validating", "s", false, "skip validating system version requirement")
 	cmd.Flags().BoolVarP(&overrideDefs, "override-definitions", "", false, "override existing definitions if conflict with those contained in this addon")
+	cmd.Flags().BoolVarP(&insecureSkipVerify, "insecure-skip-verify", "", false, "skip verifying the signature of the addon package even if the registry has trusted public keys")
+	cmd.Flags().BoolVarP(&updateDependencies, "update-dependencies", "", false, "re-resolve the dependencies and upgrade them to the latest versions satisfying the constraints, instead of keeping the installed versions")
 	return cmd
 }
 
@@ -480,24 +489,29 @@ func NewAddonPushCommand(c common.Args) *cobra.Command {
 	p := &pkgaddon.PushCmd{}
 	cmd := &cobra.Command{
 		Use:   "push",
-		Short: "uploads an addon package to ChartMuseum",
-		Long: `Uploads an addon package to ChartMuseum.
+		Short: "uploads an addon package to ChartMuseum or OCI registry",
+		Long: `Uploads an addon package to ChartMuseum or OCI registry.
 
-Two arguments are needed <addon directory/package> and <name/URL of ChartMuseum>.
+Two arguments are needed <addon directory/package> and <name/URL of ChartMuseum or OCI registry>.
 
 The first argument <addon directory/package> can be:
 	- your conventional addon directory (containing metadata.yaml). We will package it for you.
 	- packaged addon (.tgz) generated by 'vela addon package' command
 
-The second argument <name/URL of ChartMuseum> can be:
-	- registry name (helm type). You can add your ChartMuseum registry using 'vela addon registry add'.
-	- ChartMuseum URL, e.g. http://localhost:8080`,
+The second argument <name/URL of ChartMuseum or OCI registry> can be:
+	- registry name (helm or oci type). You can add your registry using 'vela addon registry add'.
+	- ChartMuseum URL, e.g. http://localhost:8080
+	- OCI registry URL, e.g. oci://localhost:5000/addons. The addon will be pushed to the repository
+	  oci://localhost:5000/addons/<addon name> and tagged with its version.`,
 		Example: `# Push the addon in directory <your-addon> to a ChartMuseum registry named <localcm>
 $ vela addon push your-addon localcm
 
 # Push packaged addon mongo-1.0.0.tgz to a ChartMuseum registry at http://localhost:8080
 $ vela addon push mongo-1.0.0.tgz http://localhost:8080
 
+# Push the addon in directory <your-addon> to an OCI registry with plain HTTP
+$ vela addon push your-addon oci://localhost:5000/addons --use-http
+
 # Force push, overwriting existing ones
 $ vela addon push your-addon localcm -f
 
@@ -617,6 +631,12 @@ func addonOptions() []pkgaddon.InstallOption {
 	if dryRun {
 		opts = append(opts, pkgaddon.DryRunAddon)
 	}
+	if insecureSkipVerify {
+		opts = append(opts, pkgaddon.InsecureSkipVerify)
+	}
+	if updateDependencies {
+		opts = append(opts, pkgaddon.UpdateDependencies)
+	}
 	return opts
 }
 
@@ -989,11 +1009,11 @@ func listAddons(ctx context.Context, clt client.Client, registry string) (*uitab
 				continue
 			}
 		} else {
-			versionedRegistry := pkgaddon.BuildVersionedRegistry(r.Name, r.Helm.URL, &common.HTTPOption{
-				Username:        r.Helm.Username,
-				Password:        r.Helm.Password,
-				InsecureSkipTLS: r.Helm.InsecureSkipTLS,
-			})
+			var versionedRegistry pkgaddon.VersionedRegistry
+			versionedRegistry, err = pkgaddon.ToVersionedRegistry(r)
+			if err != nil {
+				continue
+			}
 			addonList, err = versionedRegistry.ListAddon()
 			if err != nil {
 				continue
@@ -1204,6 +1224,44 @@ func NewAddonPackageCommand(_ common.Args) *cobra.Command {
 	return cmd
 }
 
+// NewAddonSignCommand create addon sign command
+func NewAddonSignCommand() *cobra.Command {
+	var keyFile string
+	cmd := &cobra.Command{
+		Use:   "sign",
+		Short: "sign an addon directory",
+		Long: fmt.Sprintf("generate the checksum manifest %s of an addon directory and sign it with the private key into %s. "+
+			"Chart.yaml is generated from the addon metadata if not exists and signed as well, so the version of the signed "+
+			"addon cannot be overridden when pushing it. "+
+			"Registries configured with the corresponding public keys will refuse to install unsigned or tampered addons.",
+			pkgaddon.ChecksumsFileName, pkgaddon.ChecksumsSignatureFileName),
+		Example: "vela addon sign <addon directory> --key private.pem",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) < 1 {
+				return fmt.Errorf("must specify addon directory path")
+			}
+			if keyFile == "" {
+				return fmt.Errorf("must specify the private key by --key")
+			}
+			addonDict, err := filepath.Abs(args[0])
+			if err != nil {
+				return err
+			}
+			privateKey, err := os.ReadFile(filepath.Clean(keyFile))
+			if err != nil {
+				return err
+			}
+			if err = pkgaddon.SignAddonDir(addonDict, privateKey); err != nil {
+				return errors.Wrapf(err, "fail to sign addon %s", addonDict)
+			}
+			fmt.Printf("Successfully sign addon %s\n", addonDict)
+			return nil
+		},
+	}
+	cmd.Flags().StringVarP(&keyFile, "key", "k", "", "the private key file in PEM format, ECDSA, ED25519 and RSA keys are supported")
+	return cmd
+}
+
 func splitSpecifyRegistry(name string) (string, string, error) {
 	res := strings.Split(name, "/")
 	switch len(res) {
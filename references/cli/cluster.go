@@ -32,6 +32,8 @@ import (
 	"github.com/oam-dev/cluster-gateway/pkg/config"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/client-go/tools/clientcmd"
 	"k8s.io/kubectl/pkg/util/i18n"
@@ -104,7 +106,10 @@ func ClusterCommandGroup(f velacmd.Factory, order string, c common.Args, ioStrea
 		NewClusterRenameCommand(&c),
 		NewClusterDetachCommand(&c),
 		NewClusterProbeCommand(&c),
+		NewClusterDescribeCommand(&c),
 		NewClusterLabelCommandGroup(&c),
+		NewClusterTaintCommandGroup(&c),
+		NewClusterGroupCommandGroup(&c),
 		NewClusterAliasCommand(&c),
 		NewClusterExportConfigCommand(f, ioStreams),
 	)
@@ -320,7 +325,7 @@ func updateAppsWithTopologyPolicy(ctx context.Context, cmd *cobra.Command, k8sCl
 }
 
 // hasClusterLabelSelector returns true when at least one topology policy
-// has an explicit clusterLabelSelector.
+// has an explicit clusterLabelSelector or a clusterGroup.
 func hasClusterLabelSelector(policies []v1beta1.AppPolicy) (bool, error) {
 	for _, p := range policies {
 		if p.Type != "topology" || p.Properties == nil || len(p.Properties.Raw) == 0 {
@@ -332,7 +337,7 @@ func hasClusterLabelSelector(policies []v1beta1.AppPolicy) (bool, error) {
 			return false, fmt.Errorf("error in unmarshalling policy %v: %w", p, err)
 		}
 
-		if tp.ClusterLabelSelector != nil {
+		if tp.ClusterLabelSelector != nil || tp.ClusterGroup != "" {
 			return true, nil
 		}
 	}
@@ -438,6 +443,118 @@ func NewClusterProbeCommand(c *common.Args) *cobra.Command {
 	return cmd
 }
 
+// NewClusterDescribeCommand create command to show the inventory report of the cluster
+func NewClusterDescribeCommand(c *common.Args) *cobra.Command {
+	var output string
+	cmd := &cobra.Command{
+		Use:   "describe [CLUSTER_NAME]",
+		Short: "describe managed cluster.",
+		Long:  "describe managed cluster, including the connectivity, the Kubernetes version, the capacity, the storage classes and the applications placed on the cluster.",
+		Example: templates.Examples(`
+			# Show the inventory report of the cluster
+			vela cluster describe prod
+
+			# Show the inventory report in JSON
+			vela cluster describe prod -o json`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cli, err := c.GetClient()
+			if err != nil {
+				return err
+			}
+			config, err := c.GetConfig()
+			if err != nil {
+				return err
+			}
+			inventory, err := multicluster.GetClusterInventory(context.Background(), cli, config, args[0])
+			if err != nil {
+				return errors.Wrapf(err, "failed to describe cluster %s", args[0])
+			}
+			if output != "" {
+				out, err := printObj(output, inventory)
+				if err != nil {
+					return err
+				}
+				cmd.Println(out)
+				return nil
+			}
+			printClusterInventory(cmd, inventory)
+			return nil
+		},
+	}
+	cmd.Flags().StringVarP(&output, "output", "o", "", "output the inventory report in the given format. One of: (json, yaml)")
+	return cmd
+}
+
+func printClusterInventory(cmd *cobra.Command, inventory *multicluster.ClusterInventory) {
+	name := inventory.Name
+	if inventory.Alias != "" {
+		name = fmt.Sprintf("%s (%s)", inventory.Name, inventory.Alias)
+	}
+	var labels []string
+	for k, v := range inventory.Labels {
+		if !strings.HasPrefix(k, config.MetaApiGroupName) {
+			labels = append(labels, k+"="+v)
+		}
+	}
+	sort.Strings(labels)
+	var taints []string
+	for _, taint := range inventory.Taints {
+		taints = append(taints, taint.ToString())
+	}
+	connected := color.GreenString("true")
+	if !inventory.Connected {
+		connected = color.RedString("false") + " (" + inventory.Message + ")"
+	}
+	table := newUITable()
+	table.AddRow("Name:", name)
+	table.AddRow("Type:", inventory.Type)
+	table.AddRow("Endpoint:", inventory.Endpoint)
+	table.AddRow("Labels:", strings.Join(labels, ","))
+	table.AddRow("Taints:", strings.Join(taints, ","))
+	table.AddRow("Connected:", connected)
+	table.AddRow("Version:", inventory.Version)
+	if inventory.Connected {
+		table.AddRow("Nodes:", fmt.Sprintf("%d master(s), %d worker(s)", inventory.MasterNumber, inventory.WorkerNumber))
+	}
+	cmd.Println(table.String())
+
+	if inventory.Connected {
+		usage := func(q func(*multicluster.ClusterResources) string) string {
+			if inventory.Usage == nil {
+				return "-"
+			}
+			return q(inventory.Usage)
+		}
+		table = newUITable().AddRow("RESOURCE", "CAPACITY", "ALLOCATABLE", "USAGE")
+		table.AddRow("cpu", inventory.Capacity.CPU.String(), inventory.Allocatable.CPU.String(), usage(func(r *multicluster.ClusterResources) string { return r.CPU.String() }))
+		table.AddRow("memory", inventory.Capacity.Memory.String(), inventory.Allocatable.Memory.String(), usage(func(r *multicluster.ClusterResources) string { return r.Memory.String() }))
+		table.AddRow("pods", inventory.Capacity.Pods.String(), inventory.Allocatable.Pods.String(), "-")
+		cmd.Println()
+		cmd.Println(table.String())
+
+		if len(inventory.StorageClasses) > 0 {
+			table = newUITable().AddRow("STORAGE-CLASS", "PROVISIONER", "DEFAULT")
+			for _, sc := range inventory.StorageClasses {
+				table.AddRow(sc.Name, sc.Provisioner, sc.Default)
+			}
+			cmd.Println()
+			cmd.Println(table.String())
+		}
+	}
+
+	cmd.Println()
+	if len(inventory.Applications) == 0 {
+		cmd.Println("No application placed on the cluster.")
+		return
+	}
+	table = newUITable().AddRow("APPLICATION", "NAMESPACE", "COMPONENTS", "RESOURCES")
+	for _, app := range inventory.Applications {
+		table.AddRow(app.Name, app.Namespace, strings.Join(app.Components, ","), app.Resources)
+	}
+	cmd.Println(table.String())
+}
+
 // NewClusterLabelCommandGroup create a group of commands to manage cluster labels
 func NewClusterLabelCommandGroup(c *common.Args) *cobra.Command {
 	cmd := &cobra.Command{
@@ -493,14 +610,23 @@ func NewClusterAddLabelsCommand(c *common.Args) *cobra.Command {
 	return cmd
 }
 
-func addClusterLabels(cmd *cobra.Command, c *common.Args, clusterName, labels string) error {
-	addLabels := map[string]string{}
+// parseClusterLabels parses the labels in the format of LABEL_KEY=LABEL_VAL,LABEL_KEY=LABEL_VAL
+func parseClusterLabels(labels string) (map[string]string, error) {
+	parsed := map[string]string{}
 	for _, kv := range strings.Split(labels, ",") {
 		parts := strings.Split(kv, "=")
 		if len(parts) != 2 {
-			return errors.Errorf("invalid label key-value pair %s, should use the format LABEL_KEY=LABEL_VAL", kv)
+			return nil, errors.Errorf("invalid label key-value pair %s, should use the format LABEL_KEY=LABEL_VAL", kv)
 		}
-		addLabels[parts[0]] = parts[1]
+		parsed[parts[0]] = parts[1]
+	}
+	return parsed, nil
+}
+
+func addClusterLabels(cmd *cobra.Command, c *common.Args, clusterName, labels string) error {
+	addLabels, err := parseClusterLabels(labels)
+	if err != nil {
+		return err
 	}
 
 	cli, err := c.GetClient()
@@ -553,6 +679,172 @@ func NewClusterDelLabelsCommand(c *common.Args) *cobra.Command {
 	return cmd
 }
 
+// NewClusterTaintCommandGroup create a group of commands to manage cluster taints
+func NewClusterTaintCommandGroup(c *common.Args) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "taint",
+		Aliases: []string{"taints"},
+		Short:   "Manage Kubernetes Cluster Taints.",
+		Long: "Manage Kubernetes Cluster Taints. Clusters selected by labels in topology policies are skipped " +
+			"if they have NoSchedule or NoExecute taints not tolerated. Workloads are moved off the clusters " +
+			"with NoExecute taints not tolerated in the next reconcile of applications.",
+	}
+	cmd.AddCommand(
+		NewClusterAddTaintsCommand(c),
+		NewClusterDelTaintsCommand(c),
+	)
+	return cmd
+}
+
+// parseClusterTaint parses the taint in the format of KEY[=VALUE]:EFFECT
+func parseClusterTaint(spec string) (corev1.Taint, error) {
+	taint := corev1.Taint{}
+	idx := strings.LastIndex(spec, ":")
+	if idx < 0 {
+		return taint, errors.Errorf("invalid taint %s, should use the format KEY[=VALUE]:EFFECT", spec)
+	}
+	taint.Effect = corev1.TaintEffect(spec[idx+1:])
+	switch taint.Effect {
+	case corev1.TaintEffectNoSchedule, corev1.TaintEffectPreferNoSchedule, corev1.TaintEffectNoExecute:
+	default:
+		return taint, errors.Errorf("invalid taint effect %s, should be one of %s, %s or %s", taint.Effect,
+			corev1.TaintEffectNoSchedule, corev1.TaintEffectPreferNoSchedule, corev1.TaintEffectNoExecute)
+	}
+	taint.Key, taint.Value, _ = strings.Cut(spec[:idx], "=")
+	if taint.Key == "" {
+		return taint, errors.Errorf("invalid taint %s, key cannot be empty", spec)
+	}
+	return taint, nil
+}
+
+// addClusterTaints adds the taints to the existing ones, the taint with the same key and effect is replaced.
+// NoExecute taints are stamped with the time added, which decides the applications to move workloads off.
+func addClusterTaints(existing []corev1.Taint, taints []corev1.Taint, now metav1.Time) []corev1.Taint {
+	for _, taint := range taints {
+		idx := slices.Index(existing, func(t corev1.Taint) bool { return t.MatchTaint(&taint) })
+		if idx >= 0 && existing[idx].Value == taint.Value {
+			continue
+		}
+		if taint.Effect == corev1.TaintEffectNoExecute {
+			taint.TimeAdded = now.DeepCopy()
+		}
+		if idx >= 0 {
+			existing[idx] = taint
+		} else {
+			existing = append(existing, taint)
+		}
+	}
+	return existing
+}
+
+// removeClusterTaints removes the taints matching KEY or KEY:EFFECT
+func removeClusterTaints(existing []corev1.Taint, specs []string) ([]corev1.Taint, error) {
+	for _, spec := range specs {
+		key, effect, _ := strings.Cut(spec, ":")
+		remaining := slices.Filter(existing, func(t corev1.Taint) bool {
+			return t.Key != key || (effect != "" && string(t.Effect) != effect)
+		})
+		if len(remaining) == len(existing) {
+			return nil, errors.Errorf("no such taint %s", spec)
+		}
+		existing = remaining
+	}
+	return existing, nil
+}
+
+func updateClusterTaintsAndPrint(cmd *cobra.Command, cli client.Client, vc *multicluster.VirtualCluster, taints []corev1.Taint) error {
+	if err := multicluster.SetClusterTaints(vc.Object, taints); err != nil {
+		return err
+	}
+	if err := cli.Update(context.Background(), vc.Object); err != nil {
+		return errors.Wrapf(err, "failed to update taints for cluster %s, type: %s", vc.FullName(), vc.Type)
+	}
+	cmd.Printf("Successfully update taints for cluster %s, type: %s.\n", vc.FullName(), vc.Type)
+	if len(taints) == 0 {
+		cmd.Println("No taint exists.")
+	}
+	for _, taint := range taints {
+		cmd.Println(color.CyanString(taint.ToString()))
+	}
+	return nil
+}
+
+func getTaintableCluster(cli client.Client, clusterName string) (*multicluster.VirtualCluster, []corev1.Taint, error) {
+	vc, err := multicluster.GetVirtualCluster(context.Background(), cli, clusterName)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to get cluster %s", clusterName)
+	}
+	if vc.Object == nil {
+		return nil, nil, errors.Errorf("cluster type %s do not support taints", vc.Type)
+	}
+	taints, err := multicluster.GetClusterTaints(vc.Object)
+	if err != nil {
+		return nil, nil, err
+	}
+	return vc, taints, nil
+}
+
+// NewClusterAddTaintsCommand create command to add taints for managed cluster
+func NewClusterAddTaintsCommand(c *common.Args) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "add CLUSTER_NAME TAINTS",
+		Short:   "add taints to managed cluster.",
+		Long:    "add taints to managed cluster, the taints are in the format of KEY[=VALUE]:EFFECT.",
+		Example: "vela cluster taint add my-cluster maintenance=true:NoExecute,gpu:NoSchedule",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clusterName := args[0]
+			var taints []corev1.Taint
+			for _, spec := range strings.Split(args[1], ",") {
+				taint, err := parseClusterTaint(spec)
+				if err != nil {
+					return err
+				}
+				taints = append(taints, taint)
+			}
+			cli, err := c.GetClient()
+			if err != nil {
+				return err
+			}
+			vc, existing, err := getTaintableCluster(cli, clusterName)
+			if err != nil {
+				return err
+			}
+			return updateClusterTaintsAndPrint(cmd, cli, vc, addClusterTaints(existing, taints, metav1.Now()))
+		},
+	}
+	return cmd
+}
+
+// NewClusterDelTaintsCommand create command to delete taints for managed cluster
+func NewClusterDelTaintsCommand(c *common.Args) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "del CLUSTER_NAME TAINTS",
+		Aliases: []string{"delete", "remove"},
+		Short:   "Delete taints for managed cluster.",
+		Long:    "Delete taints for managed cluster, the taints are in the format of KEY or KEY:EFFECT.",
+		Example: "vela cluster taint del my-cluster maintenance,gpu:NoSchedule",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clusterName := args[0]
+			cli, err := c.GetClient()
+			if err != nil {
+				return err
+			}
+			vc, existing, err := getTaintableCluster(cli, clusterName)
+			if err != nil {
+				return err
+			}
+			taints, err := removeClusterTaints(existing, strings.Split(args[1], ","))
+			if err != nil {
+				return err
+			}
+			return updateClusterTaintsAndPrint(cmd, cli, vc, taints)
+		},
+	}
+	return cmd
+}
+
 // NewClusterExportConfigCommand create command to export multi-cluster config
 func NewClusterExportConfigCommand(f velacmd.Factory, ioStreams cmdutil.IOStreams) *cobra.Command {
 	var labelSelector string
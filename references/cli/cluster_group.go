@@ -0,0 +1,256 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cli
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/kubevela/pkg/util/slices"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/oam-dev/kubevela/pkg/multicluster"
+	"github.com/oam-dev/kubevela/pkg/utils/common"
+)
+
+// NewClusterGroupCommandGroup create a group of commands to manage cluster groups
+func NewClusterGroupCommandGroup(c *common.Args) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "group",
+		Aliases: []string{"groups"},
+		Short:   "Manage Kubernetes Cluster Groups.",
+		Long: "Manage Kubernetes Cluster Groups. A cluster group holds the clusters listed in it and the clusters " +
+			"matching its label selector, topology policies select the members of the group by the clusterGroup field. " +
+			"The applications selecting the group are placed again once the members of the group change.",
+	}
+	cmd.AddCommand(
+		NewClusterGroupCreateCommand(c),
+		NewClusterGroupAddCommand(c),
+		NewClusterGroupRemoveCommand(c),
+		NewClusterGroupListCommand(c),
+		NewClusterGroupDeleteCommand(c),
+	)
+	return cmd
+}
+
+// checkClustersExist checks the clusters to add into the cluster group exist
+func checkClustersExist(cli client.Client, clusters []string) error {
+	for _, cluster := range clusters {
+		if _, err := multicluster.GetVirtualCluster(context.Background(), cli, cluster); err != nil {
+			return errors.Wrapf(err, "failed to get cluster %s", cluster)
+		}
+	}
+	return nil
+}
+
+// NewClusterGroupCreateCommand create command to create cluster group
+func NewClusterGroupCreateCommand(c *common.Args) *cobra.Command {
+	var clusters []string
+	var selector string
+	cmd := &cobra.Command{
+		Use:     "create GROUP_NAME",
+		Short:   "create cluster group.",
+		Long:    "create cluster group with the clusters listed and the label selector for clusters.",
+		Example: "vela cluster group create prod --clusters cluster-a,cluster-b --selector env=prod,region=us",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			group := &multicluster.ClusterGroup{Name: args[0], Clusters: clusters}
+			if selector != "" {
+				labels, err := parseClusterLabels(selector)
+				if err != nil {
+					return err
+				}
+				group.ClusterLabelSelector = labels
+			}
+			if len(group.Clusters) == 0 && len(group.ClusterLabelSelector) == 0 {
+				return errors.New("either clusters or selector must be set")
+			}
+			cli, err := c.GetClient()
+			if err != nil {
+				return err
+			}
+			if err = checkClustersExist(cli, group.Clusters); err != nil {
+				return err
+			}
+			if err = multicluster.CreateClusterGroup(context.Background(), cli, group); err != nil {
+				return errors.Wrapf(err, "failed to create cluster group %s", group.Name)
+			}
+			cmd.Printf("Successfully create cluster group %s.\n", group.Name)
+			return nil
+		},
+	}
+	cmd.Flags().StringSliceVarP(&clusters, "clusters", "c", nil, "The clusters listed in the group.")
+	cmd.Flags().StringVarP(&selector, "selector", "l", "", "The label selector for clusters in the group, in the format of LABEL_KEY=LABEL_VAL,LABEL_KEY=LABEL_VAL.")
+	return cmd
+}
+
+// NewClusterGroupAddCommand create command to add clusters into cluster group
+func NewClusterGroupAddCommand(c *common.Args) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "add GROUP_NAME CLUSTERS",
+		Short:   "add clusters into cluster group.",
+		Long:    "add clusters into the list of cluster group.",
+		Example: "vela cluster group add prod cluster-c,cluster-d",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cli, err := c.GetClient()
+			if err != nil {
+				return err
+			}
+			group, err := multicluster.GetClusterGroup(context.Background(), cli, args[0])
+			if err != nil {
+				return errors.Wrapf(err, "failed to get cluster group %s", args[0])
+			}
+			clusters := strings.Split(args[1], ",")
+			if err = checkClustersExist(cli, clusters); err != nil {
+				return err
+			}
+			for _, cluster := range clusters {
+				if !slices.Contains(group.Clusters, cluster) {
+					group.Clusters = append(group.Clusters, cluster)
+				}
+			}
+			return updateClusterGroupAndPrint(cmd, cli, group)
+		},
+	}
+	return cmd
+}
+
+// NewClusterGroupRemoveCommand create command to remove clusters from cluster group
+func NewClusterGroupRemoveCommand(c *common.Args) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "remove GROUP_NAME CLUSTERS",
+		Aliases: []string{"del"},
+		Short:   "remove clusters from cluster group.",
+		Long:    "remove clusters from the list of cluster group, the clusters matching the label selector of the group are not affected.",
+		Example: "vela cluster group remove prod cluster-c,cluster-d",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cli, err := c.GetClient()
+			if err != nil {
+				return err
+			}
+			group, err := multicluster.GetClusterGroup(context.Background(), cli, args[0])
+			if err != nil {
+				return errors.Wrapf(err, "failed to get cluster group %s", args[0])
+			}
+			for _, cluster := range strings.Split(args[1], ",") {
+				if !slices.Contains(group.Clusters, cluster) {
+					return errors.Errorf("no such cluster %s in cluster group %s", cluster, group.Name)
+				}
+				group.Clusters = slices.Filter(group.Clusters, func(name string) bool { return name != cluster })
+			}
+			return updateClusterGroupAndPrint(cmd, cli, group)
+		},
+	}
+	return cmd
+}
+
+func updateClusterGroupAndPrint(cmd *cobra.Command, cli client.Client, group *multicluster.ClusterGroup) error {
+	if err := multicluster.UpdateClusterGroup(context.Background(), cli, group); err != nil {
+		return err
+	}
+	cmd.Printf("Successfully update cluster group %s.\n", group.Name)
+	if len(group.Clusters) == 0 {
+		cmd.Println("No cluster listed in the group.")
+	}
+	for _, cluster := range group.Clusters {
+		cmd.Println(cluster)
+	}
+	return nil
+}
+
+// NewClusterGroupListCommand create command to list cluster groups
+func NewClusterGroupListCommand(c *common.Args) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Short:   "list cluster groups.",
+		Long:    "list cluster groups with the members resolved currently.",
+		Args:    cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cli, err := c.GetClient()
+			if err != nil {
+				return err
+			}
+			groups, err := multicluster.ListClusterGroups(context.Background(), cli)
+			if err != nil {
+				return err
+			}
+			if len(groups) == 0 {
+				cmd.Println("No cluster group found.")
+				return nil
+			}
+			table := newUITable().AddRow("GROUP", "CLUSTERS", "SELECTOR", "MEMBERS")
+			for _, group := range groups {
+				members := append([]string{}, group.Clusters...)
+				if len(group.ClusterLabelSelector) > 0 {
+					vcs, err := multicluster.FindVirtualClustersByLabels(context.Background(), cli, group.ClusterLabelSelector)
+					if err != nil {
+						return errors.Wrapf(err, "failed to find clusters in cluster group %s", group.Name)
+					}
+					for _, vc := range vcs {
+						if !slices.Contains(members, vc.Name) {
+							members = append(members, vc.Name)
+						}
+					}
+				}
+				sort.Strings(members)
+				table.AddRow(group.Name, strings.Join(group.Clusters, ","), formatClusterLabelSelector(group.ClusterLabelSelector), strings.Join(members, ","))
+			}
+			cmd.Println(table.String())
+			return nil
+		},
+	}
+	return cmd
+}
+
+// formatClusterLabelSelector formats the label selector in the format of LABEL_KEY=LABEL_VAL,LABEL_KEY=LABEL_VAL
+func formatClusterLabelSelector(selector map[string]string) string {
+	var labels []string
+	for k, v := range selector {
+		labels = append(labels, k+"="+v)
+	}
+	sort.Strings(labels)
+	return strings.Join(labels, ",")
+}
+
+// NewClusterGroupDeleteCommand create command to delete cluster group
+func NewClusterGroupDeleteCommand(c *common.Args) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "delete GROUP_NAME",
+		Aliases: []string{"rm"},
+		Short:   "delete cluster group.",
+		Long:    "delete cluster group, the applications selecting the group fail to be placed until the group is created again.",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cli, err := c.GetClient()
+			if err != nil {
+				return err
+			}
+			if err = multicluster.DeleteClusterGroup(context.Background(), cli, args[0]); err != nil {
+				return errors.Wrapf(err, "failed to delete cluster group %s", args[0])
+			}
+			cmd.Printf("Successfully delete cluster group %s.\n", args[0])
+			return nil
+		},
+	}
+	return cmd
+}
@@ -17,16 +17,24 @@ limitations under the License.
 package cli
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"testing"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/yaml"
 
 	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1beta1"
+	"github.com/oam-dev/kubevela/pkg/multicluster"
 	"github.com/oam-dev/kubevela/pkg/oam"
 )
 
@@ -187,3 +195,82 @@ func hasPublishVersionAnnotation(name, namespace string) (bool, error) {
 	}
 	return false, nil
 }
+
+func TestClusterTaints(t *testing.T) {
+	r := require.New(t)
+	taint, err := parseClusterTaint("maintenance=true:NoExecute")
+	r.NoError(err)
+	r.Equal(corev1.Taint{Key: "maintenance", Value: "true", Effect: corev1.TaintEffectNoExecute}, taint)
+	taint, err = parseClusterTaint("gpu:NoSchedule")
+	r.NoError(err)
+	r.Equal(corev1.Taint{Key: "gpu", Effect: corev1.TaintEffectNoSchedule}, taint)
+	for _, spec := range []string{"gpu", "gpu:Unknown", "=true:NoSchedule"} {
+		_, err = parseClusterTaint(spec)
+		r.Error(err, spec)
+	}
+
+	added := metav1.NewTime(time.Now().Add(-time.Hour))
+	existing := []corev1.Taint{
+		{Key: "maintenance", Value: "true", Effect: corev1.TaintEffectNoExecute, TimeAdded: &added},
+		{Key: "gpu", Effect: corev1.TaintEffectNoSchedule},
+	}
+	now := metav1.Now()
+	taints := addClusterTaints(existing, []corev1.Taint{
+		{Key: "maintenance", Value: "true", Effect: corev1.TaintEffectNoExecute},
+		{Key: "gpu", Value: "a100", Effect: corev1.TaintEffectNoSchedule},
+		{Key: "gpu", Effect: corev1.TaintEffectNoExecute},
+	}, now)
+	r.Equal([]corev1.Taint{
+		// the unchanged taint keeps the time added
+		{Key: "maintenance", Value: "true", Effect: corev1.TaintEffectNoExecute, TimeAdded: &added},
+		{Key: "gpu", Value: "a100", Effect: corev1.TaintEffectNoSchedule},
+		{Key: "gpu", Effect: corev1.TaintEffectNoExecute, TimeAdded: &now},
+	}, taints)
+
+	taints, err = removeClusterTaints(taints, []string{"gpu:NoSchedule"})
+	r.NoError(err)
+	r.Len(taints, 2)
+	taints, err = removeClusterTaints(taints, []string{"maintenance", "gpu"})
+	r.NoError(err)
+	r.Empty(taints)
+	_, err = removeClusterTaints(taints, []string{"not-exist"})
+	r.ErrorContains(err, "no such taint not-exist")
+}
+
+func TestPrintClusterInventory(t *testing.T) {
+	r := require.New(t)
+	pods := resource.MustParse("110")
+	inventory := &multicluster.ClusterInventory{
+		Name:         "prod",
+		Alias:        "production",
+		Type:         "X509Certificate",
+		Labels:       map[string]string{"region": "us"},
+		Taints:       []corev1.Taint{{Key: "gpu", Effect: corev1.TaintEffectNoSchedule}},
+		Connected:    true,
+		Version:      "v1.29.0",
+		WorkerNumber: 2,
+		MasterNumber: 1,
+		Capacity:     &multicluster.ClusterResources{CPU: resource.MustParse("8"), Memory: resource.MustParse("16Gi"), Pods: &pods},
+		Allocatable:  &multicluster.ClusterResources{CPU: resource.MustParse("6"), Memory: resource.MustParse("14Gi"), Pods: &pods},
+		StorageClasses: []multicluster.ClusterStorageClass{
+			{Name: "standard", Provisioner: "rancher.io/local-path", Default: true},
+		},
+		Applications: []multicluster.ClusterApplication{
+			{Name: "app1", Namespace: "default", Components: []string{"db", "web"}, Resources: 3},
+		},
+	}
+	buf := &bytes.Buffer{}
+	cmd := &cobra.Command{}
+	cmd.SetOut(buf)
+	printClusterInventory(cmd, inventory)
+	out := buf.String()
+	for _, s := range []string{"prod (production)", "region=us", "gpu:NoSchedule", "v1.29.0", "1 master(s), 2 worker(s)", "16Gi", "rancher.io/local-path", "db,web"} {
+		r.Contains(out, s)
+	}
+
+	buf.Reset()
+	printClusterInventory(cmd, &multicluster.ClusterInventory{Name: "dev", Message: "connection refused"})
+	r.Contains(buf.String(), "connection refused")
+	r.Contains(buf.String(), "No application placed on the cluster.")
+	r.NotContains(buf.String(), "CAPACITY")
+}
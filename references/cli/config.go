@@ -55,6 +55,9 @@ func ConfigCommandGroup(f velacmd.Factory, order string, streams util.IOStreams)
 	cmd.AddCommand(NewCreateConfigCommand(f, streams))
 	cmd.AddCommand(NewDistributeConfigCommand(f, streams))
 	cmd.AddCommand(NewDeleteConfigCommand(f, streams))
+	cmd.AddCommand(NewConfigHistoryCommand(f, streams))
+	cmd.AddCommand(NewRollbackConfigCommand(f, streams))
+	cmd.AddCommand(NewRotateConfigCommand(f, streams))
 	return cmd
 }
 
@@ -335,7 +338,7 @@ func NewListConfigCommand(f velacmd.Factory, streams util.IOStreams) *cobra.Comm
 				return err
 			}
 			table := newUITable()
-			header := []interface{}{"NAME", "ALIAS", "DISTRIBUTION", "TEMPLATE", "CREATED-TIME", "DESCRIPTION"}
+			header := []interface{}{"NAME", "ALIAS", "DISTRIBUTION", "SYNC", "TEMPLATE", "CREATED-TIME", "DESCRIPTION"}
 			if options.AllNamespace {
 				header = append([]interface{}{"NAMESPACE"}, header...)
 			}
@@ -355,7 +358,7 @@ func NewListConfigCommand(f velacmd.Factory, streams util.IOStreams) *cobra.Comm
 						targetShow += yellow.Sprintf("%s/%s", target.ClusterName, target.Namespace)
 					}
 				}
-				row := []interface{}{t.Name, t.Alias, targetShow, fmt.Sprintf("%s/%s", t.Template.Namespace, t.Template.Name), t.CreateTime, t.Description}
+				row := []interface{}{t.Name, t.Alias, targetShow, showSyncState(t.Targets), fmt.Sprintf("%s/%s", t.Template.Namespace, t.Template.Name), t.CreateTime, t.Description}
 				if options.AllNamespace {
 					row = append([]interface{}{t.Namespace}, row...)
 				}
@@ -376,6 +379,24 @@ func NewListConfigCommand(f velacmd.Factory, streams util.IOStreams) *cobra.Comm
 	return cmd
 }
 
+// showSyncState summarizes the sync state of the distributed config, it is out of sync if any target is out of sync
+func showSyncState(targets []*config.ClusterTargetStatus) string {
+	states := map[string]bool{}
+	for _, target := range targets {
+		states[target.SyncState] = true
+	}
+	switch {
+	case states[config.SyncStateOutOfSync]:
+		return red.Sprint(config.SyncStateOutOfSync)
+	case states[config.SyncStateUnknown]:
+		return yellow.Sprint(config.SyncStateUnknown)
+	case states[config.SyncStateInSync] && !states[""]:
+		return green.Sprint(config.SyncStateInSync)
+	default:
+		return ""
+	}
+}
+
 // NewCreateConfigCommand command for creating the config
 func NewCreateConfigCommand(f velacmd.Factory, streams util.IOStreams) *cobra.Command {
 	var options CreateConfigCommandOptions
@@ -457,7 +478,15 @@ func NewCreateConfigCommand(f velacmd.Factory, streams util.IOStreams) *cobra.Co
 				_, err = streams.Out.Write(outBuilder.Bytes())
 				return err
 			}
-			if err := inf.CreateOrUpdateConfig(context.Background(), configItem, options.Namespace); err != nil {
+			err = inf.CreateOrUpdateConfig(context.Background(), configItem, options.Namespace)
+			for _, status := range configItem.WriterStatuses {
+				if status.Synced {
+					streams.Infof("the config %s synced to the %s successfully\n", options.Name, status.Writer)
+				} else {
+					streams.Errorf("the config %s failed to sync to the %s: %s\n", options.Name, status.Writer, status.Message)
+				}
+			}
+			if err != nil {
 				return err
 			}
 			if len(options.Targets) > 0 {
@@ -631,3 +660,121 @@ func NewDeleteConfigCommand(f velacmd.Factory, streams util.IOStreams) *cobra.Co
 	cmd.Flags().BoolVarP(&options.NotRecall, "not-recall", "", false, "means only deleting the config from the local and do not recall from targets.")
 	return cmd
 }
+
+// ConfigCommandOptions the options of the command that show the history of the config or rotate the config.
+type ConfigCommandOptions struct {
+	Namespace string
+	Name      string
+}
+
+// ConfigRollbackCommandOptions the options of the command that rollback the config.
+type ConfigRollbackCommandOptions struct {
+	Namespace string
+	Name      string
+	Version   int
+}
+
+// NewConfigHistoryCommand command for listing the versions of the config
+func NewConfigHistoryCommand(f velacmd.Factory, streams util.IOStreams) *cobra.Command {
+	var options ConfigCommandOptions
+	cmd := &cobra.Command{
+		Use:   "history",
+		Short: i18n.T("List the versions of a config."),
+		Example: templates.Examples(i18n.T(`
+		# List the current and previous versions of the config
+		vela config history db-config -n vela-system
+		`)),
+		Annotations: map[string]string{
+			types.TagCommandType: types.TypeCD,
+		},
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			options.Name = args[0]
+			inf := config.NewConfigFactory(f.Client())
+			versions, err := inf.ListConfigVersions(context.Background(), options.Namespace, options.Name)
+			if err != nil {
+				return err
+			}
+			table := newUITable()
+			table.AddRow("VERSION", "CURRENT", "CREATED-TIME")
+			for _, v := range versions {
+				current := ""
+				if v.Current {
+					current = "*"
+				}
+				table.AddRow(v.Version, current, v.CreateTime)
+			}
+			if _, err := streams.Out.Write(table.Bytes()); err != nil {
+				return err
+			}
+			if _, err := streams.Out.Write([]byte("\n")); err != nil {
+				return err
+			}
+			return nil
+		},
+	}
+	cmd.Flags().StringVarP(&options.Namespace, "namespace", "n", types.DefaultKubeVelaNS, "specify the namespace of the config")
+	return cmd
+}
+
+// NewRollbackConfigCommand command for rolling back the config to a previous version
+func NewRollbackConfigCommand(f velacmd.Factory, streams util.IOStreams) *cobra.Command {
+	var options ConfigRollbackCommandOptions
+	cmd := &cobra.Command{
+		Use:   "rollback",
+		Short: i18n.T("Rollback a config to a previous version."),
+		Long:  i18n.T("Rollback a config to a previous version. The rollback creates a new version of the config, the expanded writers and the distributions are synced with the restored properties."),
+		Example: templates.Examples(i18n.T(`
+		# Rollback the config to the version 2
+		vela config rollback db-config --version 2
+		`)),
+		Annotations: map[string]string{
+			types.TagCommandType: types.TypeCD,
+		},
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			options.Name = args[0]
+			if options.Version <= 0 {
+				return fmt.Errorf("the version to rollback must be specified")
+			}
+			inf := config.NewConfigFactory(f.Client())
+			if err := inf.RollbackConfig(context.Background(), options.Namespace, options.Name, options.Version); err != nil {
+				return err
+			}
+			streams.Infof("the config %s rolled back to the version %d successfully\n", options.Name, options.Version)
+			return nil
+		},
+	}
+	cmd.Flags().StringVarP(&options.Namespace, "namespace", "n", types.DefaultKubeVelaNS, "specify the namespace of the config")
+	cmd.Flags().IntVarP(&options.Version, "version", "", 0, "specify the version to rollback")
+	return cmd
+}
+
+// NewRotateConfigCommand command for rotating the config by the rotation defined in the template
+func NewRotateConfigCommand(f velacmd.Factory, streams util.IOStreams) *cobra.Command {
+	var options ConfigCommandOptions
+	cmd := &cobra.Command{
+		Use:   "rotate",
+		Short: i18n.T("Rotate a config."),
+		Long:  i18n.T("Rotate a config immediately, the properties are regenerated by the rotation defined in the template."),
+		Example: templates.Examples(i18n.T(`
+		# Regenerate the password of the config
+		vela config rotate db-config
+		`)),
+		Annotations: map[string]string{
+			types.TagCommandType: types.TypeCD,
+		},
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			options.Name = args[0]
+			inf := config.NewConfigFactory(f.Client())
+			if err := inf.RotateConfig(context.Background(), options.Namespace, options.Name); err != nil {
+				return err
+			}
+			streams.Infof("the config %s rotated successfully\n", options.Name)
+			return nil
+		},
+	}
+	cmd.Flags().StringVarP(&options.Namespace, "namespace", "n", types.DefaultKubeVelaNS, "specify the namespace of the config")
+	return cmd
+}
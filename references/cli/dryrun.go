@@ -57,6 +57,7 @@ type DryRunCmdOptions struct {
 	OfflineMode          bool
 	MergeStandaloneFiles bool
 	DefinitionNamespace  string
+	Output               string
 }
 
 // NewDryRunCommand creates `dry-run` command
@@ -90,6 +91,9 @@ vela dry-run -f app.yaml
 
 # dry-run application with policy and workflow
 vela dry-run -f app.yaml -f policy.yaml -f workflow.yaml
+
+# dry-run application and output the rendered resources with their components, traits, clusters and policies in JSON
+vela dry-run -f app.yaml -o json
 `,
 		Annotations: map[string]string{
 			types.TagCommandType:  types.TypeApp,
@@ -126,6 +130,7 @@ vela dry-run -f app.yaml -f policy.yaml -f workflow.yaml
 	cmd.Flags().BoolVar(&o.OfflineMode, "offline", false, "Run `dry-run` in offline / local mode, all validation steps will be skipped")
 	cmd.Flags().BoolVar(&o.MergeStandaloneFiles, "merge", false, "Merge standalone files to produce dry-run results")
 	cmd.Flags().StringVarP(&o.DefinitionNamespace, "definition-namespace", "x", "", "Specify which namespace the definition locates. (default \"vela-system\")")
+	cmd.Flags().StringVarP(&o.Output, "output", "o", "", "Output the rendered resources with their provenance in the given format. One of: (json, yaml)")
 	addNamespaceAndEnvArg(cmd)
 	cmd.SetOut(ioStreams.Out)
 	return cmd
@@ -177,10 +182,18 @@ func DryRunApplication(cmdOption *DryRunCmdOptions, c common.Args, namespace str
 		}
 	}
 
-	app, err := readApplicationFromFiles(cmdOption, &buff)
+	// warnings are not mixed into the structured output
+	warnings := &buff
+	if cmdOption.Output != "" {
+		warnings = &bytes.Buffer{}
+	}
+	app, err := readApplicationFromFiles(cmdOption, warnings)
 	if err != nil {
 		return buff, errors.WithMessagef(err, "read application files: %s", cmdOption.ApplicationFiles)
 	}
+	if warnings != &buff && warnings.Len() > 0 {
+		cmdOption.Error(strings.TrimSpace(warnings.String()))
+	}
 
 	if app.Namespace != "" && namespace != "" && app.Namespace != namespace {
 		// Overwrite application namespace with flag-provided namespace (change replicated from upstream PR #6855)
@@ -198,6 +211,18 @@ func DryRunApplication(cmdOption *DryRunCmdOptions, c common.Args, namespace str
 		ctx = oamutil.SetNamespaceInCtx(ctx, app.Namespace)
 	}
 
+	if cmdOption.Output != "" {
+		result, err := dryRunOpt.GenerateDryRunResult(ctx, app)
+		if err != nil {
+			return buff, err
+		}
+		out, err := printObj(cmdOption.Output, result)
+		if err != nil {
+			return buff, err
+		}
+		buff.WriteString(out)
+		return buff, nil
+	}
 	err = dryRunOpt.ExecuteDryRunWithPolicies(ctx, app, &buff)
 	if err != nil {
 		return buff, err
@@ -18,6 +18,7 @@ package cli
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"os"
 	"strings"
@@ -33,6 +34,7 @@ import (
 
 	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1beta1"
 	"github.com/oam-dev/kubevela/apis/types"
+	"github.com/oam-dev/kubevela/pkg/appfile/dryrun"
 	common2 "github.com/oam-dev/kubevela/pkg/utils/common"
 )
 
@@ -259,6 +261,26 @@ var _ = Describe("Testing dry-run", func() {
 		Expect(buff.String()).Should(ContainSubstring("workload.oam.dev/type: myworker"))
 	})
 
+	It("Testing dry-run offline with structured output", func() {
+		c := common2.Args{}
+		opt := DryRunCmdOptions{ApplicationFiles: []string{"test-data/dry-run/testing-dry-run-7.yaml"}, DefinitionFile: "test-data/dry-run/definitions/testing-worker-def.yaml", OfflineMode: true, Output: "json"}
+		buff, err := DryRunApplication(&opt, c, "", "")
+		Expect(err).Should(BeNil())
+		result := &dryrun.Result{}
+		Expect(json.Unmarshal(buff.Bytes(), result)).Should(Succeed())
+		Expect(result.Application).Should(Equal("testing-app"))
+		Expect(result.Manifests).Should(HaveLen(2))
+		for _, m := range result.Manifests {
+			Expect(m.Component).Should(Equal("testing-dryrun"))
+			Expect(m.Cluster).Should(Equal("local"))
+			Expect(m.Object.GetKind()).Should(Equal("Deployment"))
+		}
+		Expect(result.Manifests[0].Topology).Should(Equal("target-default"))
+		Expect(result.Manifests[0].Namespace).Should(Equal("default"))
+		Expect(result.Manifests[1].Topology).Should(Equal("target-prod"))
+		Expect(result.Manifests[1].Namespace).Should(Equal("prod"))
+	})
+
 	It("Testing dry-run with default application namespace", func() {
 		c := common2.Args{}
 		c.SetConfig(cfg)
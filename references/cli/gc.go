@@ -0,0 +1,155 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cli
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	apitypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/kubectl/pkg/util/i18n"
+	"k8s.io/kubectl/pkg/util/templates"
+
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1beta1"
+	"github.com/oam-dev/kubevela/apis/types"
+	velacmd "github.com/oam-dev/kubevela/pkg/cmd"
+	cmdutil "github.com/oam-dev/kubevela/pkg/cmd/util"
+	"github.com/oam-dev/kubevela/pkg/resourcekeeper"
+)
+
+// GCOptions options for vela gc command
+type GCOptions struct {
+	AppName   string
+	Namespace string
+	DryRun    bool
+}
+
+// Complete .
+func (opt *GCOptions) Complete(f velacmd.Factory, cmd *cobra.Command, args []string) {
+	if len(args) > 0 {
+		opt.AppName = args[0]
+	}
+	opt.Namespace = velacmd.GetNamespace(f, cmd)
+}
+
+// Validate validate if vela gc args are valid
+func (opt *GCOptions) Validate() error {
+	switch {
+	case opt.AppName == "":
+		return fmt.Errorf("no application provided for garbage collection")
+	case !opt.DryRun:
+		return fmt.Errorf("garbage collection is executed by the controller, only --dry-run is supported")
+	}
+	return nil
+}
+
+// Run vela gc
+func (opt *GCOptions) Run(f velacmd.Factory, cmd *cobra.Command) error {
+	ctx := cmd.Context()
+	app := &v1beta1.Application{}
+	if err := f.Client().Get(ctx, apitypes.NamespacedName{Namespace: opt.Namespace, Name: opt.AppName}, app); err != nil {
+		return fmt.Errorf("failed to get application %s/%s: %w", opt.Namespace, opt.AppName, err)
+	}
+	rk, err := resourcekeeper.NewResourceKeeper(ctx, f.Client(), app)
+	if err != nil {
+		return fmt.Errorf("failed to load resourcetrackers for application %s/%s: %w", opt.Namespace, opt.AppName, err)
+	}
+	report := &resourcekeeper.GCDryRunReport{}
+	if _, _, err = rk.GarbageCollect(ctx, resourcekeeper.DryRunGCOption{Report: report}); err != nil {
+		return fmt.Errorf("failed to dry-run garbage collection for application %s/%s: %w", opt.Namespace, opt.AppName, err)
+	}
+	printGCDryRunReport(cmd.OutOrStdout(), app, report)
+	return nil
+}
+
+func printGCDryRunReport(out io.Writer, app *v1beta1.Application, report *resourcekeeper.GCDryRunReport) {
+	if len(report.Resources) == 0 {
+		_, _ = fmt.Fprintf(out, "No resource will be recycled for application %s/%s.\n", app.Namespace, app.Name)
+		return
+	}
+	_, _ = fmt.Fprintf(out, "Outdated resourcetrackers: %s\n", strings.Join(report.InactiveResourceTrackers, ", "))
+	groups := report.GroupByCluster()
+	var clusters []string
+	for cluster := range groups {
+		clusters = append(clusters, cluster)
+	}
+	sort.Strings(clusters)
+	for _, cluster := range clusters {
+		_, _ = fmt.Fprintf(out, "\nCluster: %s\n", cluster)
+		table := newUITable()
+		table.AddRow("RESOURCE", "NAMESPACE", "COMPONENT", "ACTION", "RESOURCETRACKER", "RULE", "REASON")
+		for _, res := range groups[cluster] {
+			rule := res.Rule
+			if rule == "" {
+				rule = "-"
+			}
+			table.AddRow(fmt.Sprintf("%s/%s", res.Kind, res.Name), res.Namespace, res.Component, res.Action, res.ResourceTracker, rule, res.Reason)
+		}
+		_, _ = fmt.Fprintln(out, table.String())
+	}
+}
+
+var (
+	gcLong = templates.LongDesc(i18n.T(`
+		Garbage collect application resources
+
+		Resources that are no longer used by the latest version of an application
+		are recycled by the controller according to the garbage-collect policy.
+		Use the --dry-run parameter to preview, per cluster, which managed resources
+		would be deleted or orphaned, together with the outdated resourcetracker
+		and the garbage-collect rule that lead to the action. The dry-run never
+		changes the resourcetrackers or the managed resources.
+	`))
+
+	gcExample = templates.Examples(i18n.T(`
+		# Preview the resources to be recycled for an application
+		vela gc my-app --dry-run
+
+		# Preview the resources to be recycled for an application in a namespace
+		vela gc my-app -n example --dry-run
+	`))
+)
+
+// NewGCCommand garbage collect application resources
+func NewGCCommand(f velacmd.Factory, order string) *cobra.Command {
+	o := &GCOptions{}
+	cmd := &cobra.Command{
+		Use:                   "gc",
+		DisableFlagsInUseLine: true,
+		Short:                 i18n.T("Preview the garbage collection of an application."),
+		Long:                  gcLong,
+		Example:               gcExample,
+		Args:                  cobra.MaximumNArgs(1),
+		Annotations: map[string]string{
+			types.TagCommandOrder: order,
+			types.TagCommandType:  types.TypeApp,
+		},
+		Run: func(cmd *cobra.Command, args []string) {
+			o.Complete(f, cmd, args)
+			cmdutil.CheckErr(o.Validate())
+			cmdutil.CheckErr(o.Run(f, cmd))
+		},
+	}
+	cmd.Flags().BoolVarP(&o.DryRun, "dry-run", "", o.DryRun, "print the resources to be recycled without changing anything")
+	return velacmd.NewCommandBuilder(f, cmd).
+		WithNamespaceFlag().
+		WithResponsiveWriter().
+		Build()
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cli
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/common"
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1beta1"
+	"github.com/oam-dev/kubevela/pkg/resourcekeeper"
+)
+
+func TestGCOptionsValidate(t *testing.T) {
+	r := require.New(t)
+	r.Error((&GCOptions{DryRun: true}).Validate())
+	r.Error((&GCOptions{AppName: "app"}).Validate())
+	r.NoError((&GCOptions{AppName: "app", DryRun: true}).Validate())
+}
+
+func TestPrintGCDryRunReport(t *testing.T) {
+	r := require.New(t)
+	app := &v1beta1.Application{ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "default"}}
+	buf := bytes.NewBuffer(nil)
+	printGCDryRunReport(buf, app, &resourcekeeper.GCDryRunReport{})
+	r.Equal("No resource will be recycled for application default/app.\n", buf.String())
+
+	buf.Reset()
+	printGCDryRunReport(buf, app, &resourcekeeper.GCDryRunReport{
+		InactiveResourceTrackers: []string{"app-v1-default"},
+		Resources: []resourcekeeper.GCDryRunResource{{
+			ManagedResource: v1beta1.ManagedResource{
+				ClusterObjectReference: common.ClusterObjectReference{
+					Cluster:         "worker",
+					ObjectReference: corev1.ObjectReference{Kind: "ConfigMap", Name: "blue", Namespace: "default"},
+				},
+			},
+			Action:          resourcekeeper.GCDryRunActionDelete,
+			Reason:          "resourcetracker app-v1-default is outdated",
+			ResourceTracker: "app-v1-default",
+		}, {
+			ManagedResource: v1beta1.ManagedResource{
+				ClusterObjectReference: common.ClusterObjectReference{
+					ObjectReference: corev1.ObjectReference{Kind: "Secret", Name: "red", Namespace: "default"},
+				},
+			},
+			Action:          resourcekeeper.GCDryRunActionOrphan,
+			Reason:          "resourcetracker app-v1-default is outdated, propagation is orphan",
+			ResourceTracker: "app-v1-default",
+			Rule:            "garbage-collect.rules[0] (strategy: onAppUpdate)",
+		}},
+	})
+	out := buf.String()
+	r.Contains(out, "Outdated resourcetrackers: app-v1-default")
+	r.Contains(out, "Cluster: local")
+	r.Contains(out, "Cluster: worker")
+	r.Less(bytes.Index(buf.Bytes(), []byte("Cluster: local")), bytes.Index(buf.Bytes(), []byte("Cluster: worker")))
+	r.Contains(out, "ConfigMap/blue")
+	r.Contains(out, "Secret/red")
+	r.Contains(out, "garbage-collect.rules[0] (strategy: onAppUpdate)")
+}
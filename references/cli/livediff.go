@@ -44,8 +44,17 @@ type LiveDiffCmdOptions struct {
 	Revision          string
 	SecondaryRevision string
 	Context           int
+	Output            string
+	Live              bool
 }
 
+var (
+	// errLiveDiffChanges is returned when live-diff finds changes, so that the command exits with non-zero code
+	errLiveDiffChanges = errors.New("changes found")
+	// errLiveDiffFailures is returned when live-diff fails to compare some resources, the changes of them are unknown
+	errLiveDiffFailures = errors.New("failed to compare some resources")
+)
+
 // NewLiveDiffCommand creates `live-diff` command
 func NewLiveDiffCommand(c common.Args, order string, ioStreams cmdutil.IOStreams) *cobra.Command {
 	o := &LiveDiffCmdOptions{IOStreams: ioStreams}
@@ -54,7 +63,10 @@ func NewLiveDiffCommand(c common.Args, order string, ioStreams cmdutil.IOStreams
 		Use:                   "live-diff",
 		DisableFlagsInUseLine: true,
 		Short:                 "Compare application and revisions.",
-		Long:                  "Compare application and revisions.",
+		Long: "Compare application and revisions.\n\n" +
+			"The command exits with non-zero code if any change is found or any resource fails to be compared, in both " +
+			"the text and the structured output, so that pipelines can gate on it. Scripts relying on the text output " +
+			"exiting with zero code on changes should tolerate the exit code, e.g. `vela live-diff my-app || true`.",
 		Example: "# compare the current application and the running revision\n" +
 			"> vela live-diff my-app\n" +
 			"# compare the current application and the specified revision\n" +
@@ -62,7 +74,11 @@ func NewLiveDiffCommand(c common.Args, order string, ioStreams cmdutil.IOStreams
 			"# compare two application revisions\n" +
 			"> vela live-diff --revision my-app-v1,my-app-v2\n" +
 			"# compare the application file and the specified revision\n" +
-			"> vela live-diff -f my-app.yaml -r my-app-v1 --context 10",
+			"> vela live-diff -f my-app.yaml -r my-app-v1 --context 10\n" +
+			"# output the diff in JSON\n" +
+			"> vela live-diff my-app -o json\n" +
+			"# compare the application file with the live objects in all the placement clusters\n" +
+			"> vela live-diff -f my-app.yaml --live",
 		Annotations: map[string]string{
 			types.TagCommandOrder: order,
 			types.TagCommandType:  types.TypeApp,
@@ -84,11 +100,26 @@ func NewLiveDiffCommand(c common.Args, order string, ioStreams cmdutil.IOStreams
 			if err = o.loadAndValidate(args); err != nil {
 				return err
 			}
-			buff, err := LiveDiffApplication(o, c)
+			diffResult, err := o.diff(c)
+			if err != nil {
+				return err
+			}
+			buff, err := o.printDiffResult(diffResult)
 			if err != nil {
 				return err
 			}
-			cmd.Println(buff.String())
+			if o.Output != "" {
+				// the structured output goes to stdout to be consumed by pipelines
+				fmt.Fprint(cmd.OutOrStdout(), buff.String())
+			} else {
+				cmd.Println(buff.String())
+			}
+			if diffResult.HasErrors() {
+				return errLiveDiffFailures
+			}
+			if diffResult.HasChanges() {
+				return errLiveDiffChanges
+			}
 			return nil
 		},
 	}
@@ -97,78 +128,103 @@ func NewLiveDiffCommand(c common.Args, order string, ioStreams cmdutil.IOStreams
 	cmd.Flags().StringVarP(&o.DefinitionFile, "definition", "d", "", "specify a file or directory containing capability definitions, they will only be used in dry-run rather than applied to K8s cluster")
 	cmd.Flags().StringVarP(&o.Revision, "revision", "r", "", "specify one or two application revision name(s), by default, it will compare with the latest revision")
 	cmd.Flags().IntVarP(&o.Context, "context", "c", -1, "output number lines of context around changes, by default show all unchanged lines")
+	cmd.Flags().StringVarP(&o.Output, "output", "o", "", "output the diff entries with field patches in the given format. One of: (json, yaml)")
+	cmd.Flags().BoolVarP(&o.Live, "live", "", false, "compare with the live objects in the placement clusters through server-side dry-run instead of the application revision, the fields changed manually in the clusters are reported as drifts")
 	addNamespaceAndEnvArg(cmd)
 	return cmd
 }
 
 // LiveDiffApplication can return user what would change if upgrade an application.
 func LiveDiffApplication(cmdOption *LiveDiffCmdOptions, c common.Args) (bytes.Buffer, error) {
+	diffResult, err := cmdOption.diff(c)
+	if err != nil {
+		return bytes.Buffer{}, err
+	}
+	return cmdOption.printDiffResult(diffResult)
+}
+
+// printDiffResult prints the diff result as report, or in the structured output format
+func (o *LiveDiffCmdOptions) printDiffResult(diffResult *dryrun.DiffEntry) (bytes.Buffer, error) {
 	buff := bytes.Buffer{}
+	if o.Output != "" {
+		out, err := printObj(o.Output, diffResult)
+		if err != nil {
+			return buff, err
+		}
+		buff.WriteString(out)
+		return buff, nil
+	}
+	reportDiffOpt := dryrun.NewReportDiffOption(o.Context, &buff)
+	reportDiffOpt.PrintDiffReport(diffResult)
+	return buff, nil
+}
 
+// diff calculates the diff between the application and the revision(s)
+func (o *LiveDiffCmdOptions) diff(c common.Args) (*dryrun.DiffEntry, error) {
 	newClient, err := c.GetClient()
 	if err != nil {
-		return buff, err
+		return nil, err
 	}
 	var objs []*unstructured.Unstructured
-	if cmdOption.DefinitionFile != "" {
-		objs, err = ReadDefinitionsFromFile(cmdOption.DefinitionFile, cmdOption.IOStreams)
+	if o.DefinitionFile != "" {
+		objs, err = ReadDefinitionsFromFile(o.DefinitionFile, o.IOStreams)
 		if err != nil {
-			return buff, err
+			return nil, err
 		}
 	}
 	config, err := c.GetConfig()
 	if err != nil {
-		return buff, err
+		return nil, err
 	}
 	liveDiffOption := dryrun.NewLiveDiffOption(newClient, config, objs)
-	if cmdOption.ApplicationFile == "" {
-		return cmdOption.renderlessDiff(newClient, liveDiffOption)
+	if o.Live {
+		return o.clusterDiff(newClient, liveDiffOption)
+	}
+	if o.ApplicationFile == "" {
+		return o.renderlessDiff(newClient, liveDiffOption)
 	}
 
-	app, err := readApplicationFromFile(cmdOption.ApplicationFile)
+	app, err := readApplicationFromFile(o.ApplicationFile)
 	if err != nil {
-		return buff, errors.WithMessagef(err, "read application file: %s", cmdOption.ApplicationFile)
+		return nil, errors.WithMessagef(err, "read application file: %s", o.ApplicationFile)
 	}
 	if app.Namespace == "" {
-		app.SetNamespace(cmdOption.Namespace)
+		app.SetNamespace(o.Namespace)
 	}
 
 	appRevision := &v1beta1.ApplicationRevision{}
-	if cmdOption.Revision != "" {
+	if o.Revision != "" {
 		// get the Revision if user specifies
 		if err := newClient.Get(context.Background(),
-			client.ObjectKey{Name: cmdOption.Revision, Namespace: app.Namespace}, appRevision); err != nil {
-			return buff, errors.Wrapf(err, "cannot get application Revision %q", cmdOption.Revision)
+			client.ObjectKey{Name: o.Revision, Namespace: app.Namespace}, appRevision); err != nil {
+			return nil, errors.Wrapf(err, "cannot get application Revision %q", o.Revision)
 		}
 	} else {
 		// get the latest Revision of the application
 		livingApp := &v1beta1.Application{}
 		if err := newClient.Get(context.Background(),
 			client.ObjectKey{Name: app.Name, Namespace: app.Namespace}, livingApp); err != nil {
-			return buff, errors.Wrapf(err, "cannot get application %q", app.Name)
+			return nil, errors.Wrapf(err, "cannot get application %q", app.Name)
 		}
 		if livingApp.Status.LatestRevision != nil {
 			latestRevName := livingApp.Status.LatestRevision.Name
 			if err := newClient.Get(context.Background(),
 				client.ObjectKey{Name: latestRevName, Namespace: app.Namespace}, appRevision); err != nil {
-				return buff, errors.Wrapf(err, "cannot get application Revision %q", cmdOption.Revision)
+				return nil, errors.Wrapf(err, "cannot get application Revision %q", o.Revision)
 			}
 		} else {
 			// .status.latestRevision is nil, that means the app has not
 			// been rendered yet
-			return buff, fmt.Errorf("the application %q has no Revision in the cluster", app.Name)
+			return nil, fmt.Errorf("the application %q has no Revision in the cluster", app.Name)
 		}
 	}
 
 	diffResult, err := liveDiffOption.Diff(context.Background(), app, appRevision)
 	if err != nil {
-		return buff, errors.WithMessage(err, "cannot calculate diff")
+		return nil, errors.WithMessage(err, "cannot calculate diff")
 	}
 
-	reportDiffOpt := dryrun.NewReportDiffOption(cmdOption.Context, &buff)
-	reportDiffOpt.PrintDiffReport(diffResult)
-
-	return buff, nil
+	return diffResult, nil
 }
 
 func (o *LiveDiffCmdOptions) loadAndValidate(args []string) error {
@@ -195,17 +251,41 @@ func (o *LiveDiffCmdOptions) loadAndValidate(args []string) error {
 	if o.SecondaryRevision != "" && o.ApplicationFile != "" {
 		return errors.Errorf("cannot use application file and two revisions at the same time")
 	}
+	if o.Live && o.Revision != "" {
+		return errors.Errorf("cannot compare with the live objects and revisions at the same time")
+	}
 	return nil
 }
 
-func (o *LiveDiffCmdOptions) renderlessDiff(cli client.Client, option *dryrun.LiveDiffOption) (bytes.Buffer, error) {
+// clusterDiff compares the application, from file or in cluster, with the live objects in the placement clusters
+func (o *LiveDiffCmdOptions) clusterDiff(cli client.Client, option *dryrun.LiveDiffOption) (*dryrun.DiffEntry, error) {
+	ctx := context.Background()
+	app := &v1beta1.Application{}
+	if o.ApplicationFile != "" {
+		var err error
+		if app, err = readApplicationFromFile(o.ApplicationFile); err != nil {
+			return nil, errors.WithMessagef(err, "read application file: %s", o.ApplicationFile)
+		}
+		if app.Namespace == "" {
+			app.SetNamespace(o.Namespace)
+		}
+	} else if err := cli.Get(ctx, client.ObjectKey{Name: o.AppName, Namespace: o.Namespace}, app); err != nil {
+		return nil, errors.Wrapf(err, "cannot get application %s/%s", o.Namespace, o.AppName)
+	}
+	diffResult, err := option.DiffCluster(ctx, app)
+	if err != nil {
+		return nil, errors.WithMessage(err, "cannot calculate diff")
+	}
+	return diffResult, nil
+}
+
+func (o *LiveDiffCmdOptions) renderlessDiff(cli client.Client, option *dryrun.LiveDiffOption) (*dryrun.DiffEntry, error) {
 	var base, comparor dryrun.LiveDiffObject
 	ctx := context.Background()
-	var buf bytes.Buffer
 	if o.AppName != "" {
 		app := &v1beta1.Application{}
 		if err := cli.Get(ctx, client.ObjectKey{Name: o.AppName, Namespace: o.Namespace}, app); err != nil {
-			return buf, errors.Wrapf(err, "cannot get application %s/%s", o.Namespace, o.AppName)
+			return nil, errors.Wrapf(err, "cannot get application %s/%s", o.Namespace, o.AppName)
 		}
 		if app.Namespace == "" {
 			app.SetNamespace(o.Namespace)
@@ -213,14 +293,14 @@ func (o *LiveDiffCmdOptions) renderlessDiff(cli client.Client, option *dryrun.Li
 		base = dryrun.LiveDiffObject{Application: app}
 		if o.Revision == "" {
 			if app.Status.LatestRevision == nil {
-				return buf, errors.Errorf("no latest application revision available for application %s/%s", o.Namespace, o.AppName)
+				return nil, errors.Errorf("no latest application revision available for application %s/%s", o.Namespace, o.AppName)
 			}
 			o.Revision = app.Status.LatestRevision.Name
 		}
 	}
 	rev, secondaryRev := &v1beta1.ApplicationRevision{}, &v1beta1.ApplicationRevision{}
 	if err := cli.Get(ctx, client.ObjectKey{Name: o.Revision, Namespace: o.Namespace}, rev); err != nil {
-		return buf, errors.Wrapf(err, "cannot get application revision %s/%s", o.Namespace, o.Revision)
+		return nil, errors.Wrapf(err, "cannot get application revision %s/%s", o.Namespace, o.Revision)
 	}
 	if rev.Namespace == "" {
 		rev.SetNamespace(o.Namespace)
@@ -229,7 +309,7 @@ func (o *LiveDiffCmdOptions) renderlessDiff(cli client.Client, option *dryrun.Li
 		comparor = dryrun.LiveDiffObject{ApplicationRevision: rev}
 	} else {
 		if err := cli.Get(ctx, client.ObjectKey{Name: o.SecondaryRevision, Namespace: o.Namespace}, secondaryRev); err != nil {
-			return buf, errors.Wrapf(err, "cannot get application revision %s/%s", o.Namespace, o.SecondaryRevision)
+			return nil, errors.Wrapf(err, "cannot get application revision %s/%s", o.Namespace, o.SecondaryRevision)
 		}
 		if secondaryRev.Namespace == "" {
 			secondaryRev.SetNamespace(o.Namespace)
@@ -239,9 +319,7 @@ func (o *LiveDiffCmdOptions) renderlessDiff(cli client.Client, option *dryrun.Li
 	}
 	diffResult, err := option.RenderlessDiff(ctx, base, comparor)
 	if err != nil {
-		return buf, errors.WithMessage(err, "cannot calculate diff")
+		return nil, errors.WithMessage(err, "cannot calculate diff")
 	}
-	reportDiffOpt := dryrun.NewReportDiffOption(o.Context, &buf)
-	reportDiffOpt.PrintDiffReport(diffResult)
-	return buf, nil
+	return diffResult, nil
 }
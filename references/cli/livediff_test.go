@@ -0,0 +1,55 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cli
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/oam-dev/kubevela/pkg/appfile/dryrun"
+)
+
+func TestLiveDiffPrintDiffResult(t *testing.T) {
+	r := require.New(t)
+	diffResult := &dryrun.DiffEntry{Name: "app", Kind: dryrun.AppKind, Subs: []*dryrun.DiffEntry{{
+		Name:     "comp",
+		Kind:     dryrun.AppConfigCompKind,
+		DiffType: dryrun.ModifyDiff,
+		Patches:  []dryrun.FieldPatch{{Op: dryrun.PatchReplace, Path: "/spec/replicas", Value: 3, OldValue: 1}},
+	}}}
+
+	o := &LiveDiffCmdOptions{Output: "json", Context: -1}
+	buff, err := o.printDiffResult(diffResult)
+	r.NoError(err)
+	printed := &dryrun.DiffEntry{}
+	r.NoError(json.Unmarshal(buff.Bytes(), printed))
+	r.Equal("comp", printed.Subs[0].Name)
+	r.Equal(dryrun.ModifyDiff, printed.Subs[0].DiffType)
+	r.Equal("/spec/replicas", printed.Subs[0].Patches[0].Path)
+	r.True(printed.HasChanges())
+
+	o.Output = "yaml"
+	buff, err = o.printDiffResult(diffResult)
+	r.NoError(err)
+	r.Contains(buff.String(), "op: replace")
+
+	o.Output = "xml"
+	_, err = o.printDiffResult(diffResult)
+	r.Error(err)
+}
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"strings"
 	"time"
 
 	"cuelang.org/go/cue"
@@ -31,6 +32,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	"golang.org/x/term"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	pkgtypes "k8s.io/apimachinery/pkg/types"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -51,6 +53,8 @@ import (
 	"github.com/oam-dev/kubevela/pkg/utils/common"
 	types2 "github.com/oam-dev/kubevela/pkg/utils/types"
 	cmdutil "github.com/oam-dev/kubevela/pkg/utils/util"
+	"github.com/oam-dev/kubevela/pkg/workflow/providers/query"
+	"github.com/oam-dev/kubevela/pkg/workflow/step"
 	"github.com/oam-dev/kubevela/references/appfile"
 	references "github.com/oam-dev/kubevela/references/common"
 )
@@ -84,6 +88,10 @@ func NewAppStatusCommand(c common.Args, order string, ioStreams cmdutil.IOStream
   # Show detailed info in tree
   vela status first-vela-app --tree --detail --detail-format list
 
+  # Show the dependency graph of the components, in text or in DOT
+  vela status first-vela-app --graph
+  vela status first-vela-app --graph --graph-format dot | dot -Tpng -o graph.png
+
   # Show pod list
   vela status first-vela-app --pod
   vela status first-vela-app --pod --component express-server --cluster local
@@ -121,6 +129,9 @@ func NewAppStatusCommand(c common.Args, order string, ioStreams cmdutil.IOStream
 			if printTree, err := cmd.Flags().GetBool("tree"); err == nil && printTree {
 				return printApplicationTree(c, cmd, appName, namespace)
 			}
+			if printGraph, err := cmd.Flags().GetBool("graph"); err == nil && printGraph {
+				return printApplicationGraph(c, cmd, appName, namespace)
+			}
 			if printPod, err := cmd.Flags().GetBool("pod"); err == nil && printPod {
 				component, _ := cmd.Flags().GetString("component")
 				cluster, _ := cmd.Flags().GetString("cluster")
@@ -176,6 +187,8 @@ func NewAppStatusCommand(c common.Args, order string, ioStreams cmdutil.IOStream
 	cmd.Flags().StringP("cluster", "", "", "filter the endpoints or pods by cluster name")
 	cmd.Flags().BoolP("tree", "t", false, "display the application resources into tree structure")
 	cmd.Flags().BoolP("pod", "", false, "show pod list of the application")
+	cmd.Flags().BoolP("graph", "", false, "display the dependency graph of the application components, inferred from dependsOn and inputs/outputs")
+	cmd.Flags().StringP("graph-format", "", "text", "the format for displaying the dependency graph, must be used with --graph. Can be one of text, dot.")
 	cmd.Flags().BoolVarP(&detail, "detail", "d", false, "display more details in the application like input/output data in context. Note that if you want to show the realtime details of application resources, please use it with --tree")
 	cmd.Flags().StringP("detail-format", "", "inline", "the format for displaying details, must be used with --detail. Can be one of inline, wide, list, table, raw.")
 	cmd.Flags().StringVarP(&outputFormat, "output", "o", "", "raw Application output format. One of: (json, yaml, jsonpath)")
@@ -200,14 +213,33 @@ func printAppStatus(_ context.Context, c client.Client, ioStreams cmdutil.IOStre
 	table.AddRow("  Namespace:", namespace)
 	table.AddRow("  Created at:", app.CreationTimestamp.String())
 	table.AddRow("  Healthy:", healthStatusEmoji)
+	table.AddRow("  Health Status:", getHealthStatusColor(app.Status.GetHealthStatus()).Sprint(app.Status.GetHealthStatus()))
 	table.AddRow("  Details:", getAppPhaseColor(app.Status.Phase).Sprint(app.Status.Phase))
 	cmd.Printf("%s\n\n", table.String())
+	printPlacementRevisions(cmd, app)
 	if err := printWorkflowStatus(c, ioStreams, appName, namespace, detail); err != nil {
 		return err
 	}
 	return loopCheckStatus(c, ioStreams, appName, namespace)
 }
 
+// printPlacementRevisions prints the revision deployed to each cluster if any cluster is pinned to another revision
+func printPlacementRevisions(cmd *cobra.Command, app *v1beta1.Application) {
+	pinned := false
+	for _, pr := range app.Status.PlacementRevisions {
+		pinned = pinned || pr.Pinned
+	}
+	if !pinned {
+		return
+	}
+	cmd.Printf("Revision per Placement:\n\n")
+	table := newUITable().AddRow("  CLUSTER", "REVISION", "PINNED")
+	for _, pr := range app.Status.PlacementRevisions {
+		table.AddRow("  "+pr.Cluster, pr.Revision, pr.Pinned)
+	}
+	cmd.Printf("%s\n\n", table.String())
+}
+
 func formatEndpoints(endpoints []types2.ServiceEndpoint) [][]string {
 	var result [][]string
 	result = append(result, []string{"Cluster", "Component", "Ref(Kind/Namespace/Name)", "Endpoint", "Inner"})
@@ -371,7 +403,7 @@ func loopCheckStatus(c client.Client, ioStreams cmdutil.IOStreams, appName strin
 		if !comp.Healthy {
 			healthEmoji = emojiFail
 		}
-		ioStreams.Infof("    Health: %s\n", healthEmoji)
+		ioStreams.Infof("    Health: %s %s\n", healthEmoji, getHealthStatusColor(comp.GetHealthStatus()).Sprint(comp.GetHealthStatus()))
 		if comp.Message != "" {
 			ioStreams.Infof("      Message: %s\n", comp.Message)
 		}
@@ -399,7 +431,7 @@ func loopCheckStatus(c client.Client, ioStreams cmdutil.IOStreams, appName strin
 			if !tr.Healthy {
 				trHealthEmoji = emojiFail
 			}
-			ioStreams.Infof("      %s: %s\n", "Health", trHealthEmoji)
+			ioStreams.Infof("      %s: %s %s\n", "Health", trHealthEmoji, getHealthStatusColor(tr.GetHealthStatus()).Sprint(tr.GetHealthStatus()))
 			if tr.Message != "" {
 				ioStreams.Infof("        %s: %s\n", "Message", tr.Message)
 			}
@@ -482,6 +514,19 @@ func getAppPhaseColor(appPhase commontypes.ApplicationPhase) *color.Color {
 	}
 }
 
+func getHealthStatusColor(status commontypes.HealthStatus) *color.Color {
+	switch status {
+	case commontypes.HealthStatusHealthy:
+		return green
+	case commontypes.HealthStatusSuspended:
+		return blue
+	case commontypes.HealthStatusDegraded, commontypes.HealthStatusMissing:
+		return red
+	default:
+		return yellow
+	}
+}
+
 func getAppHealth(app *v1beta1.Application) bool {
 	for _, s := range app.Status.Services {
 		if !s.Healthy {
@@ -525,7 +570,7 @@ func printApplicationTree(c common.Args, cmd *cobra.Command, appName string, app
 	var placements []v1alpha1.PlacementDecision
 	af, err := pkgappfile.NewApplicationParser(cli).GenerateAppFile(context.Background(), app)
 	if err == nil {
-		placements, _ = policy.GetPlacementsFromTopologyPolicies(context.Background(), cli, app.GetNamespace(), af.Policies, true)
+		placements, _ = policy.GetPlacementsFromTopologyPolicies(context.Background(), cli, app.GetNamespace(), af.Policies, true, app)
 	}
 	format, _ := cmd.Flags().GetString("detail-format")
 	var maxWidth *int
@@ -541,10 +586,95 @@ func printApplicationTree(c common.Args, cmd *cobra.Command, appName string, app
 		}
 		options.DetailRetriever = msgRetriever
 	}
+	// the custom health rules are loaded so that the health of the custom resources can be assessed, the tree is still
+	// printed without the health if the rules cannot be loaded, for example, the user has no access to the configmaps
+	if err = query.MergeCustomRules(ctx, cli); err != nil {
+		_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "Warning: failed to load custom resource rules: %s\n", err.Error())
+	} else {
+		options.HealthRetriever = func(mr *v1beta1.ManagedResource) (string, error) {
+			return retrieveResourceHealth(ctx, cli, mr)
+		}
+	}
 	options.PrintResourceTree(cmd.OutOrStdout(), placements, currentRT, historyRTs)
 	return nil
 }
 
+// retrieveResourceHealth assesses the health of the managed resource in the cluster. Only the resources matched by the
+// custom health rules are fetched, the health of the others is left empty to avoid getting every resource.
+func retrieveResourceHealth(ctx context.Context, cli client.Client, mr *v1beta1.ManagedResource) (string, error) {
+	if !query.HasCustomHealthRule(mr.GroupVersionKind()) {
+		return "", nil
+	}
+	obj := mr.ToUnstructured()
+	if err := cli.Get(multicluster.ContextWithClusterName(ctx, mr.Cluster), mr.NamespacedName(), obj); err != nil {
+		if kerrors.IsNotFound(err) {
+			return string(commontypes.HealthStatusMissing), nil
+		}
+		return "", err
+	}
+	status, err := query.CheckResourceStatus(*obj)
+	if err != nil {
+		return "", err
+	}
+	return string(status.Status), nil
+}
+
+func printApplicationGraph(c common.Args, cmd *cobra.Command, appName string, appNs string) error {
+	cli, err := c.GetClient()
+	if err != nil {
+		return err
+	}
+	app, err := loadRemoteApplication(cli, appNs, appName)
+	if err != nil {
+		return err
+	}
+	af, err := pkgappfile.NewApplicationParser(cli).GenerateAppFile(context.Background(), app)
+	if err != nil {
+		return errors.Wrapf(err, "failed to parse application %s", appName)
+	}
+	format, _ := cmd.Flags().GetString("graph-format")
+	return printComponentGraph(cmd.OutOrStdout(), app, af.WorkflowSteps, format)
+}
+
+// printComponentGraph prints the dependency graph of the application components in text or in DOT
+func printComponentGraph(out io.Writer, app *v1beta1.Application, steps []workflowv1alpha1.WorkflowStep, format string) error {
+	graph, err := step.BuildComponentGraph(app.Spec.Components, steps)
+	if err != nil {
+		return err
+	}
+	switch format {
+	case "dot":
+		_, err = fmt.Fprint(out, graph.DOT(app.Name))
+		return err
+	case "text":
+		table := newUITable().AddRow("COMPONENT", "DEPENDS ON", "INPUTS")
+		for _, comp := range graph.Components {
+			var deps, inputs []string
+			for _, dep := range graph.Dependencies {
+				if dep.Component != comp {
+					continue
+				}
+				deps = append(deps, dep.Dependency)
+				for _, input := range dep.Inputs {
+					inputs = append(inputs, fmt.Sprintf("%s(%s)", input, dep.Dependency))
+				}
+			}
+			table.AddRow(comp, strings.Join(deps, ","), strings.Join(inputs, ","))
+		}
+		if _, err = fmt.Fprintln(out, table.String()); err != nil {
+			return err
+		}
+		for _, input := range graph.DanglingInputs {
+			if _, err = fmt.Fprintf(out, "Warning: %s\n", input); err != nil {
+				return err
+			}
+		}
+		return nil
+	default:
+		return errors.Errorf("unknown graph format %s, must be one of text, dot", format)
+	}
+}
+
 // printRawApplication prints raw Application in yaml/json/jsonpath (without managedFields).
 func printRawApplication(ctx context.Context, c common.Args, format string, out io.Writer, ns, appName string) error {
 	var err error
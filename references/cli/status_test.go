@@ -0,0 +1,125 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cli
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	workflowv1alpha1 "github.com/kubevela/workflow/api/v1alpha1"
+
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/common"
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1beta1"
+	"github.com/oam-dev/kubevela/apis/types"
+	"github.com/oam-dev/kubevela/pkg/oam"
+	common2 "github.com/oam-dev/kubevela/pkg/utils/common"
+	"github.com/oam-dev/kubevela/pkg/workflow/providers/query"
+)
+
+func TestPrintComponentGraph(t *testing.T) {
+	r := require.New(t)
+	app := &v1beta1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: "app"},
+		Spec: v1beta1.ApplicationSpec{Components: []common.ApplicationComponent{
+			{Name: "db", Outputs: workflowv1alpha1.StepOutputs{{Name: "db-host", ValueFrom: "output.metadata.name"}}},
+			{Name: "web", DependsOn: []string{"db"}, Inputs: workflowv1alpha1.StepInputs{
+				{From: "db-host", ParameterKey: "host"},
+				{From: "channel", ParameterKey: "channel"},
+			}},
+		}},
+	}
+	steps := []workflowv1alpha1.WorkflowStep{{
+		WorkflowStepBase: workflowv1alpha1.WorkflowStepBase{Name: "notify", Type: "notification", Outputs: workflowv1alpha1.StepOutputs{{Name: "channel", ValueFrom: "output"}}},
+	}}
+
+	buf := &bytes.Buffer{}
+	r.NoError(printComponentGraph(buf, app, steps, "text"))
+	r.Regexp(`COMPONENT\s+DEPENDS ON\s+INPUTS`, buf.String())
+	r.Regexp(`web\s+db\s+db-host\(db\)`, buf.String())
+
+	buf.Reset()
+	r.NoError(printComponentGraph(buf, app, steps, "dot"))
+	r.Contains(buf.String(), `digraph "app" {`)
+	r.Contains(buf.String(), `"db" -> "web" [label="db-host"];`)
+
+	r.ErrorContains(printComponentGraph(buf, app, steps, "yaml"), "unknown graph format yaml")
+
+	buf.Reset()
+	r.NoError(printComponentGraph(buf, app, nil, "text"))
+	r.Contains(buf.String(), "Warning: input channel of component web is not the output of any component or workflow step")
+}
+
+func TestRetrieveResourceHealth(t *testing.T) {
+	r := require.New(t)
+	ctx := context.Background()
+	rules := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "health-rules",
+			Namespace: types.DefaultKubeVelaNS,
+			Labels:    map[string]string{oam.LabelResourceRules: "true"},
+		},
+		Data: map[string]string{"health": `
+- group: apps
+  kind: Deployment
+  cel:
+    health: 'has(object.status.readyReplicas) && object.status.readyReplicas == object.spec.replicas'
+`},
+	}
+	deploy := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"},
+		Spec:       appsv1.DeploymentSpec{Replicas: ptr.To(int32(1))},
+	}
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"}}
+	var gets []string
+	cli := fake.NewClientBuilder().WithScheme(common2.Scheme).WithObjects(rules, deploy, secret).WithInterceptorFuncs(interceptor.Funcs{
+		Get: func(ctx context.Context, c client.WithWatch, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+			gets = append(gets, obj.GetObjectKind().GroupVersionKind().Kind)
+			return c.Get(ctx, key, obj, opts...)
+		},
+	}).Build()
+	r.NoError(query.MergeCustomRules(ctx, cli))
+	defer func() {
+		r.NoError(query.MergeCustomRules(ctx, fake.NewClientBuilder().Build()))
+	}()
+
+	newManagedResource := func(apiVersion, kind, name string) *v1beta1.ManagedResource {
+		return &v1beta1.ManagedResource{ClusterObjectReference: common.ClusterObjectReference{
+			ObjectReference: corev1.ObjectReference{APIVersion: apiVersion, Kind: kind, Namespace: "default", Name: name},
+		}}
+	}
+	health, err := retrieveResourceHealth(ctx, cli, newManagedResource("apps/v1", "Deployment", "web"))
+	r.NoError(err)
+	r.Equal("Progressing", health)
+	health, err = retrieveResourceHealth(ctx, cli, newManagedResource("apps/v1", "Deployment", "missing"))
+	r.NoError(err)
+	r.Equal("Missing", health)
+	// the resource without custom health rules is not fetched
+	health, err = retrieveResourceHealth(ctx, cli, newManagedResource("v1", "Secret", "web"))
+	r.NoError(err)
+	r.Equal("", health)
+	r.Equal([]string{"Deployment", "Deployment"}, gets)
+}
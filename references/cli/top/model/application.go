@@ -35,6 +35,7 @@ type Application struct {
 	name         string
 	namespace    string
 	phase        string
+	health       string
 	service      string
 	workflow     string
 	workflowMode string
@@ -48,7 +49,7 @@ type ApplicationList []Application
 func (l ApplicationList) ToTableBody() [][]string {
 	data := make([][]string, len(l))
 	for index, app := range l {
-		data[index] = []string{app.name, app.namespace, app.phase, app.workflowMode, app.workflow, app.service, app.createTime}
+		data[index] = []string{app.name, app.namespace, app.phase, app.health, app.workflowMode, app.workflow, app.service, app.createTime}
 	}
 	return data
 }
@@ -64,6 +65,7 @@ func ListApplications(ctx context.Context, c client.Client) (ApplicationList, er
 	appList := make(ApplicationList, len(apps.Items))
 	for index, app := range apps.Items {
 		appList[index] = Application{name: app.Name, namespace: app.Namespace, phase: string(app.Status.Phase), createTime: app.CreationTimestamp.String()}
+		appList[index].health = string(app.Status.GetHealthStatus())
 		appList[index].service = serviceNum(app)
 		appList[index].workflow = workflowStepNum(app)
 		appList[index].workflowMode = workflowMode(app)
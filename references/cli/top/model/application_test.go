@@ -80,21 +80,21 @@ func TestApplicationList_ToTableBody(t *testing.T) {
 		{
 			name: "single item list",
 			list: ApplicationList{
-				{name: "app1", namespace: "ns1", phase: "running", workflowMode: "DAG", workflow: "1/1", service: "1/1", createTime: "now"},
+				{name: "app1", namespace: "ns1", phase: "running", health: "Healthy", workflowMode: "DAG", workflow: "1/1", service: "1/1", createTime: "now"},
 			},
 			expected: [][]string{
-				{"app1", "ns1", "running", "DAG", "1/1", "1/1", "now"},
+				{"app1", "ns1", "running", "Healthy", "DAG", "1/1", "1/1", "now"},
 			},
 		},
 		{
 			name: "multiple item list",
 			list: ApplicationList{
-				{name: "app1", namespace: "ns1", phase: "running", workflowMode: "DAG", workflow: "1/1", service: "1/1", createTime: "now"},
-				{name: "app2", namespace: "ns2", phase: "failed", workflowMode: "StepByStep", workflow: "0/1", service: "0/1", createTime: "then"},
+				{name: "app1", namespace: "ns1", phase: "running", health: "Healthy", workflowMode: "DAG", workflow: "1/1", service: "1/1", createTime: "now"},
+				{name: "app2", namespace: "ns2", phase: "failed", health: "Degraded", workflowMode: "StepByStep", workflow: "0/1", service: "0/1", createTime: "then"},
 			},
 			expected: [][]string{
-				{"app1", "ns1", "running", "DAG", "1/1", "1/1", "now"},
-				{"app2", "ns2", "failed", "StepByStep", "0/1", "0/1", "then"},
+				{"app1", "ns1", "running", "Healthy", "DAG", "1/1", "1/1", "now"},
+				{"app2", "ns2", "failed", "Degraded", "StepByStep", "0/1", "0/1", "then"},
 			},
 		},
 	}
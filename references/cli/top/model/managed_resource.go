@@ -19,6 +19,7 @@ package model
 import (
 	"context"
 
+	"k8s.io/klog/v2"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/oam-dev/kubevela/pkg/workflow/providers/legacy/query"
@@ -47,6 +48,10 @@ func ListManagedResource(ctx context.Context, c client.Client) (ManagedResourceL
 	if err != nil {
 		return ManagedResourceList{}, err
 	}
+	// load the custom health rules to assess the health of the custom resources, continue without them if failed
+	if err = query.MergeCustomRules(ctx, c); err != nil {
+		klog.Warningf("failed to load custom resource rules: %v", err)
+	}
 
 	list := make(ManagedResourceList, len(appResList))
 
@@ -85,7 +85,7 @@ func (v *ApplicationView) Update(timeoutCancel func()) {
 
 // BuildHeader render the header of table
 func (v *ApplicationView) BuildHeader() {
-	header := []string{"Name", "Namespace", "Phase", "WorkflowMode", "Workflow", "Service", "CreateTime"}
+	header := []string{"Name", "Namespace", "Phase", "Health", "WorkflowMode", "Workflow", "Service", "CreateTime"}
 	v.CommonResourceView.BuildHeader(header)
 }
 
@@ -119,6 +119,19 @@ func (v *ApplicationView) ColorizeStatusText(rowNum int) {
 		default:
 		}
 		v.Table.GetCell(i+1, 2).SetText(fmt.Sprintf("[%s::]%s", highlightColor, status))
+
+		health := v.Table.GetCell(i+1, 3).Text
+		switch common.HealthStatus(health) {
+		case common.HealthStatusHealthy:
+			highlightColor = v.app.config.Theme.Status.Healthy.String()
+		case common.HealthStatusDegraded, common.HealthStatusMissing:
+			highlightColor = v.app.config.Theme.Status.UnHealthy.String()
+		case common.HealthStatusProgressing, common.HealthStatusSuspended:
+			highlightColor = v.app.config.Theme.Status.Waiting.String()
+		default:
+			highlightColor = v.app.config.Theme.Table.Body.String()
+		}
+		v.Table.GetCell(i+1, 3).SetText(fmt.Sprintf("[%s::]%s", highlightColor, health))
 	}
 }
 
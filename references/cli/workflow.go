@@ -21,6 +21,8 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"strings"
+	"time"
 
 	"github.com/AlecAivazis/survey/v2"
 	"github.com/gosuri/uitable"
@@ -36,6 +38,9 @@ import (
 	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1alpha1"
 	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1beta1"
 	"github.com/oam-dev/kubevela/apis/types"
+	"github.com/oam-dev/kubevela/pkg/multicluster"
+	"github.com/oam-dev/kubevela/pkg/oam"
+	utilapp "github.com/oam-dev/kubevela/pkg/utils/app"
 	"github.com/oam-dev/kubevela/pkg/utils/common"
 	querytypes "github.com/oam-dev/kubevela/pkg/utils/types"
 	cmdutil "github.com/oam-dev/kubevela/pkg/utils/util"
@@ -64,6 +69,7 @@ func NewWorkflowCommand(c common.Args, order string, ioStreams cmdutil.IOStreams
 		NewWorkflowTerminateCommand(c, ioStreams, wargs),
 		NewWorkflowRestartCommand(c, ioStreams, wargs),
 		NewWorkflowRollbackCommand(c, ioStreams, wargs),
+		NewWorkflowHistoryCommand(c, ioStreams, wargs),
 		NewWorkflowLogsCommand(c, ioStreams, wargs),
 		NewWorkflowDebugCommand(c, ioStreams, wargs),
 		NewWorkflowListCommand(c, ioStreams, wargs),
@@ -84,6 +90,7 @@ func NewWorkflowSuspendCommand(_ common.Args, _ cmdutil.IOStreams, wargs *Workfl
 			if err := wargs.getWorkflowInstance(ctx, cmd, args); err != nil {
 				return err
 			}
+			ctx = wargs.operationContext(ctx)
 			if wargs.StepName != "" {
 				return wargs.StepOperator.Suspend(ctx, wargs.StepName)
 			}
@@ -93,6 +100,7 @@ func NewWorkflowSuspendCommand(_ common.Args, _ cmdutil.IOStreams, wargs *Workfl
 	addNamespaceAndEnvArg(cmd)
 	cmd.Flags().StringVarP(&wargs.StepName, "step", "s", "", "specify the step name in the workflow")
 	cmd.Flags().StringVarP(&wargs.Type, "type", "t", "", "the type of the resource, support: [app, workflow]")
+	addOperationReasonFlag(cmd, wargs)
 	return cmd
 }
 
@@ -109,6 +117,7 @@ func NewWorkflowResumeCommand(_ common.Args, _ cmdutil.IOStreams, wargs *Workflo
 			if err := wargs.getWorkflowInstance(ctx, cmd, args); err != nil {
 				return err
 			}
+			ctx = wargs.operationContext(ctx)
 			if wargs.StepName != "" {
 				return wargs.StepOperator.Resume(ctx, wargs.StepName)
 			}
@@ -118,6 +127,7 @@ func NewWorkflowResumeCommand(_ common.Args, _ cmdutil.IOStreams, wargs *Workflo
 	addNamespaceAndEnvArg(cmd)
 	cmd.Flags().StringVarP(&wargs.StepName, "step", "s", "", "specify the step name in the workflow")
 	cmd.Flags().StringVarP(&wargs.Type, "type", "t", "", "the type of the resource, support: [app, workflow]")
+	addOperationReasonFlag(cmd, wargs)
 	return cmd
 }
 
@@ -134,11 +144,12 @@ func NewWorkflowTerminateCommand(_ common.Args, _ cmdutil.IOStreams, wargs *Work
 			if err := wargs.getWorkflowInstance(ctx, cmd, args); err != nil {
 				return err
 			}
-			return wargs.Operator.Terminate(ctx)
+			return wargs.Operator.Terminate(wargs.operationContext(ctx))
 		},
 	}
 	addNamespaceAndEnvArg(cmd)
 	cmd.Flags().StringVarP(&wargs.Type, "type", "t", "", "the type of the resource, support: [app, workflow]")
+	addOperationReasonFlag(cmd, wargs)
 	return cmd
 }
 
@@ -154,6 +165,7 @@ func NewWorkflowRestartCommand(_ common.Args, _ cmdutil.IOStreams, wargs *Workfl
 			if err := wargs.getWorkflowInstance(ctx, cmd, args); err != nil {
 				return err
 			}
+			ctx = wargs.operationContext(ctx)
 			if wargs.StepName != "" {
 				return wargs.StepOperator.Restart(ctx, wargs.StepName)
 			}
@@ -163,26 +175,60 @@ func NewWorkflowRestartCommand(_ common.Args, _ cmdutil.IOStreams, wargs *Workfl
 	addNamespaceAndEnvArg(cmd)
 	cmd.Flags().StringVarP(&wargs.StepName, "step", "s", "", "specify the step name in the workflow")
 	cmd.Flags().StringVarP(&wargs.Type, "type", "t", "", "the type of the resource, support: [app, workflow]")
+	addOperationReasonFlag(cmd, wargs)
 	return cmd
 }
 
 // NewWorkflowRollbackCommand create workflow rollback command
 func NewWorkflowRollbackCommand(_ common.Args, _ cmdutil.IOStreams, wargs *WorkflowArgs) *cobra.Command {
+	var clusters []string
+	var clusterGroup, revision string
 	cmd := &cobra.Command{
-		Use:     "rollback",
-		Short:   "Rollback an application workflow to the latest revision.",
-		Long:    "Rollback an application workflow to the latest revision.",
-		Example: "vela workflow rollback <application-name>",
+		Use:   "rollback",
+		Short: "Rollback an application workflow to the latest revision.",
+		Long: "Rollback an application workflow to the latest revision. If clusters or cluster group are specified, " +
+			"the clusters of the multi-cluster application are rolled back to the revision specified by --to while " +
+			"the other clusters keep using the latest revision. Rolling back the clusters to the latest revision " +
+			"makes them follow the latest revision again.",
+		Example: "vela workflow rollback <application-name>\n" +
+			"vela workflow rollback <application-name> --cluster eu-1 --to <application-name>-v2",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := context.Background()
 			if err := wargs.getWorkflowInstance(ctx, cmd, args); err != nil {
 				return err
 			}
-			return wargs.Operator.Rollback(ctx)
+			ctx = wargs.operationContext(ctx)
+			if len(clusters) == 0 && clusterGroup == "" {
+				return wargs.Operator.Rollback(ctx)
+			}
+			return wargs.rollbackPlacements(ctx, cmd, clusters, clusterGroup, revision)
 		},
 	}
 	addNamespaceAndEnvArg(cmd)
 	cmd.Flags().StringVarP(&wargs.Type, "type", "t", "", "the type of the resource, support: [app, workflow]")
+	cmd.Flags().StringSliceVarP(&clusters, "cluster", "c", nil, "the clusters of the application to rollback")
+	cmd.Flags().StringVarP(&clusterGroup, "cluster-group", "g", "", "the cluster group whose members are rolled back")
+	cmd.Flags().StringVarP(&revision, "to", "", "", "the revision which the clusters are rolled back to, required if clusters or cluster group are specified")
+	addOperationReasonFlag(cmd, wargs)
+	return cmd
+}
+
+// NewWorkflowHistoryCommand create workflow history command
+func NewWorkflowHistoryCommand(_ common.Args, _ cmdutil.IOStreams, wargs *WorkflowArgs) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "history",
+		Short:   "Show the operation history of an application workflow.",
+		Long:    "Show the latest operations on an application workflow, including who operated it, the step operated and the reason.",
+		Example: "vela workflow history <application-name>",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			wargs.Type = instanceTypeApplication
+			if err := wargs.getWorkflowInstance(context.Background(), cmd, args); err != nil {
+				return err
+			}
+			return wargs.printOperationHistory(cmd)
+		},
+	}
+	addNamespaceAndEnvArg(cmd)
 	return cmd
 }
 
@@ -340,6 +386,7 @@ type WorkflowArgs struct {
 	Args             common.Args
 	StepName         string
 	StepID           string
+	Reason           string
 	ErrMap           map[string]string
 	App              *v1beta1.Application
 	WorkflowRun      *workflowv1alpha1.WorkflowRun
@@ -351,6 +398,84 @@ const (
 	instanceTypeWorkflowRun string = "workflow"
 )
 
+// addOperationReasonFlag add the flag for the reason of the workflow operation, which is recorded in the operation history
+func addOperationReasonFlag(cmd *cobra.Command, wargs *WorkflowArgs) {
+	cmd.Flags().StringVarP(&wargs.Reason, "reason", "", "", "the reason of the operation, recorded in the operation history of the application")
+}
+
+// operationContext injects the reason into the context of the workflow operation, so that it is recorded in the
+// operation history of the application. The operator is recorded by the application webhook.
+func (w *WorkflowArgs) operationContext(ctx context.Context) context.Context {
+	if w.Type != instanceTypeApplication || w.Reason == "" {
+		return ctx
+	}
+	return operation.ContextWithOperationReason(ctx, w.Reason)
+}
+
+func (w *WorkflowArgs) printOperationHistory(cmd *cobra.Command) error {
+	history := w.App.Status.OperationHistory
+	if len(history) == 0 {
+		cmd.Printf("No operation recorded for application %s.\n", w.App.Name)
+		return nil
+	}
+	table := newUITable().AddRow("TIME", "OPERATION", "STEP", "USER", "GROUPS", "REASON")
+	for _, record := range history {
+		table.AddRow(record.Time.Format(time.RFC3339), record.Operation, record.Step, record.User, strings.Join(record.Groups, ","), record.Reason)
+	}
+	cmd.Println(table.String())
+	return nil
+}
+
+// rollbackPlacements pins the clusters of the application, including the members of the cluster group, to the revision
+func (w *WorkflowArgs) rollbackPlacements(ctx context.Context, cmd *cobra.Command, clusters []string, clusterGroup string, revision string) error {
+	if w.Type != instanceTypeApplication {
+		return fmt.Errorf("clusters can only be rolled back for application")
+	}
+	if revision == "" {
+		return fmt.Errorf("please specify the revision to rollback the clusters by --to")
+	}
+	cli, err := w.Args.GetClient()
+	if err != nil {
+		return err
+	}
+	if clusterGroup != "" {
+		group, err := multicluster.GetClusterGroup(ctx, cli, clusterGroup)
+		if err != nil {
+			return errors.Wrapf(err, "failed to get cluster group %s", clusterGroup)
+		}
+		clusters = append(clusters, group.Clusters...)
+		if len(group.ClusterLabelSelector) > 0 {
+			vcs, err := multicluster.FindVirtualClustersByLabels(ctx, cli, group.ClusterLabelSelector)
+			if err != nil {
+				return errors.Wrapf(err, "failed to find clusters in cluster group %s", clusterGroup)
+			}
+			for _, vc := range vcs {
+				clusters = append(clusters, vc.Name)
+			}
+		}
+	}
+	app, err := utilapp.RollbackPlacementsWithRevision(ctx, cli, w.App.Name, w.App.Namespace, clusters, revision)
+	if err != nil {
+		return err
+	}
+	operation.RecordOperation(ctx, cli, app, operation.OperationRollback, "")
+	pins, err := oam.GetPlacementRevisions(app)
+	if err != nil {
+		return err
+	}
+	cmd.Printf("Successfully rollback clusters of application %s to revision %s.\n", app.Name, revision)
+	table := newUITable().AddRow("CLUSTER", "REVISION")
+	for _, cluster := range clusters {
+		if rev, pinned := pins[cluster]; pinned {
+			table.AddRow(cluster, rev)
+		} else {
+			table.AddRow(cluster, "latest")
+		}
+	}
+	cmd.Println(table.String())
+	return nil
+}
+
 func (w *WorkflowArgs) getWorkflowInstance(ctx context.Context, cmd *cobra.Command, args []string) error {
 	if len(args) < 1 {
 		return fmt.Errorf("please specify the name of application/workflow")
@@ -984,3 +984,26 @@ func TestWorkflowList(t *testing.T) {
 		})
 	}
 }
+
+func TestWorkflowPrintOperationHistory(t *testing.T) {
+	r := require.New(t)
+	buffer := bytes.NewBuffer(nil)
+	cmd := NewWorkflowHistoryCommand(initArgs(), cmdutil.IOStreams{Out: buffer}, &WorkflowArgs{})
+	cmd.SetOut(buffer)
+	app := &v1beta1.Application{ObjectMeta: metav1.ObjectMeta{Name: "app"}}
+	wargs := &WorkflowArgs{App: app}
+	r.NoError(wargs.printOperationHistory(cmd))
+	r.Contains(buffer.String(), "No operation recorded for application app.")
+
+	buffer.Reset()
+	app.Status.OperationHistory = []common.WorkflowOperationRecord{
+		{Operation: "suspend", Step: "deploy", User: "alice", Groups: []string{"sre", "dev"}, Reason: "hold", Time: metav1.NewTime(time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC))},
+		{Operation: "resume", Time: metav1.NewTime(time.Date(2026, 1, 2, 4, 4, 5, 0, time.UTC))},
+	}
+	r.NoError(wargs.printOperationHistory(cmd))
+	lines := strings.Split(strings.TrimSpace(buffer.String()), "\n")
+	r.Len(lines, 3)
+	r.Equal([]string{"TIME", "OPERATION", "STEP", "USER", "GROUPS", "REASON"}, strings.Fields(lines[0]))
+	r.Equal([]string{"2026-01-02T03:04:05Z", "suspend", "deploy", "alice", "sre,dev", "hold"}, strings.Fields(lines[1]))
+	r.Equal([]string{"2026-01-02T04:04:05Z", "resume"}, strings.Fields(lines[2]))
+}
@@ -11,7 +11,9 @@ template: {
 		// +usage=Specify how to select the targets of the rule
 		selector: #ResourcePolicyRuleSelector
 		// +usage=Specify the strategy for target resource to recycle
-		strategy: *"onAppUpdate" | "onAppDelete" | "never"
+		strategy: *"onAppUpdate" | "onAppDelete" | "never" | "afterTTL"
+		// +usage=Specify how long the outdated resource will be kept after it falls out of use (like 24h), only works for the afterTTL strategy
+		ttl?: string
 		// +usage=Specify the deletion propagation strategy for target resource to delete
 		propagation?: "orphan" | "cascading"
 	}
@@ -29,11 +31,36 @@ template: {
 		resourceTypes?: [...string]
 		// +usage=Select resources by their names
 		resourceNames?: [...string]
+		// +usage=Select resources by glob patterns of their names (like redis-*)
+		resourceNamePatterns?: [...string]
+		// +usage=Select resources by regular expressions of their names
+		resourceNameRegexps?: [...string]
+		// +usage=Select resources by their namespaces
+		namespaces?: [...string]
+		// +usage=Select resources by their api groups (use core for the core api group)
+		apiGroups?: [...string]
+		// +usage=Select resources by their api versions (like apps/v1)
+		apiVersions?: [...string]
+		// +usage=Select resources by label selector
+		labelSelector?: {
+			// +usage=Select resources whose labels match all the given key-value pairs
+			matchLabels?: [string]: string
+			// +usage=Select resources whose labels match all the given label selector requirements
+			matchExpressions?: [...{
+				key:      string
+				operator: "In" | "NotIn" | "Exists" | "DoesNotExist"
+				values?: [...string]
+			}]
+		}
+		// +usage=Select resources which contain all the given annotations
+		annotations?: [string]: string
 	}
 
 	parameter: {
 		// +usage=If set, it will override the default revision limit number and customize this number for the current application
 		applicationRevisionLimit?: int
+		// +usage=If set, application revisions created within this duration (like 168h) will be kept, older revisions will be recycled unless they are in use or kept by applicationRevisionLimit
+		applicationRevisionRetention?: string
 		// +usage=If is set, outdated versioned resourcetracker will not be recycled automatically, outdated resources will be kept until resourcetracker be deleted manually
 		keepLegacyResource: *false | bool
 		// +usage=If is set, continue to execute gc when the workflow fails, by default gc will be executed only after the workflow succeeds
@@ -25,6 +25,29 @@ template: {
 		resourceTypes?: [...string]
 		// +usage=Select resources by their names
 		resourceNames?: [...string]
+		// +usage=Select resources by glob patterns of their names (like redis-*)
+		resourceNamePatterns?: [...string]
+		// +usage=Select resources by regular expressions of their names
+		resourceNameRegexps?: [...string]
+		// +usage=Select resources by their namespaces
+		namespaces?: [...string]
+		// +usage=Select resources by their api groups (use core for the core api group)
+		apiGroups?: [...string]
+		// +usage=Select resources by their api versions (like apps/v1)
+		apiVersions?: [...string]
+		// +usage=Select resources by label selector
+		labelSelector?: {
+			// +usage=Select resources whose labels match all the given key-value pairs
+			matchLabels?: [string]: string
+			// +usage=Select resources whose labels match all the given label selector requirements
+			matchExpressions?: [...{
+				key:      string
+				operator: "In" | "NotIn" | "Exists" | "DoesNotExist"
+				values?: [...string]
+			}]
+		}
+		// +usage=Select resources which contain all the given annotations
+		annotations?: [string]: string
 	}
 
 	parameter: {
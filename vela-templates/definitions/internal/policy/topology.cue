@@ -12,11 +12,43 @@ template: {
 		clusters?: [...string]
 		// +usage=Specify the label selector for clusters
 		clusterLabelSelector?: [string]: string
+		// +usage=Specify the name of the cluster group to select, the members of the group are resolved when the application is reconciled.
+		clusterGroup?: string
 		// +usage=Ignore empty cluster error
 		allowEmpty?: bool
 		// +usage=Deprecated: Use clusterLabelSelector instead.
 		clusterSelector?: [string]: string
 		// +usage=Specify the target namespace to deploy in the selected clusters, default inherit the original namespace.
 		namespace?: string
+		// +usage=Specify the strategy to pick a subset of the selected clusters, the picked clusters are kept until the selected clusters or the strategy change.
+		strategy?: {
+			// +usage=Specify the number of clusters to pick, all the selected clusters are picked if not set.
+			count?: int & >=1
+			// +usage=Specify the cluster label, such as region or zone, to spread the picked clusters across its values.
+			spreadByLabel?: string
+			// +usage=Specify the weights of the free resources to rank the clusters, the clusters are ranked by names if not set.
+			capacityWeights?: {
+				cpu?:    int & >=0
+				memory?: int & >=0
+			}
+		}
+		// +usage=Specify the tolerations to select the tainted clusters.
+		tolerations?: [...{
+			// +usage=Specify the taint key to tolerate, empty key with Exists operator tolerates all taints.
+			key?: string
+			// +usage=Specify the operator to match the taint value.
+			operator: *"Equal" | "Exists"
+			// +usage=Specify the taint value to tolerate when the operator is Equal.
+			value?: string
+			// +usage=Specify the taint effect to tolerate, all effects are tolerated if not set.
+			effect?: "NoSchedule" | "PreferNoSchedule" | "NoExecute"
+		}]
+		// +usage=Specify the failover to move the components off the placed clusters disconnected for longer than the grace period.
+		failover?: {
+			// +usage=Specify the duration a placed cluster can be disconnected before its components are moved to another cluster.
+			gracePeriod: *"5m" | string
+			// +usage=Specify whether to move the components back when the original cluster is connected again.
+			failBack: *false | bool
+		}
 	}
 }
@@ -22,6 +22,9 @@ template: {
 			policies:                 parameter.policies
 			parallelism:              parameter.parallelism
 			ignoreTerraformComponent: parameter.ignoreTerraformComponent
+			if parameter.strategy != _|_ {
+				strategy: parameter.strategy
+			}
 		}
 	}
 	parameter: {
@@ -33,5 +36,18 @@ template: {
 		parallelism: *5 | int
 		//+usage=If set false, this step will apply the components with the terraform workload.
 		ignoreTerraformComponent: *true | bool
+		//+usage=Roll out the components to the clusters progressively in waves, each wave starts after the previous waves are healthy.
+		strategy?: {
+			//+usage=The clusters to deploy in the first wave. If not specified, the first cluster will be used.
+			canary?: [...string]
+			//+usage=The number of clusters in each wave after the canary.
+			batchSize?: int & >=1
+			//+usage=The percentage of the clusters after the canary to deploy in each wave, ignored if batchSize is set.
+			percentage?: int & >=1 & <=100
+			//+usage=The maximum duration for a wave to become healthy.
+			progressDeadline: *"10m" | string
+			//+usage=The action when a wave is not healthy before the deadline, pause the workflow or roll back the deployed waves to the last succeeded revision.
+			onFailure: *"pause" | "rollback"
+		}
 	}
 }